@@ -0,0 +1,12 @@
+# Short-lived token for calling the Emporix API from Terraform.
+# Ephemeral resources need Terraform 1.10 or later; the token is never written to plan or state.
+ephemeral "emporix_access_token" "smoke_test" {
+  scope = "tenant=my-tenant webhook.subscription_read"
+}
+
+provider "restapi" {
+  uri = "https://api.emporix.io"
+  headers = {
+    Authorization = "Bearer ${ephemeral.emporix_access_token.smoke_test.access_token}"
+  }
+}
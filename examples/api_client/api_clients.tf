@@ -0,0 +1,72 @@
+# Example Terraform configuration for Emporix API Clients
+
+terraform {
+  required_providers {
+    emporix = {
+      source  = "emporix/emporix"
+      version = "~> 0.1"
+    }
+  }
+}
+
+# Configure the Emporix provider
+# Recommended: Use a Custom API Key with only the required scopes
+# See: https://developer.emporix.io/ce/getting-started/developer-portal/manage-apikeys#custom-api-keys
+provider "emporix" {
+  tenant  = var.emporix_tenant
+  api_url = var.emporix_api_url
+
+  # Use client credentials from your Custom API Key
+  client_id     = var.emporix_client_id
+  client_secret = var.emporix_client_secret
+}
+
+# Variables
+variable "emporix_tenant" {
+  description = "Emporix tenant name"
+  type        = string
+  sensitive   = false
+}
+
+variable "emporix_api_url" {
+  description = "Emporix API base URL"
+  type        = string
+  default     = "https://api.emporix.io"
+}
+
+variable "emporix_client_id" {
+  description = "Emporix OAuth2 client ID"
+  type        = string
+  sensitive   = true
+}
+
+variable "emporix_client_secret" {
+  description = "Emporix OAuth2 client secret"
+  type        = string
+  sensitive   = true
+}
+
+# Example 1: Read-only key for a storefront
+resource "emporix_api_client" "storefront" {
+  name        = "storefront"
+  description = "Catalog access for the storefront"
+  scopes = [
+    "site.site_read",
+    "category.category_read",
+  ]
+}
+
+# Example 2: Key for an order integration, with its credentials as outputs
+resource "emporix_api_client" "order_sync" {
+  name   = "order-sync"
+  scopes = ["order.order_read", "order.order_update"]
+}
+
+output "order_sync_client_id" {
+  value = emporix_api_client.order_sync.client_id
+}
+
+output "order_sync_client_secret" {
+  value     = emporix_api_client.order_sync.client_secret
+  sensitive = true
+}
@@ -0,0 +1,131 @@
+# Example Terraform configuration for Emporix Categories
+
+terraform {
+  required_providers {
+    emporix = {
+      source  = "emporix/emporix"
+      version = "~> 0.1"
+    }
+  }
+}
+
+# Configure the Emporix provider
+# Recommended: Use a Custom API Key with only the required scopes
+# See: https://developer.emporix.io/ce/getting-started/developer-portal/manage-apikeys#custom-api-keys
+provider "emporix" {
+  tenant  = var.emporix_tenant
+  api_url = var.emporix_api_url
+
+  # Use client credentials from your Custom API Key
+  client_id     = var.emporix_client_id
+  client_secret = var.emporix_client_secret
+}
+
+# Variables
+variable "emporix_tenant" {
+  description = "Emporix tenant name"
+  type        = string
+  sensitive   = false
+}
+
+variable "emporix_api_url" {
+  description = "Emporix API base URL"
+  type        = string
+  default     = "https://api.emporix.io"
+}
+
+variable "emporix_client_id" {
+  description = "Emporix OAuth2 client ID"
+  type        = string
+  sensitive   = true
+}
+
+variable "emporix_client_secret" {
+  description = "Emporix OAuth2 client secret"
+  type        = string
+  sensitive   = true
+}
+
+# Example 1: Category tree
+resource "emporix_category" "shoes" {
+  name = {
+    en = "Shoes"
+    de = "Schuhe"
+  }
+  description = {
+    en = "Shoes for every occasion"
+    de = "Schuhe für jeden Anlass"
+  }
+  published = true
+}
+
+resource "emporix_category" "sneakers" {
+  parent_id = emporix_category.shoes.id
+  position  = 0
+  published = true
+  name = {
+    en = "Sneakers"
+    de = "Sneaker"
+  }
+}
+
+resource "emporix_category" "boots" {
+  parent_id = emporix_category.shoes.id
+  position  = 1
+  name = {
+    en = "Boots"
+    de = "Stiefel"
+  }
+}
+
+# Example 2: Custom slugs and mixins
+resource "emporix_category" "outdoor" {
+  name = {
+    en = "Outdoor"
+    de = "Outdoor"
+  }
+  slug = {
+    en = "outdoor"
+    de = "draussen"
+  }
+
+  mixins = [
+    {
+      name       = "seo"
+      schema_url = "https://example.com/mixins/seo.json"
+      fields = jsonencode({
+        metaTitle = "Outdoor equipment"
+        keywords  = "hiking, camping"
+      })
+    }
+  ]
+}
+
+# Example 3: Assigning products to a category
+variable "sneaker_product_ids" {
+  description = "IDs of existing products to list under Sneakers"
+  type        = set(string)
+  default     = []
+}
+
+resource "emporix_category_assignment" "sneakers" {
+  for_each = var.sneaker_product_ids
+
+  category_id = emporix_category.sneakers.id
+  product_id  = each.value
+}
+
+# Outputs
+output "shoe_category_ids" {
+  description = "IDs of the shoe categories"
+  value = {
+    shoes    = emporix_category.shoes.id
+    sneakers = emporix_category.sneakers.id
+    boots    = emporix_category.boots.id
+  }
+}
+
+output "sneakers_slug" {
+  description = "English slug of the Sneakers category"
+  value       = lookup(emporix_category.sneakers.slug, "en", "")
+}
@@ -104,3 +104,16 @@ output "country_regions" {
     germany = emporix_country.germany.regions
   }
 }
+
+# Look up every active EU country instead of hardcoding codes
+data "emporix_countries" "eu" {
+  region = "EU"
+  active = true
+}
+
+output "eu_country_names" {
+  description = "English names of all active EU countries"
+  value = {
+    for country in data.emporix_countries.eu.countries : country.code => lookup(country.name, "en", country.code)
+  }
+}
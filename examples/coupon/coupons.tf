@@ -0,0 +1,92 @@
+# Example Terraform configuration for Emporix Coupons
+
+terraform {
+  required_providers {
+    emporix = {
+      source  = "emporix/emporix"
+      version = "~> 0.1"
+    }
+  }
+}
+
+# Configure the Emporix provider
+# Recommended: Use a Custom API Key with only the required scopes
+# See: https://developer.emporix.io/ce/getting-started/developer-portal/manage-apikeys#custom-api-keys
+provider "emporix" {
+  tenant  = var.emporix_tenant
+  api_url = var.emporix_api_url
+
+  # Use client credentials from your Custom API Key
+  client_id     = var.emporix_client_id
+  client_secret = var.emporix_client_secret
+}
+
+# Variables
+variable "emporix_tenant" {
+  description = "Emporix tenant name"
+  type        = string
+  sensitive   = false
+}
+
+variable "emporix_api_url" {
+  description = "Emporix API base URL"
+  type        = string
+  default     = "https://api.emporix.io"
+}
+
+variable "emporix_client_id" {
+  description = "Emporix OAuth2 client ID"
+  type        = string
+  sensitive   = true
+}
+
+variable "emporix_client_secret" {
+  description = "Emporix OAuth2 client secret"
+  type        = string
+  sensitive   = true
+}
+
+# Example 1: Welcome coupon, 10 EUR off the first order
+resource "emporix_coupon" "welcome" {
+  code          = "WELCOME10"
+  name          = "Welcome discount"
+  description   = "10 EUR off the first order"
+  discount_type = "ABSOLUTE"
+
+  discount_absolute = {
+    amount   = 10
+    currency = "EUR"
+  }
+
+  max_redemptions_per_customer = 1
+}
+
+# Example 2: Percentage coupon for a summer sale, limited to 1000 redemptions
+resource "emporix_coupon" "summer_sale" {
+  code                = "SUMMER15"
+  name                = "Summer sale"
+  discount_type       = "PERCENT"
+  discount_percentage = 15
+  max_redemptions     = 1000
+
+  validity = {
+    from = "2026-06-01T00:00:00.000Z"
+    to   = "2026-08-31T23:59:59.000Z"
+  }
+}
+
+# Example 3: Free shipping for the customers of a segment
+resource "emporix_customer_segment" "vip" {
+  site_code = "main"
+
+  name = {
+    en = "VIP Customers"
+  }
+}
+
+resource "emporix_coupon" "vip_shipping" {
+  code          = "VIP-SHIPPING"
+  name          = "Free shipping for VIP customers"
+  discount_type = "FREE_SHIPPING"
+  segment_ids   = [emporix_customer_segment.vip.id]
+}
@@ -0,0 +1,93 @@
+# Example Terraform configuration for Emporix Customer Segments
+
+terraform {
+  required_providers {
+    emporix = {
+      source  = "emporix/emporix"
+      version = "~> 0.1"
+    }
+  }
+}
+
+# Configure the Emporix provider
+# Recommended: Use a Custom API Key with only the required scopes
+# See: https://developer.emporix.io/ce/getting-started/developer-portal/manage-apikeys#custom-api-keys
+provider "emporix" {
+  tenant  = var.emporix_tenant
+  api_url = var.emporix_api_url
+
+  # Use client credentials from your Custom API Key
+  client_id     = var.emporix_client_id
+  client_secret = var.emporix_client_secret
+}
+
+# Variables
+variable "emporix_tenant" {
+  description = "Emporix tenant name"
+  type        = string
+  sensitive   = false
+}
+
+variable "emporix_api_url" {
+  description = "Emporix API base URL"
+  type        = string
+  default     = "https://api.emporix.io"
+}
+
+variable "emporix_client_id" {
+  description = "Emporix OAuth2 client ID"
+  type        = string
+  sensitive   = true
+}
+
+variable "emporix_client_secret" {
+  description = "Emporix OAuth2 client secret"
+  type        = string
+  sensitive   = true
+}
+
+# Example 1: Segment whose members are managed by Terraform
+variable "vip_customer_ids" {
+  description = "IDs of the customers in the VIP segment"
+  type        = set(string)
+  default     = []
+}
+
+resource "emporix_customer_segment" "vip" {
+  site_code    = "main"
+  customer_ids = var.vip_customer_ids
+
+  name = {
+    en = "VIP Customers"
+    de = "VIP-Kunden"
+  }
+  description = {
+    en = "Customers with early access to new collections"
+  }
+}
+
+# Example 2: Seasonal segment; members are maintained outside Terraform
+resource "emporix_customer_segment" "winter_trade_fair" {
+  site_code = "main"
+  name = {
+    en = "Winter Trade Fair Visitors"
+  }
+
+  validity = {
+    from = "2024-11-01T00:00:00.000Z"
+    to   = "2025-01-31T23:59:59.000Z"
+  }
+}
+
+# Example 3: Attaching a category to the VIP segment
+resource "emporix_category" "new_collection" {
+  name = {
+    en = "New Collection"
+  }
+}
+
+resource "emporix_customer_segment_item_assignment" "vip_new_collection" {
+  segment_id = emporix_customer_segment.vip.id
+  item_type  = "CATEGORY"
+  item_id    = emporix_category.new_collection.id
+}
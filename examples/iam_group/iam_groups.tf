@@ -0,0 +1,80 @@
+# Example Terraform configuration for Emporix IAM Groups
+
+terraform {
+  required_providers {
+    emporix = {
+      source  = "emporix/emporix"
+      version = "~> 0.1"
+    }
+  }
+}
+
+# Configure the Emporix provider
+# Recommended: Use a Custom API Key with only the required scopes
+# See: https://developer.emporix.io/ce/getting-started/developer-portal/manage-apikeys#custom-api-keys
+provider "emporix" {
+  tenant  = var.emporix_tenant
+  api_url = var.emporix_api_url
+
+  # Use client credentials from your Custom API Key
+  client_id     = var.emporix_client_id
+  client_secret = var.emporix_client_secret
+}
+
+# Variables
+variable "emporix_tenant" {
+  description = "Emporix tenant name"
+  type        = string
+  sensitive   = false
+}
+
+variable "emporix_api_url" {
+  description = "Emporix API base URL"
+  type        = string
+  default     = "https://api.emporix.io"
+}
+
+variable "emporix_client_id" {
+  description = "Emporix OAuth2 client ID"
+  type        = string
+  sensitive   = true
+}
+
+variable "emporix_client_secret" {
+  description = "Emporix OAuth2 client secret"
+  type        = string
+  sensitive   = true
+}
+
+# Example 1: Employee group bound to an access control template
+resource "emporix_iam_group" "order_managers" {
+  user_type    = "EMPLOYEE"
+  template_ids = [var.order_manager_template_id]
+
+  name = {
+    en = "Order Managers"
+    de = "Auftragsverwaltung"
+  }
+  description = {
+    en = "Employees handling orders and returns"
+  }
+}
+
+# Example 2: Adding employees to the group
+variable "order_manager_template_id" {
+  description = "ID of the access control template for order managers"
+  type        = string
+}
+
+variable "order_manager_user_ids" {
+  description = "IDs of the employees managing orders"
+  type        = set(string)
+  default     = []
+}
+
+resource "emporix_iam_group_membership" "order_managers" {
+  for_each = var.order_manager_user_ids
+
+  group_id = emporix_iam_group.order_managers.id
+  user_id  = each.value
+}
@@ -0,0 +1,101 @@
+# Example Terraform configuration for Emporix Languages
+
+terraform {
+  required_providers {
+    emporix = {
+      source  = "emporix/emporix"
+      version = "~> 0.1"
+    }
+  }
+}
+
+# Configure the Emporix provider
+# Recommended: Use a Custom API Key with only the required scopes
+# See: https://developer.emporix.io/ce/getting-started/developer-portal/manage-apikeys#custom-api-keys
+provider "emporix" {
+  tenant  = var.emporix_tenant
+  api_url = var.emporix_api_url
+
+  # Use client credentials from your Custom API Key
+  client_id     = var.emporix_client_id
+  client_secret = var.emporix_client_secret
+}
+
+# Variables
+variable "emporix_tenant" {
+  description = "Emporix tenant name"
+  type        = string
+  sensitive   = false
+}
+
+variable "emporix_api_url" {
+  description = "Emporix API base URL"
+  type        = string
+  default     = "https://api.emporix.io"
+}
+
+variable "emporix_client_id" {
+  description = "Emporix OAuth2 client ID"
+  type        = string
+  sensitive   = true
+}
+
+variable "emporix_client_secret" {
+  description = "Emporix OAuth2 client secret"
+  type        = string
+  sensitive   = true
+}
+
+# Example 1: Default language of the tenant
+resource "emporix_language" "en" {
+  code     = "en"
+  default  = true
+  required = true
+  name = {
+    en = "English"
+    de = "Englisch"
+  }
+}
+
+# Example 2: Additional language with translations
+resource "emporix_language" "de" {
+  code = "de"
+  name = {
+    en = "German"
+    de = "Deutsch"
+  }
+}
+
+# Example 3: Using for_each for multiple languages
+locals {
+  european_languages = {
+    fr = "Français"
+    es = "Español"
+    it = "Italiano"
+  }
+}
+
+resource "emporix_language" "european" {
+  for_each = local.european_languages
+
+  code = each.key
+  name = {
+    (each.key) = each.value
+  }
+}
+
+# Example 4: A site using the languages of the tenant
+resource "emporix_sitesettings" "main" {
+  code             = "main"
+  name             = "Main Site"
+  active           = true
+  default_language = emporix_language.en.code
+  languages        = concat([emporix_language.en.code, emporix_language.de.code], [for language in emporix_language.european : language.code])
+  currency         = "EUR"
+}
+
+# Outputs
+output "languages" {
+  description = "Language codes of the tenant"
+  value       = concat([emporix_language.en.code, emporix_language.de.code], [for language in emporix_language.european : language.code])
+}
@@ -0,0 +1,115 @@
+# Example Terraform configuration for Emporix Locations
+
+terraform {
+  required_providers {
+    emporix = {
+      source  = "emporix/emporix"
+      version = "~> 0.1"
+    }
+  }
+}
+
+# Configure the Emporix provider
+# Recommended: Use a Custom API Key with only the required scopes
+# See: https://developer.emporix.io/ce/getting-started/developer-portal/manage-apikeys#custom-api-keys
+provider "emporix" {
+  tenant  = var.emporix_tenant
+  api_url = var.emporix_api_url
+
+  # Use client credentials from your Custom API Key
+  client_id     = var.emporix_client_id
+  client_secret = var.emporix_client_secret
+}
+
+# Variables
+variable "emporix_tenant" {
+  description = "Emporix tenant name"
+  type        = string
+  sensitive   = false
+}
+
+variable "emporix_api_url" {
+  description = "Emporix API base URL"
+  type        = string
+  default     = "https://api.emporix.io"
+}
+
+variable "emporix_client_id" {
+  description = "Emporix OAuth2 client ID"
+  type        = string
+  sensitive   = true
+}
+
+variable "emporix_client_secret" {
+  description = "Emporix OAuth2 client secret"
+  type        = string
+  sensitive   = true
+}
+
+# Example 1: Warehouse that feeds product availability
+resource "emporix_location" "berlin_warehouse" {
+  name = "Berlin Central Warehouse"
+  type = "WAREHOUSE"
+
+  address = {
+    street        = "Lagerstrasse"
+    street_number = "12"
+    zip_code      = "12099"
+    city          = "Berlin"
+    country       = "DE"
+  }
+}
+
+# Example 2: Pickup point for click-and-collect with opening hours and a lunch break
+resource "emporix_location" "munich_pickup" {
+  name = "Munich Marienplatz Pickup"
+  type = "PICKUP_POINT"
+
+  address = {
+    street        = "Marienplatz"
+    street_number = "1"
+    zip_code      = "80331"
+    city          = "Munich"
+    country       = "DE"
+    state         = "Bavaria"
+  }
+
+  location = {
+    latitude  = 48.1374
+    longitude = 11.5755
+  }
+
+  contact_details = {
+    email = "pickup-munich@example.com"
+    phone = "+49 89 1234567"
+  }
+
+  opening_hours = [
+    {
+      weekday = "MONDAY"
+      time_ranges = [
+        { time_from = "09:00", time_to = "13:00" },
+        { time_from = "14:00", time_to = "18:00" },
+      ]
+    },
+    {
+      weekday = "SATURDAY"
+      time_ranges = [
+        { time_from = "10:00", time_to = "14:00" },
+      ]
+    },
+  ]
+}
+
+# Example 3: Store that is temporarily closed
+resource "emporix_location" "hamburg_store" {
+  name   = "Hamburg Store"
+  type   = "STORE"
+  active = false
+
+  address = {
+    zip_code = "20095"
+    city     = "Hamburg"
+    country  = "DE"
+  }
+}
@@ -0,0 +1,81 @@
+# Example Terraform configuration for Emporix Price Lists
+
+terraform {
+  required_providers {
+    emporix = {
+      source  = "emporix/emporix"
+      version = "~> 0.1"
+    }
+  }
+}
+
+# Configure the Emporix provider
+# Recommended: Use a Custom API Key with only the required scopes
+# See: https://developer.emporix.io/ce/getting-started/developer-portal/manage-apikeys#custom-api-keys
+provider "emporix" {
+  tenant  = var.emporix_tenant
+  api_url = var.emporix_api_url
+
+  # Use client credentials from your Custom API Key
+  client_id     = var.emporix_client_id
+  client_secret = var.emporix_client_secret
+}
+
+# Variables
+variable "emporix_tenant" {
+  description = "Emporix tenant name"
+  type        = string
+  sensitive   = false
+}
+
+variable "emporix_api_url" {
+  description = "Emporix API base URL"
+  type        = string
+  default     = "https://api.emporix.io"
+}
+
+variable "emporix_client_id" {
+  description = "Emporix OAuth2 client ID"
+  type        = string
+  sensitive   = true
+}
+
+variable "emporix_client_secret" {
+  description = "Emporix OAuth2 client secret"
+  type        = string
+  sensitive   = true
+}
+
+# Example 1: Price list for all customers of a site
+resource "emporix_price_list" "retail" {
+  site_code = "main"
+  currency  = "EUR"
+  name = {
+    en = "Retail Prices"
+    de = "Endkundenpreise"
+  }
+}
+
+# Example 2: Price list for B2B customer groups in a second currency of the site
+resource "emporix_price_list" "b2b_chf" {
+  site_code       = "main"
+  currency        = "CHF"
+  customer_groups = ["b2b", "wholesale"]
+  name = {
+    en = "B2B Prices CHF"
+  }
+}
+
+# Example 3: Seasonal price list with a validity period
+resource "emporix_price_list" "winter_sale" {
+  site_code = "main"
+  currency  = "EUR"
+  name = {
+    en = "Winter Sale"
+  }
+
+  validity = {
+    from = "2024-12-01T00:00:00.000Z"
+    to   = "2025-02-28T23:59:59.000Z"
+  }
+}
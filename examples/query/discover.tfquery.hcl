@@ -0,0 +1,47 @@
+# Discover objects that are not managed by Terraform yet:
+#   terraform query -generate-config-out=generated.tf
+
+list "emporix_webhook" "active" {
+  provider = emporix
+
+  config {
+    active = true
+  }
+}
+
+list "emporix_shipping_zone" "main" {
+  provider         = emporix
+  include_resource = true
+
+  config {
+    site = "main"
+  }
+}
+
+list "emporix_schema" "product" {
+  provider = emporix
+
+  config {
+    type = "PRODUCT"
+  }
+}
+
+list "emporix_custom_entity_instance" "documents" {
+  provider = emporix
+
+  config {
+    type = "DOCUMENT"
+  }
+}
+
+list "emporix_delivery_time" "main" {
+  provider = emporix
+
+  config {
+    site = "main"
+  }
+}
+
+list "emporix_tenant_configuration" "all" {
+  provider = emporix
+}
@@ -0,0 +1,78 @@
+# Example Terraform configuration for Emporix Sequential ID Schemas
+
+terraform {
+  required_providers {
+    emporix = {
+      source  = "emporix/emporix"
+      version = "~> 0.1"
+    }
+  }
+}
+
+# Configure the Emporix provider
+# Recommended: Use a Custom API Key with only the required scopes
+# See: https://developer.emporix.io/ce/getting-started/developer-portal/manage-apikeys#custom-api-keys
+provider "emporix" {
+  tenant  = var.emporix_tenant
+  api_url = var.emporix_api_url
+
+  # Use client credentials from your Custom API Key
+  client_id     = var.emporix_client_id
+  client_secret = var.emporix_client_secret
+}
+
+# Variables
+variable "emporix_tenant" {
+  description = "Emporix tenant name"
+  type        = string
+  sensitive   = false
+}
+
+variable "emporix_api_url" {
+  description = "Emporix API base URL"
+  type        = string
+  default     = "https://api.emporix.io"
+}
+
+variable "emporix_client_id" {
+  description = "Emporix OAuth2 client ID"
+  type        = string
+  sensitive   = true
+}
+
+variable "emporix_client_secret" {
+  description = "Emporix OAuth2 client secret"
+  type        = string
+  sensitive   = true
+}
+
+# Example 1: Active order number schema, e.g. ORD-100000
+resource "emporix_sequential_id_schema" "orders" {
+  name          = "Order Numbers"
+  schema_type   = "order"
+  prefix        = "ORD-"
+  counter_start = 100000
+  max_value     = 999999
+  active        = true
+}
+
+# Example 2: Invoice numbers with a country suffix, e.g. INV-1-DE
+resource "emporix_sequential_id_schema" "invoices" {
+  name          = "Invoice Numbers DE"
+  schema_type   = "invoice"
+  prefix        = "INV-"
+  suffix        = "-DE"
+  counter_start = 1
+  max_value     = 99999999
+  active        = true
+}
+
+# Example 3: Next year's quote numbers, prepared but not active yet.
+# Switch by setting active = true here and removing it from the current schema.
+resource "emporix_sequential_id_schema" "quotes_2026" {
+  name          = "Quote Numbers 2026"
+  schema_type   = "quote"
+  prefix        = "Q26-"
+  counter_start = 1
+  max_value     = 99999
+}
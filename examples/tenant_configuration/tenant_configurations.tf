@@ -244,6 +244,22 @@ resource "emporix_tenant_configuration" "api_key" {
   secured = true  # Value will be encrypted
 }
 
+# Example 10b: Secured Configuration with a write-only value (Terraform 1.11 or later).
+# The value never lands in state; increment value_wo_version after changing it.
+variable "external_api_token" {
+  description = "Token of the external API, e.g. passed in from a secrets manager"
+  type        = string
+  sensitive   = true
+  ephemeral   = true
+}
+
+resource "emporix_tenant_configuration" "api_token" {
+  key              = "test_external_api_token"
+  value_wo         = jsonencode(var.external_api_token)
+  value_wo_version = 1
+  secured          = true
+}
+
 # Example 11: Boolean Configuration
 resource "emporix_tenant_configuration" "feature_flag" {
   key   = "test_enable_new_checkout"
@@ -316,17 +332,17 @@ resource "emporix_tenant_configuration" "env_config" {
 # Outputs
 output "project_country" {
   description = "Project country configuration"
-  value       = jsondecode(emporix_tenant_configuration.project_country.value)
+  value       = nonsensitive(jsondecode(emporix_tenant_configuration.project_country.value))
 }
 
 output "tax_configuration" {
   description = "Tax configuration object"
-  value       = jsondecode(emporix_tenant_configuration.tax_config.value)
+  value       = nonsensitive(jsondecode(emporix_tenant_configuration.tax_config.value))
 }
 
 output "project_languages" {
   description = "Configured project languages"
-  value       = jsondecode(emporix_tenant_configuration.project_languages.value)
+  value       = nonsensitive(jsondecode(emporix_tenant_configuration.project_languages.value))
 }
 
 output "all_app_configs" {
@@ -334,7 +350,7 @@ output "all_app_configs" {
   value = {
     for key, config in emporix_tenant_configuration.app_configs :
     key => {
-      value   = jsondecode(config.value)
+      value   = nonsensitive(jsondecode(config.value))
       version = config.version
     }
   }
@@ -0,0 +1,116 @@
+# Example Terraform configuration for Emporix Units
+
+terraform {
+  required_providers {
+    emporix = {
+      source  = "emporix/emporix"
+      version = "~> 0.1"
+    }
+  }
+}
+
+# Configure the Emporix provider
+# Recommended: Use a Custom API Key with only the required scopes
+# See: https://developer.emporix.io/ce/getting-started/developer-portal/manage-apikeys#custom-api-keys
+provider "emporix" {
+  tenant  = var.emporix_tenant
+  api_url = var.emporix_api_url
+
+  # Use client credentials from your Custom API Key
+  client_id     = var.emporix_client_id
+  client_secret = var.emporix_client_secret
+}
+
+# Variables
+variable "emporix_tenant" {
+  description = "Emporix tenant name"
+  type        = string
+  sensitive   = false
+}
+
+variable "emporix_api_url" {
+  description = "Emporix API base URL"
+  type        = string
+  default     = "https://api.emporix.io"
+}
+
+variable "emporix_client_id" {
+  description = "Emporix OAuth2 client ID"
+  type        = string
+  sensitive   = true
+}
+
+variable "emporix_client_secret" {
+  description = "Emporix OAuth2 client secret"
+  type        = string
+  sensitive   = true
+}
+
+# Example 1: Base unit of a type
+resource "emporix_unit" "kilogram" {
+  code      = "kg"
+  type      = "mass"
+  base_unit = true
+  name = {
+    en = "Kilogram"
+    de = "Kilogramm"
+  }
+}
+
+# Example 2: Units converted into the base unit
+resource "emporix_unit" "gram" {
+  code   = "g"
+  type   = emporix_unit.kilogram.type
+  factor = 0.001
+  name = {
+    en = "Gram"
+    de = "Gramm"
+  }
+}
+
+# Example 3: Using for_each for the units of a type
+locals {
+  volume_units = {
+    ml = { name = "Milliliter", factor = 0.001 }
+    cl = { name = "Centiliter", factor = 0.01 }
+  }
+}
+
+resource "emporix_unit" "liter" {
+  code      = "l"
+  type      = "volume"
+  base_unit = true
+  name = {
+    en = "Liter"
+  }
+}
+
+resource "emporix_unit" "volume" {
+  for_each = local.volume_units
+
+  code   = each.key
+  type   = emporix_unit.liter.type
+  factor = each.value.factor
+  name = {
+    en = each.value.name
+  }
+}
+
+# Example 4: Looking up a unit managed outside Terraform
+data "emporix_unit" "piece" {
+  code = "pcs"
+}
+
+# Outputs
+output "mass_units" {
+  description = "Mass unit codes with their factor to kg"
+  value = {
+    (emporix_unit.kilogram.code) = emporix_unit.kilogram.factor
+    (emporix_unit.gram.code)     = emporix_unit.gram.factor
+  }
+}
+
+output "piece_name" {
+  description = "English name of the piece unit"
+  value       = lookup(data.emporix_unit.piece.name, "en", "")
+}
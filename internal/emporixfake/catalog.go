@@ -0,0 +1,544 @@
+package emporixfake
+
+import (
+	"net/http"
+)
+
+func (s *Server) registerCatalogRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /payment-gateway/{tenant}/paymentmodes/config", s.createPaymentMode)
+	mux.HandleFunc("GET /payment-gateway/{tenant}/paymentmodes/config", s.listPaymentModes)
+	mux.HandleFunc("GET /payment-gateway/{tenant}/paymentmodes/config/{id}", s.getPaymentMode)
+	mux.HandleFunc("PUT /payment-gateway/{tenant}/paymentmodes/config/{id}", s.updatePaymentMode)
+	mux.HandleFunc("DELETE /payment-gateway/{tenant}/paymentmodes/config/{id}", s.deletePaymentMode)
+
+	mux.HandleFunc("GET /country/{tenant}/countries", s.listCountries)
+	mux.HandleFunc("GET /country/{tenant}/countries/{code}", s.getCountry)
+	mux.HandleFunc("PATCH /country/{tenant}/countries/{code}", s.patchCountry)
+
+	mux.HandleFunc("POST /currency/{tenant}/currencies", s.createCurrency)
+	mux.HandleFunc("GET /currency/{tenant}/currencies", s.listCurrencies)
+	mux.HandleFunc("GET /currency/{tenant}/currencies/{code}", s.getCurrency)
+	mux.HandleFunc("PUT /currency/{tenant}/currencies/{code}", s.updateCurrency)
+	mux.HandleFunc("DELETE /currency/{tenant}/currencies/{code}", s.deleteCurrency)
+
+	mux.HandleFunc("POST /unit-handling/{tenant}/units", s.createUnit)
+	mux.HandleFunc("GET /unit-handling/{tenant}/units", s.listUnits)
+	mux.HandleFunc("GET /unit-handling/{tenant}/units/{code}", s.getUnit)
+	mux.HandleFunc("PUT /unit-handling/{tenant}/units/{code}", s.updateUnit)
+	mux.HandleFunc("DELETE /unit-handling/{tenant}/units/{code}", s.deleteUnit)
+
+	mux.HandleFunc("POST /price/{tenant}/price-lists", s.createPriceList)
+	mux.HandleFunc("GET /price/{tenant}/price-lists", s.listPriceLists)
+	mux.HandleFunc("GET /price/{tenant}/price-lists/{id}", s.getPriceList)
+	mux.HandleFunc("PUT /price/{tenant}/price-lists/{id}", s.updatePriceList)
+	mux.HandleFunc("DELETE /price/{tenant}/price-lists/{id}", s.deletePriceList)
+
+	mux.HandleFunc("POST /tax/{tenant}/taxes", s.createTax)
+	mux.HandleFunc("GET /tax/{tenant}/taxes", s.listTaxes)
+	mux.HandleFunc("GET /tax/{tenant}/taxes/{code}", s.getTax)
+	mux.HandleFunc("PUT /tax/{tenant}/taxes/{code}", s.updateTax)
+	mux.HandleFunc("DELETE /tax/{tenant}/taxes/{code}", s.deleteTax)
+
+	mux.HandleFunc("POST /configuration/{tenant}/configurations", s.createConfigurations)
+	mux.HandleFunc("GET /configuration/{tenant}/configurations", s.listConfigurations)
+	mux.HandleFunc("GET /configuration/{tenant}/configurations/{key}", s.getConfiguration)
+	mux.HandleFunc("PUT /configuration/{tenant}/configurations/{key}", s.updateConfiguration)
+	mux.HandleFunc("DELETE /configuration/{tenant}/configurations/{key}", s.deleteConfiguration)
+}
+
+// Payment modes
+
+func (s *Server) createPaymentMode(w http.ResponseWriter, r *http.Request) {
+	var paymentMode document
+	if !decodeBody(w, r, &paymentMode) {
+		return
+	}
+
+	code := stringField(paymentMode, "code")
+	if code == "" {
+		writeFieldError(w, "code", "must not be empty")
+		return
+	}
+	for _, existing := range s.paymentModes.list() {
+		if stringField(existing, "code") == code {
+			writeConflict(w, "Payment mode", code)
+			return
+		}
+	}
+
+	id := s.newID("paymentmode")
+	paymentMode["id"] = id
+	s.paymentModes.put(id, paymentMode)
+
+	writeJSON(w, http.StatusOK, paymentMode)
+}
+
+func (s *Server) listPaymentModes(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.paymentModes.list())
+}
+
+func (s *Server) getPaymentMode(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	paymentMode, ok := s.paymentModes.get(id)
+	if !ok {
+		writeNotFound(w, "Payment mode", id)
+		return
+	}
+	writeJSON(w, http.StatusOK, paymentMode)
+}
+
+func (s *Server) updatePaymentMode(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	paymentMode, ok := s.paymentModes.get(id)
+	if !ok {
+		writeNotFound(w, "Payment mode", id)
+		return
+	}
+
+	var update document
+	if !decodeBody(w, r, &update) {
+		return
+	}
+
+	// Only active and configuration can be changed after creation
+	paymentMode["active"] = update["active"]
+	if configuration, ok := update["configuration"]; ok {
+		paymentMode["configuration"] = configuration
+	} else {
+		delete(paymentMode, "configuration")
+	}
+
+	writeJSON(w, http.StatusOK, paymentMode)
+}
+
+func (s *Server) deletePaymentMode(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if !s.paymentModes.remove(id) {
+		writeNotFound(w, "Payment mode", id)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Countries are pre-populated; only the active flag can be changed
+
+func (s *Server) listCountries(w http.ResponseWriter, r *http.Request) {
+	listPage(w, r, s.countries.list())
+}
+
+func (s *Server) getCountry(w http.ResponseWriter, r *http.Request) {
+	code := r.PathValue("code")
+	country, ok := s.countries.get(code)
+	if !ok {
+		writeNotFound(w, "Country", code)
+		return
+	}
+	writeJSON(w, http.StatusOK, country)
+}
+
+func (s *Server) patchCountry(w http.ResponseWriter, r *http.Request) {
+	code := r.PathValue("code")
+	country, ok := s.countries.get(code)
+	if !ok {
+		writeNotFound(w, "Country", code)
+		return
+	}
+
+	var update document
+	if !decodeBody(w, r, &update) {
+		return
+	}
+	if !checkVersion(w, country, update) {
+		return
+	}
+
+	if active, ok := update["active"].(bool); ok {
+		country["active"] = active
+	}
+	setMetadataVersion(country, versionOf(country)+1)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Currencies
+
+func (s *Server) createCurrency(w http.ResponseWriter, r *http.Request) {
+	var currency document
+	if !decodeBody(w, r, &currency) {
+		return
+	}
+
+	code := stringField(currency, "code")
+	if code == "" {
+		writeFieldError(w, "code", "must not be empty")
+		return
+	}
+	if _, exists := s.currencies.get(code); exists {
+		writeConflict(w, "Currency", code)
+		return
+	}
+
+	setMetadataVersion(currency, 1)
+	s.currencies.put(code, currency)
+
+	writeJSON(w, http.StatusCreated, document{"code": code})
+}
+
+func (s *Server) listCurrencies(w http.ResponseWriter, r *http.Request) {
+	listPage(w, r, s.currencies.list())
+}
+
+func (s *Server) getCurrency(w http.ResponseWriter, r *http.Request) {
+	code := r.PathValue("code")
+	currency, ok := s.currencies.get(code)
+	if !ok {
+		writeNotFound(w, "Currency", code)
+		return
+	}
+	writeJSON(w, http.StatusOK, currency)
+}
+
+func (s *Server) updateCurrency(w http.ResponseWriter, r *http.Request) {
+	code := r.PathValue("code")
+	currency, ok := s.currencies.get(code)
+	if !ok {
+		writeNotFound(w, "Currency", code)
+		return
+	}
+
+	var update document
+	if !decodeBody(w, r, &update) {
+		return
+	}
+	if !checkVersion(w, currency, update) {
+		return
+	}
+
+	currency["name"] = update["name"]
+	setMetadataVersion(currency, versionOf(currency)+1)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteCurrency(w http.ResponseWriter, r *http.Request) {
+	code := r.PathValue("code")
+	if !s.currencies.remove(code) {
+		writeNotFound(w, "Currency", code)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Units
+
+func (s *Server) createUnit(w http.ResponseWriter, r *http.Request) {
+	var unit document
+	if !decodeBody(w, r, &unit) {
+		return
+	}
+
+	code := stringField(unit, "code")
+	if code == "" {
+		writeFieldError(w, "code", "must not be empty")
+		return
+	}
+	if stringField(unit, "type") == "" {
+		writeFieldError(w, "type", "must not be empty")
+		return
+	}
+	if _, exists := s.units.get(code); exists {
+		writeConflict(w, "Unit", code)
+		return
+	}
+
+	setMetadataVersion(unit, 1)
+	s.units.put(code, unit)
+
+	writeJSON(w, http.StatusCreated, document{"code": code})
+}
+
+func (s *Server) listUnits(w http.ResponseWriter, r *http.Request) {
+	listPage(w, r, s.units.list())
+}
+
+func (s *Server) getUnit(w http.ResponseWriter, r *http.Request) {
+	code := r.PathValue("code")
+	unit, ok := s.units.get(code)
+	if !ok {
+		writeNotFound(w, "Unit", code)
+		return
+	}
+	writeJSON(w, http.StatusOK, unit)
+}
+
+func (s *Server) updateUnit(w http.ResponseWriter, r *http.Request) {
+	code := r.PathValue("code")
+	unit, ok := s.units.get(code)
+	if !ok {
+		writeNotFound(w, "Unit", code)
+		return
+	}
+
+	var update document
+	if !decodeBody(w, r, &update) {
+		return
+	}
+	if !checkVersion(w, unit, update) {
+		return
+	}
+
+	update["code"] = code
+	setMetadataVersion(update, versionOf(unit)+1)
+	s.units.put(code, update)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteUnit(w http.ResponseWriter, r *http.Request) {
+	code := r.PathValue("code")
+	if !s.units.remove(code) {
+		writeNotFound(w, "Unit", code)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Price lists
+
+func (s *Server) createPriceList(w http.ResponseWriter, r *http.Request) {
+	var priceList document
+	if !decodeBody(w, r, &priceList) {
+		return
+	}
+	if !validPriceList(w, priceList) {
+		return
+	}
+
+	id := s.newID("pricelist")
+	priceList["id"] = id
+	setMetadataVersion(priceList, 1)
+	s.priceLists.put(id, priceList)
+
+	writeJSON(w, http.StatusCreated, document{"id": id})
+}
+
+func (s *Server) listPriceLists(w http.ResponseWriter, r *http.Request) {
+	listPage(w, r, s.priceLists.list())
+}
+
+func (s *Server) getPriceList(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	priceList, ok := s.priceLists.get(id)
+	if !ok {
+		writeNotFound(w, "Price list", id)
+		return
+	}
+	writeJSON(w, http.StatusOK, priceList)
+}
+
+func (s *Server) updatePriceList(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	priceList, ok := s.priceLists.get(id)
+	if !ok {
+		writeNotFound(w, "Price list", id)
+		return
+	}
+
+	var update document
+	if !decodeBody(w, r, &update) {
+		return
+	}
+	if !checkVersion(w, priceList, update) {
+		return
+	}
+	if !validPriceList(w, update) {
+		return
+	}
+
+	update["id"] = id
+	setMetadataVersion(update, versionOf(priceList)+1)
+	s.priceLists.put(id, update)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deletePriceList(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if !s.priceLists.remove(id) {
+		writeNotFound(w, "Price list", id)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validPriceList writes a 400 unless the price list has a currency and a site,
+// and its validity period doesn't end before it starts
+func validPriceList(w http.ResponseWriter, priceList document) bool {
+	for _, field := range []string{"currency", "siteCode"} {
+		if stringField(priceList, field) == "" {
+			writeFieldError(w, field, "must not be empty")
+			return false
+		}
+	}
+	if validity, ok := priceList["validity"].(document); ok {
+		from, to := stringField(validity, "from"), stringField(validity, "to")
+		if from != "" && to != "" && from > to {
+			writeFieldError(w, "validity.to", "must not be before validity.from")
+			return false
+		}
+	}
+	return true
+}
+
+// Taxes are keyed by the location's country code
+
+func (s *Server) createTax(w http.ResponseWriter, r *http.Request) {
+	var tax document
+	if !decodeBody(w, r, &tax) {
+		return
+	}
+
+	location, _ := tax["location"].(document)
+	code := stringField(location, "countryCode")
+	if code == "" {
+		writeFieldError(w, "location.countryCode", "must not be empty")
+		return
+	}
+	if _, exists := s.countries.get(code); !exists {
+		writeFieldError(w, "location.countryCode", "unknown country code "+code)
+		return
+	}
+	if _, exists := s.taxes.get(code); exists {
+		writeConflict(w, "Tax configuration", code)
+		return
+	}
+
+	tax["locationCode"] = code
+	setMetadataVersion(tax, 1)
+	s.taxes.put(code, tax)
+
+	writeJSON(w, http.StatusCreated, document{"locationCode": code})
+}
+
+func (s *Server) listTaxes(w http.ResponseWriter, r *http.Request) {
+	listPage(w, r, s.taxes.list())
+}
+
+func (s *Server) getTax(w http.ResponseWriter, r *http.Request) {
+	code := r.PathValue("code")
+	tax, ok := s.taxes.get(code)
+	if !ok {
+		writeNotFound(w, "Tax configuration", code)
+		return
+	}
+	writeJSON(w, http.StatusOK, tax)
+}
+
+func (s *Server) updateTax(w http.ResponseWriter, r *http.Request) {
+	code := r.PathValue("code")
+	tax, ok := s.taxes.get(code)
+	if !ok {
+		writeNotFound(w, "Tax configuration", code)
+		return
+	}
+
+	var update document
+	if !decodeBody(w, r, &update) {
+		return
+	}
+	if !checkVersion(w, tax, update) {
+		return
+	}
+
+	tax["taxClasses"] = update["taxClasses"]
+	setMetadataVersion(tax, versionOf(tax)+1)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteTax(w http.ResponseWriter, r *http.Request) {
+	code := r.PathValue("code")
+	if !s.taxes.remove(code) {
+		writeNotFound(w, "Tax configuration", code)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Tenant configurations keep their version at the top level
+
+func (s *Server) createConfigurations(w http.ResponseWriter, r *http.Request) {
+	var configurations []document
+	if !decodeBody(w, r, &configurations) {
+		return
+	}
+
+	for i, configuration := range configurations {
+		key := stringField(configuration, "key")
+		if key == "" {
+			writeFieldError(w, "key", "must not be empty")
+			return
+		}
+		if _, exists := s.configurations.get(key); exists {
+			writeConflict(w, "Configuration", key)
+			return
+		}
+		if _, ok := configuration["secured"]; !ok {
+			configurations[i]["secured"] = false
+		}
+		configurations[i]["version"] = float64(1)
+	}
+	for _, configuration := range configurations {
+		s.configurations.put(stringField(configuration, "key"), configuration)
+	}
+
+	writeJSON(w, http.StatusCreated, configurations)
+}
+
+func (s *Server) listConfigurations(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.configurations.list())
+}
+
+func (s *Server) getConfiguration(w http.ResponseWriter, r *http.Request) {
+	key := r.PathValue("key")
+	configuration, ok := s.configurations.get(key)
+	if !ok {
+		writeNotFound(w, "Configuration", key)
+		return
+	}
+	writeJSON(w, http.StatusOK, configuration)
+}
+
+func (s *Server) updateConfiguration(w http.ResponseWriter, r *http.Request) {
+	key := r.PathValue("key")
+	configuration, ok := s.configurations.get(key)
+	if !ok {
+		writeNotFound(w, "Configuration", key)
+		return
+	}
+
+	var update document
+	if !decodeBody(w, r, &update) {
+		return
+	}
+	if !checkVersion(w, configuration, update) {
+		return
+	}
+
+	configuration["value"] = update["value"]
+	if secured, ok := update["secured"].(bool); ok {
+		configuration["secured"] = secured
+	}
+	configuration["version"] = float64(versionOf(configuration) + 1)
+
+	writeJSON(w, http.StatusOK, configuration)
+}
+
+func (s *Server) deleteConfiguration(w http.ResponseWriter, r *http.Request) {
+	key := r.PathValue("key")
+	if !s.configurations.remove(key) {
+		writeNotFound(w, "Configuration", key)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
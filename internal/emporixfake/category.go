@@ -0,0 +1,233 @@
+package emporixfake
+
+import (
+	"net/http"
+	"strings"
+)
+
+func (s *Server) registerCategoryRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /category/{tenant}/categories", s.createCategory)
+	mux.HandleFunc("GET /category/{tenant}/categories", s.listCategories)
+	mux.HandleFunc("GET /category/{tenant}/categories/{id}", s.getCategory)
+	mux.HandleFunc("PUT /category/{tenant}/categories/{id}", s.updateCategory)
+	mux.HandleFunc("DELETE /category/{tenant}/categories/{id}", s.deleteCategory)
+
+	mux.HandleFunc("POST /category/{tenant}/categories/{id}/assignments", s.createCategoryAssignment)
+	mux.HandleFunc("GET /category/{tenant}/categories/{id}/assignments", s.listCategoryAssignments)
+	mux.HandleFunc("DELETE /category/{tenant}/categories/{id}/assignments/references/{ref}", s.deleteCategoryAssignment)
+}
+
+// Categories form a tree through parentId; position orders the children of a parent
+
+func (s *Server) createCategory(w http.ResponseWriter, r *http.Request) {
+	var category document
+	if !decodeBody(w, r, &category) {
+		return
+	}
+
+	if name, ok := category["name"].(document); !ok || len(name) == 0 {
+		writeFieldError(w, "name", "must not be empty")
+		return
+	}
+	if !s.validCategoryParent(w, category, "") {
+		return
+	}
+
+	id := s.newID("category")
+	category["id"] = id
+	if _, ok := category["position"]; !ok {
+		category["position"] = float64(len(s.childCategories(stringField(category, "parentId"))))
+	}
+	defaultCategorySlug(category)
+	setMetadataVersion(category, 1)
+	s.categories.put(id, category)
+
+	writeJSON(w, http.StatusCreated, document{"id": id})
+}
+
+func (s *Server) listCategories(w http.ResponseWriter, r *http.Request) {
+	listPage(w, r, s.categories.list())
+}
+
+func (s *Server) getCategory(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	category, ok := s.categories.get(id)
+	if !ok {
+		writeNotFound(w, "Category", id)
+		return
+	}
+	writeJSON(w, http.StatusOK, category)
+}
+
+func (s *Server) updateCategory(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	category, ok := s.categories.get(id)
+	if !ok {
+		writeNotFound(w, "Category", id)
+		return
+	}
+
+	var update document
+	if !decodeBody(w, r, &update) {
+		return
+	}
+	if !checkVersion(w, category, update) {
+		return
+	}
+	if !s.validCategoryParent(w, update, id) {
+		return
+	}
+
+	update["id"] = id
+	if _, ok := update["position"]; !ok {
+		update["position"] = category["position"]
+	}
+	defaultCategorySlug(update)
+	setMetadataVersion(update, versionOf(category)+1)
+	s.categories.put(id, update)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// deleteCategory deletes a category with its subcategories, unless withSubcategories=false,
+// in which case the subcategories move up to the deleted category's parent
+func (s *Server) deleteCategory(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	category, ok := s.categories.get(id)
+	if !ok {
+		writeNotFound(w, "Category", id)
+		return
+	}
+
+	withSubcategories := r.URL.Query().Get("withSubcategories") != "false"
+	s.removeCategory(id, stringField(category, "parentId"), withSubcategories)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) removeCategory(id, parentID string, withSubcategories bool) {
+	for _, child := range s.childCategories(id) {
+		childID := stringField(child, "id")
+		if withSubcategories {
+			s.removeCategory(childID, id, true)
+			continue
+		}
+		if parentID == "" {
+			delete(child, "parentId")
+		} else {
+			child["parentId"] = parentID
+		}
+	}
+	s.categories.remove(id)
+	delete(s.categoryAssignments, id)
+}
+
+// validCategoryParent writes a 400 unless the parentId of doc refers to an existing category
+// that is neither the category itself nor one of its descendants
+func (s *Server) validCategoryParent(w http.ResponseWriter, doc document, id string) bool {
+	for parentID := stringField(doc, "parentId"); parentID != ""; {
+		if parentID == id {
+			writeFieldError(w, "parentId", "a category cannot be moved below itself")
+			return false
+		}
+		parent, ok := s.categories.get(parentID)
+		if !ok {
+			writeFieldError(w, "parentId", "category "+parentID+" does not exist")
+			return false
+		}
+		parentID = stringField(parent, "parentId")
+	}
+	return true
+}
+
+func (s *Server) childCategories(parentID string) []document {
+	var children []document
+	for _, category := range s.categories.list() {
+		if stringField(category, "parentId") == parentID {
+			children = append(children, category)
+		}
+	}
+	return children
+}
+
+// defaultCategorySlug derives a slug from the name for every language without one, like the API
+func defaultCategorySlug(category document) {
+	slugs, _ := category["localizedSlug"].(document)
+	if slugs == nil {
+		slugs = document{}
+	}
+	name, _ := category["name"].(document)
+	for language, value := range name {
+		if _, ok := slugs[language]; ok {
+			continue
+		}
+		if text, ok := value.(string); ok {
+			slugs[language] = strings.ReplaceAll(strings.ToLower(text), " ", "-")
+		}
+	}
+	category["localizedSlug"] = slugs
+}
+
+// Category assignments are stored per category, keyed by the referenced object's ID
+
+func (s *Server) createCategoryAssignment(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, ok := s.categories.get(id); !ok {
+		writeNotFound(w, "Category", id)
+		return
+	}
+
+	var assignment document
+	if !decodeBody(w, r, &assignment) {
+		return
+	}
+	ref, _ := assignment["ref"].(document)
+	refID := stringField(ref, "id")
+	if refID == "" {
+		writeFieldError(w, "ref.id", "must not be empty")
+		return
+	}
+	if stringField(ref, "type") == "" {
+		writeFieldError(w, "ref.type", "must not be empty")
+		return
+	}
+
+	assignments, ok := s.categoryAssignments[id]
+	if !ok {
+		assignments = newCollection()
+		s.categoryAssignments[id] = assignments
+	}
+	if _, exists := assignments.get(refID); exists {
+		writeConflict(w, "Assignment", refID)
+		return
+	}
+
+	assignment["id"] = s.newID("assignment")
+	assignments.put(refID, assignment)
+
+	writeJSON(w, http.StatusCreated, document{"id": assignment["id"]})
+}
+
+func (s *Server) listCategoryAssignments(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, ok := s.categories.get(id); !ok {
+		writeNotFound(w, "Category", id)
+		return
+	}
+
+	var docs []document
+	if assignments, ok := s.categoryAssignments[id]; ok {
+		docs = assignments.list()
+	}
+	listPage(w, r, docs)
+}
+
+func (s *Server) deleteCategoryAssignment(w http.ResponseWriter, r *http.Request) {
+	id, refID := r.PathValue("id"), r.PathValue("ref")
+	assignments, ok := s.categoryAssignments[id]
+	if !ok || !assignments.remove(refID) {
+		writeNotFound(w, "Assignment", refID)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,150 @@
+package emporixfake
+
+import "net/http"
+
+func (s *Server) registerCouponRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /coupon/{tenant}/coupons", s.createCoupon)
+	mux.HandleFunc("GET /coupon/{tenant}/coupons", s.listCoupons)
+	mux.HandleFunc("GET /coupon/{tenant}/coupons/{code}", s.getCoupon)
+	mux.HandleFunc("PUT /coupon/{tenant}/coupons/{code}", s.updateCoupon)
+	mux.HandleFunc("DELETE /coupon/{tenant}/coupons/{code}", s.deleteCoupon)
+
+	mux.HandleFunc("GET /reward-points/{tenant}/config", s.getRewardPointsConfig)
+	mux.HandleFunc("PUT /reward-points/{tenant}/config", s.updateRewardPointsConfig)
+}
+
+// Coupons
+
+func (s *Server) createCoupon(w http.ResponseWriter, r *http.Request) {
+	var coupon document
+	if !decodeBody(w, r, &coupon) {
+		return
+	}
+
+	code := stringField(coupon, "code")
+	if code == "" {
+		writeFieldError(w, "code", "must not be empty")
+		return
+	}
+	if _, exists := s.coupons.get(code); exists {
+		writeConflict(w, "Coupon", code)
+		return
+	}
+	if !validCoupon(w, coupon) {
+		return
+	}
+
+	setMetadataVersion(coupon, 1)
+	s.coupons.put(code, coupon)
+
+	writeJSON(w, http.StatusCreated, document{"code": code})
+}
+
+func (s *Server) listCoupons(w http.ResponseWriter, r *http.Request) {
+	listPage(w, r, s.coupons.list())
+}
+
+func (s *Server) getCoupon(w http.ResponseWriter, r *http.Request) {
+	code := r.PathValue("code")
+	coupon, ok := s.coupons.get(code)
+	if !ok {
+		writeNotFound(w, "Coupon", code)
+		return
+	}
+	writeJSON(w, http.StatusOK, coupon)
+}
+
+func (s *Server) updateCoupon(w http.ResponseWriter, r *http.Request) {
+	code := r.PathValue("code")
+	coupon, ok := s.coupons.get(code)
+	if !ok {
+		writeNotFound(w, "Coupon", code)
+		return
+	}
+
+	var update document
+	if !decodeBody(w, r, &update) {
+		return
+	}
+	if !checkVersion(w, coupon, update) {
+		return
+	}
+	if !validCoupon(w, update) {
+		return
+	}
+
+	update["code"] = code
+	setMetadataVersion(update, versionOf(coupon)+1)
+	s.coupons.put(code, update)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteCoupon(w http.ResponseWriter, r *http.Request) {
+	code := r.PathValue("code")
+	if !s.coupons.remove(code) {
+		writeNotFound(w, "Coupon", code)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validCoupon writes a 400 unless the coupon has a name and the discount fields its discount type needs
+func validCoupon(w http.ResponseWriter, coupon document) bool {
+	if stringField(coupon, "name") == "" {
+		writeFieldError(w, "name", "must not be empty")
+		return false
+	}
+	_, hasPercentage := coupon["discountPercentage"]
+	_, hasAbsolute := coupon["discountAbsolute"].(document)
+	switch stringField(coupon, "discountType") {
+	case "PERCENT":
+		if !hasPercentage || hasAbsolute {
+			writeFieldError(w, "discountPercentage", "must be the only discount of a PERCENT coupon")
+			return false
+		}
+	case "ABSOLUTE":
+		if !hasAbsolute || hasPercentage {
+			writeFieldError(w, "discountAbsolute", "must be the only discount of an ABSOLUTE coupon")
+			return false
+		}
+	case "FREE_SHIPPING":
+		if hasPercentage || hasAbsolute {
+			writeFieldError(w, "discountType", "a FREE_SHIPPING coupon has no discount value")
+			return false
+		}
+	default:
+		writeFieldError(w, "discountType", "must be one of PERCENT, ABSOLUTE, FREE_SHIPPING")
+		return false
+	}
+	return true
+}
+
+// Reward points configuration is a singleton of the tenant, seeded with the defaults
+
+func (s *Server) getRewardPointsConfig(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.rewardPoints)
+}
+
+func (s *Server) updateRewardPointsConfig(w http.ResponseWriter, r *http.Request) {
+	var update document
+	if !decodeBody(w, r, &update) {
+		return
+	}
+	if !checkVersion(w, s.rewardPoints, update) {
+		return
+	}
+	if rate, _ := update["earnRate"].(float64); rate < 0 {
+		writeFieldError(w, "earnRate", "must not be negative")
+		return
+	}
+	if rate, _ := update["redemptionRate"].(float64); rate <= 0 {
+		writeFieldError(w, "redemptionRate", "must be positive")
+		return
+	}
+
+	setMetadataVersion(update, versionOf(s.rewardPoints)+1)
+	s.rewardPoints = update
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,255 @@
+package emporixfake
+
+import "net/http"
+
+func (s *Server) registerIAMRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /iam/{tenant}/groups", s.createIAMGroup)
+	mux.HandleFunc("GET /iam/{tenant}/groups", s.listIAMGroups)
+	mux.HandleFunc("GET /iam/{tenant}/groups/{id}", s.getIAMGroup)
+	mux.HandleFunc("PUT /iam/{tenant}/groups/{id}", s.updateIAMGroup)
+	mux.HandleFunc("DELETE /iam/{tenant}/groups/{id}", s.deleteIAMGroup)
+
+	mux.HandleFunc("POST /iam/{tenant}/groups/{id}/users", s.addIAMGroupUser)
+	mux.HandleFunc("GET /iam/{tenant}/groups/{id}/users", s.listIAMGroupUsers)
+	mux.HandleFunc("DELETE /iam/{tenant}/groups/{id}/users/{userId}", s.removeIAMGroupUser)
+
+	mux.HandleFunc("POST /iam/{tenant}/api-keys", s.createAPIClient)
+	mux.HandleFunc("GET /iam/{tenant}/api-keys/{id}", s.getAPIClient)
+	mux.HandleFunc("PUT /iam/{tenant}/api-keys/{id}", s.updateAPIClient)
+	mux.HandleFunc("DELETE /iam/{tenant}/api-keys/{id}", s.deleteAPIClient)
+}
+
+// IAM groups
+
+func (s *Server) createIAMGroup(w http.ResponseWriter, r *http.Request) {
+	var group document
+	if !decodeBody(w, r, &group) {
+		return
+	}
+	if !validIAMGroup(w, group) {
+		return
+	}
+
+	id := s.newID("group")
+	group["id"] = id
+	setMetadataVersion(group, 1)
+	s.iamGroups.put(id, group)
+
+	writeJSON(w, http.StatusCreated, document{"id": id})
+}
+
+func (s *Server) listIAMGroups(w http.ResponseWriter, r *http.Request) {
+	listPage(w, r, s.iamGroups.list())
+}
+
+func (s *Server) getIAMGroup(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	group, ok := s.iamGroups.get(id)
+	if !ok {
+		writeNotFound(w, "Group", id)
+		return
+	}
+	writeJSON(w, http.StatusOK, group)
+}
+
+func (s *Server) updateIAMGroup(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	group, ok := s.iamGroups.get(id)
+	if !ok {
+		writeNotFound(w, "Group", id)
+		return
+	}
+
+	var update document
+	if !decodeBody(w, r, &update) {
+		return
+	}
+	if !checkVersion(w, group, update) {
+		return
+	}
+	if !validIAMGroup(w, update) {
+		return
+	}
+	if stringField(update, "userType") != stringField(group, "userType") {
+		writeFieldError(w, "userType", "cannot be changed")
+		return
+	}
+
+	update["id"] = id
+	setMetadataVersion(update, versionOf(group)+1)
+	s.iamGroups.put(id, update)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteIAMGroup(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if !s.iamGroups.remove(id) {
+		writeNotFound(w, "Group", id)
+		return
+	}
+	delete(s.iamGroupUsers, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validIAMGroup writes a 400 unless the group has a name and a known user type
+func validIAMGroup(w http.ResponseWriter, group document) bool {
+	if name, ok := group["name"].(document); !ok || len(name) == 0 {
+		writeFieldError(w, "name", "must not be empty")
+		return false
+	}
+	switch stringField(group, "userType") {
+	case "EMPLOYEE", "CUSTOMER":
+		return true
+	default:
+		writeFieldError(w, "userType", "must be EMPLOYEE or CUSTOMER")
+		return false
+	}
+}
+
+// Group members are stored per group, keyed by user ID. A user must be of the group's user type.
+
+func (s *Server) addIAMGroupUser(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	group, ok := s.iamGroups.get(id)
+	if !ok {
+		writeNotFound(w, "Group", id)
+		return
+	}
+
+	var membership document
+	if !decodeBody(w, r, &membership) {
+		return
+	}
+	userID := stringField(membership, "userId")
+	if userID == "" {
+		writeFieldError(w, "userId", "must not be empty")
+		return
+	}
+	if stringField(membership, "userType") != stringField(group, "userType") {
+		writeFieldError(w, "userType", "must match the user type of the group")
+		return
+	}
+
+	users, ok := s.iamGroupUsers[id]
+	if !ok {
+		users = newCollection()
+		s.iamGroupUsers[id] = users
+	}
+	if _, exists := users.get(userID); exists {
+		writeConflict(w, "User", userID)
+		return
+	}
+	users.put(userID, document{"id": userID, "userType": membership["userType"]})
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *Server) listIAMGroupUsers(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, ok := s.iamGroups.get(id); !ok {
+		writeNotFound(w, "Group", id)
+		return
+	}
+
+	var docs []document
+	if users, ok := s.iamGroupUsers[id]; ok {
+		docs = users.list()
+	}
+	listPage(w, r, docs)
+}
+
+func (s *Server) removeIAMGroupUser(w http.ResponseWriter, r *http.Request) {
+	id, userID := r.PathValue("id"), r.PathValue("userId")
+	users, ok := s.iamGroupUsers[id]
+	if !ok || !users.remove(userID) {
+		writeNotFound(w, "User", userID)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// API clients (custom API keys) get a generated client ID and secret. Like the real API,
+// the secret is only returned by the create call.
+
+func (s *Server) createAPIClient(w http.ResponseWriter, r *http.Request) {
+	var apiClient document
+	if !decodeBody(w, r, &apiClient) {
+		return
+	}
+	if !validAPIClient(w, apiClient) {
+		return
+	}
+
+	id := s.newID("apikey")
+	apiClient["id"] = id
+	apiClient["clientId"] = "client-" + id
+	setMetadataVersion(apiClient, 1)
+	s.apiClients.put(id, apiClient)
+
+	writeJSON(w, http.StatusCreated, document{
+		"id":           id,
+		"clientId":     apiClient["clientId"],
+		"clientSecret": "secret-" + id,
+	})
+}
+
+func (s *Server) getAPIClient(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	apiClient, ok := s.apiClients.get(id)
+	if !ok {
+		writeNotFound(w, "API key", id)
+		return
+	}
+	writeJSON(w, http.StatusOK, apiClient)
+}
+
+func (s *Server) updateAPIClient(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	apiClient, ok := s.apiClients.get(id)
+	if !ok {
+		writeNotFound(w, "API key", id)
+		return
+	}
+
+	var update document
+	if !decodeBody(w, r, &update) {
+		return
+	}
+	if !checkVersion(w, apiClient, update) {
+		return
+	}
+	if !validAPIClient(w, update) {
+		return
+	}
+
+	// The credentials stay the same, only name, description and scopes change
+	update["id"] = id
+	update["clientId"] = apiClient["clientId"]
+	setMetadataVersion(update, versionOf(apiClient)+1)
+	s.apiClients.put(id, update)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteAPIClient(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if !s.apiClients.remove(id) {
+		writeNotFound(w, "API key", id)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validAPIClient writes a 400 unless the API client has a name and at least one scope
+func validAPIClient(w http.ResponseWriter, apiClient document) bool {
+	if stringField(apiClient, "name") == "" {
+		writeFieldError(w, "name", "must not be empty")
+		return false
+	}
+	if scopes, ok := apiClient["scopes"].([]interface{}); !ok || len(scopes) == 0 {
+		writeFieldError(w, "scopes", "must not be empty")
+		return false
+	}
+	return true
+}
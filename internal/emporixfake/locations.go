@@ -0,0 +1,97 @@
+package emporixfake
+
+import "net/http"
+
+func (s *Server) registerLocationRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /availability/{tenant}/locations", s.createLocation)
+	mux.HandleFunc("GET /availability/{tenant}/locations", s.listLocations)
+	mux.HandleFunc("GET /availability/{tenant}/locations/{id}", s.getLocation)
+	mux.HandleFunc("PUT /availability/{tenant}/locations/{id}", s.updateLocation)
+	mux.HandleFunc("DELETE /availability/{tenant}/locations/{id}", s.deleteLocation)
+}
+
+func (s *Server) createLocation(w http.ResponseWriter, r *http.Request) {
+	var location document
+	if !decodeBody(w, r, &location) {
+		return
+	}
+	if !validLocation(w, location) {
+		return
+	}
+
+	id := s.newID("location")
+	location["id"] = id
+	setMetadataVersion(location, 1)
+	s.locations.put(id, location)
+
+	writeJSON(w, http.StatusCreated, document{"id": id})
+}
+
+func (s *Server) listLocations(w http.ResponseWriter, r *http.Request) {
+	listPage(w, r, s.locations.list())
+}
+
+func (s *Server) getLocation(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	location, ok := s.locations.get(id)
+	if !ok {
+		writeNotFound(w, "Location", id)
+		return
+	}
+	writeJSON(w, http.StatusOK, location)
+}
+
+func (s *Server) updateLocation(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	location, ok := s.locations.get(id)
+	if !ok {
+		writeNotFound(w, "Location", id)
+		return
+	}
+
+	var update document
+	if !decodeBody(w, r, &update) {
+		return
+	}
+	if !checkVersion(w, location, update) {
+		return
+	}
+	if !validLocation(w, update) {
+		return
+	}
+
+	update["id"] = id
+	setMetadataVersion(update, versionOf(location)+1)
+	s.locations.put(id, update)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteLocation(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if !s.locations.remove(id) {
+		writeNotFound(w, "Location", id)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validLocation writes a 400 unless the location has a name, a known type and an address with a country
+func validLocation(w http.ResponseWriter, location document) bool {
+	if stringField(location, "name") == "" {
+		writeFieldError(w, "name", "must not be empty")
+		return false
+	}
+	switch stringField(location, "type") {
+	case "WAREHOUSE", "STORE", "PICKUP_POINT":
+	default:
+		writeFieldError(w, "type", "must be one of WAREHOUSE, STORE, PICKUP_POINT")
+		return false
+	}
+	address, ok := location["address"].(document)
+	if !ok || stringField(address, "country") == "" {
+		writeFieldError(w, "address.country", "must not be empty")
+		return false
+	}
+	return true
+}
@@ -0,0 +1,282 @@
+package emporixfake
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func (s *Server) registerSchemaRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /schema/{tenant}/schemas", s.createSchema)
+	mux.HandleFunc("GET /schema/{tenant}/schemas", s.listSchemas)
+	mux.HandleFunc("GET /schema/{tenant}/schemas/{id}", s.getSchema)
+	mux.HandleFunc("PUT /schema/{tenant}/schemas/{id}", s.updateSchema)
+	mux.HandleFunc("DELETE /schema/{tenant}/schemas/{id}", s.deleteSchema)
+
+	mux.HandleFunc("POST /schema/{tenant}/custom-entities", s.createCustomEntityType)
+	mux.HandleFunc("GET /schema/{tenant}/custom-entities", s.listCustomEntityTypes)
+	mux.HandleFunc("GET /schema/{tenant}/custom-entities/{type}", s.getCustomEntityType)
+	mux.HandleFunc("PUT /schema/{tenant}/custom-entities/{type}", s.updateCustomEntityType)
+	mux.HandleFunc("DELETE /schema/{tenant}/custom-entities/{type}", s.deleteCustomEntityType)
+
+	mux.HandleFunc("POST /schema/{tenant}/custom-entities/{type}/instances", s.createCustomEntity)
+	mux.HandleFunc("GET /schema/{tenant}/custom-entities/{type}/instances", s.listCustomEntities)
+	mux.HandleFunc("GET /schema/{tenant}/custom-entities/{type}/instances/{id}", s.getCustomEntity)
+	mux.HandleFunc("PUT /schema/{tenant}/custom-entities/{type}/instances/{id}", s.updateCustomEntity)
+	mux.HandleFunc("DELETE /schema/{tenant}/custom-entities/{type}/instances/{id}", s.deleteCustomEntity)
+}
+
+// Schemas get a new version and schema file URL on every update
+
+func (s *Server) createSchema(w http.ResponseWriter, r *http.Request) {
+	var schema document
+	if !decodeBody(w, r, &schema) {
+		return
+	}
+
+	id := stringField(schema, "id")
+	if id == "" {
+		id = s.newID("schema")
+		schema["id"] = id
+	}
+	if _, exists := s.schemas.get(id); exists {
+		writeConflict(w, "Schema", id)
+		return
+	}
+
+	s.setSchemaVersion(schema, 1)
+	s.schemas.put(id, schema)
+
+	writeJSON(w, http.StatusCreated, document{"id": id})
+}
+
+func (s *Server) listSchemas(w http.ResponseWriter, r *http.Request) {
+	listPage(w, r, s.schemas.list())
+}
+
+func (s *Server) getSchema(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	schema, ok := s.schemas.get(id)
+	if !ok {
+		writeNotFound(w, "Schema", id)
+		return
+	}
+	writeJSON(w, http.StatusOK, schema)
+}
+
+func (s *Server) updateSchema(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	schema, ok := s.schemas.get(id)
+	if !ok {
+		writeNotFound(w, "Schema", id)
+		return
+	}
+
+	var update document
+	if !decodeBody(w, r, &update) {
+		return
+	}
+	if !checkVersion(w, schema, update) {
+		return
+	}
+
+	update["id"] = id
+	s.setSchemaVersion(update, versionOf(schema)+1)
+	s.schemas.put(id, update)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteSchema(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if !s.schemas.remove(id) {
+		writeNotFound(w, "Schema", id)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) setSchemaVersion(schema document, version int) {
+	schema["metadata"] = document{
+		"version": float64(version),
+		"url":     fmt.Sprintf("%s/schema-files/%s/v%d.json", s.URL, stringField(schema, "id"), version),
+	}
+}
+
+// Custom entity types own their instances; deleting a type removes them
+
+func (s *Server) createCustomEntityType(w http.ResponseWriter, r *http.Request) {
+	var entityType document
+	if !decodeBody(w, r, &entityType) {
+		return
+	}
+
+	id := stringField(entityType, "id")
+	if id == "" {
+		writeFieldError(w, "id", "must not be empty")
+		return
+	}
+	if _, exists := s.customEntityTypes.get(id); exists {
+		writeConflict(w, "Custom entity type", id)
+		return
+	}
+
+	setMetadataVersion(entityType, 1)
+	s.customEntityTypes.put(id, entityType)
+	s.customEntities[id] = newCollection()
+
+	writeJSON(w, http.StatusCreated, document{"id": id})
+}
+
+func (s *Server) listCustomEntityTypes(w http.ResponseWriter, r *http.Request) {
+	listPage(w, r, s.customEntityTypes.list())
+}
+
+func (s *Server) getCustomEntityType(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("type")
+	entityType, ok := s.customEntityTypes.get(id)
+	if !ok {
+		writeNotFound(w, "Custom entity type", id)
+		return
+	}
+	writeJSON(w, http.StatusOK, entityType)
+}
+
+func (s *Server) updateCustomEntityType(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("type")
+	entityType, ok := s.customEntityTypes.get(id)
+	if !ok {
+		writeNotFound(w, "Custom entity type", id)
+		return
+	}
+
+	var update document
+	if !decodeBody(w, r, &update) {
+		return
+	}
+	if !checkVersion(w, entityType, update) {
+		return
+	}
+
+	entityType["name"] = update["name"]
+	setMetadataVersion(entityType, versionOf(entityType)+1)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteCustomEntityType(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("type")
+	if !s.customEntityTypes.remove(id) {
+		writeNotFound(w, "Custom entity type", id)
+		return
+	}
+	delete(s.customEntities, id)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Custom entity instances
+
+// typeInstances returns the instances of a custom entity type, writing a 404 if the type does not exist
+func (s *Server) typeInstances(w http.ResponseWriter, r *http.Request) (*collection, bool) {
+	entityType := r.PathValue("type")
+	instances, ok := s.customEntities[entityType]
+	if !ok {
+		writeNotFound(w, "Custom entity type", entityType)
+		return nil, false
+	}
+	return instances, true
+}
+
+func (s *Server) createCustomEntity(w http.ResponseWriter, r *http.Request) {
+	instances, ok := s.typeInstances(w, r)
+	if !ok {
+		return
+	}
+
+	var instance document
+	if !decodeBody(w, r, &instance) {
+		return
+	}
+
+	id := stringField(instance, "id")
+	if id == "" {
+		id = s.newID("entity")
+		instance["id"] = id
+	}
+	if _, exists := instances.get(id); exists {
+		writeConflict(w, "Custom entity", id)
+		return
+	}
+
+	instance["type"] = r.PathValue("type")
+	setMetadataVersion(instance, 1)
+	instances.put(id, instance)
+
+	writeJSON(w, http.StatusCreated, document{"id": id})
+}
+
+func (s *Server) listCustomEntities(w http.ResponseWriter, r *http.Request) {
+	instances, ok := s.typeInstances(w, r)
+	if !ok {
+		return
+	}
+	listPage(w, r, instances.list())
+}
+
+func (s *Server) getCustomEntity(w http.ResponseWriter, r *http.Request) {
+	instances, ok := s.typeInstances(w, r)
+	if !ok {
+		return
+	}
+
+	id := r.PathValue("id")
+	instance, ok := instances.get(id)
+	if !ok {
+		writeNotFound(w, "Custom entity", id)
+		return
+	}
+	writeJSON(w, http.StatusOK, instance)
+}
+
+func (s *Server) updateCustomEntity(w http.ResponseWriter, r *http.Request) {
+	instances, ok := s.typeInstances(w, r)
+	if !ok {
+		return
+	}
+
+	id := r.PathValue("id")
+	instance, ok := instances.get(id)
+	if !ok {
+		writeNotFound(w, "Custom entity", id)
+		return
+	}
+
+	var update document
+	if !decodeBody(w, r, &update) {
+		return
+	}
+	if !checkVersion(w, instance, update) {
+		return
+	}
+
+	update["id"] = id
+	update["type"] = r.PathValue("type")
+	setMetadataVersion(update, versionOf(instance)+1)
+	instances.put(id, update)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteCustomEntity(w http.ResponseWriter, r *http.Request) {
+	instances, ok := s.typeInstances(w, r)
+	if !ok {
+		return
+	}
+
+	id := r.PathValue("id")
+	if !instances.remove(id) {
+		writeNotFound(w, "Custom entity", id)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
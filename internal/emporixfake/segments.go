@@ -0,0 +1,223 @@
+package emporixfake
+
+import "net/http"
+
+func (s *Server) registerCustomerSegmentRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /customer-segment/{tenant}/segments", s.createCustomerSegment)
+	mux.HandleFunc("GET /customer-segment/{tenant}/segments", s.listCustomerSegments)
+	mux.HandleFunc("GET /customer-segment/{tenant}/segments/{id}", s.getCustomerSegment)
+	mux.HandleFunc("PUT /customer-segment/{tenant}/segments/{id}", s.updateCustomerSegment)
+	mux.HandleFunc("DELETE /customer-segment/{tenant}/segments/{id}", s.deleteCustomerSegment)
+
+	mux.HandleFunc("GET /customer-segment/{tenant}/segments/{id}/customers", s.listSegmentCustomers)
+	mux.HandleFunc("PUT /customer-segment/{tenant}/segments/{id}/customers/{customerId}", s.addSegmentCustomer)
+	mux.HandleFunc("DELETE /customer-segment/{tenant}/segments/{id}/customers/{customerId}", s.removeSegmentCustomer)
+
+	mux.HandleFunc("POST /customer-segment/{tenant}/segments/{id}/items", s.createSegmentItem)
+	mux.HandleFunc("GET /customer-segment/{tenant}/segments/{id}/items", s.listSegmentItems)
+	mux.HandleFunc("DELETE /customer-segment/{tenant}/segments/{id}/items/{itemId}", s.deleteSegmentItem)
+}
+
+// Customer segments
+
+func (s *Server) createCustomerSegment(w http.ResponseWriter, r *http.Request) {
+	var segment document
+	if !decodeBody(w, r, &segment) {
+		return
+	}
+	if !validCustomerSegment(w, segment) {
+		return
+	}
+
+	id := s.newID("segment")
+	segment["id"] = id
+	setMetadataVersion(segment, 1)
+	s.customerSegments.put(id, segment)
+
+	writeJSON(w, http.StatusCreated, document{"id": id})
+}
+
+func (s *Server) listCustomerSegments(w http.ResponseWriter, r *http.Request) {
+	listPage(w, r, s.customerSegments.list())
+}
+
+func (s *Server) getCustomerSegment(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	segment, ok := s.customerSegments.get(id)
+	if !ok {
+		writeNotFound(w, "Segment", id)
+		return
+	}
+	writeJSON(w, http.StatusOK, segment)
+}
+
+func (s *Server) updateCustomerSegment(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	segment, ok := s.customerSegments.get(id)
+	if !ok {
+		writeNotFound(w, "Segment", id)
+		return
+	}
+
+	var update document
+	if !decodeBody(w, r, &update) {
+		return
+	}
+	if !checkVersion(w, segment, update) {
+		return
+	}
+	if !validCustomerSegment(w, update) {
+		return
+	}
+
+	update["id"] = id
+	setMetadataVersion(update, versionOf(segment)+1)
+	s.customerSegments.put(id, update)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteCustomerSegment(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if !s.customerSegments.remove(id) {
+		writeNotFound(w, "Segment", id)
+		return
+	}
+	delete(s.segmentCustomers, id)
+	delete(s.segmentItems, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validCustomerSegment writes a 400 unless the segment has a name and a site,
+// and its validity period doesn't end before it starts
+func validCustomerSegment(w http.ResponseWriter, segment document) bool {
+	if name, ok := segment["name"].(document); !ok || len(name) == 0 {
+		writeFieldError(w, "name", "must not be empty")
+		return false
+	}
+	if stringField(segment, "siteCode") == "" {
+		writeFieldError(w, "siteCode", "must not be empty")
+		return false
+	}
+	if validity, ok := segment["validity"].(document); ok {
+		from, to := stringField(validity, "from"), stringField(validity, "to")
+		if from != "" && to != "" && from > to {
+			writeFieldError(w, "validity.to", "must not be before validity.from")
+			return false
+		}
+	}
+	return true
+}
+
+// Segment members are stored per segment, keyed by customer ID. Adding a member is idempotent.
+
+func (s *Server) listSegmentCustomers(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, ok := s.customerSegments.get(id); !ok {
+		writeNotFound(w, "Segment", id)
+		return
+	}
+
+	var docs []document
+	if customers, ok := s.segmentCustomers[id]; ok {
+		docs = customers.list()
+	}
+	listPage(w, r, docs)
+}
+
+func (s *Server) addSegmentCustomer(w http.ResponseWriter, r *http.Request) {
+	id, customerID := r.PathValue("id"), r.PathValue("customerId")
+	if _, ok := s.customerSegments.get(id); !ok {
+		writeNotFound(w, "Segment", id)
+		return
+	}
+
+	customers, ok := s.segmentCustomers[id]
+	if !ok {
+		customers = newCollection()
+		s.segmentCustomers[id] = customers
+	}
+	customers.put(customerID, document{"id": customerID})
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) removeSegmentCustomer(w http.ResponseWriter, r *http.Request) {
+	id, customerID := r.PathValue("id"), r.PathValue("customerId")
+	customers, ok := s.segmentCustomers[id]
+	if !ok || !customers.remove(customerID) {
+		writeNotFound(w, "Customer", customerID)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Segment items are products and categories attached to a segment, stored per segment by their
+// generated ID. An object can be attached to a segment only once.
+
+func (s *Server) createSegmentItem(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, ok := s.customerSegments.get(id); !ok {
+		writeNotFound(w, "Segment", id)
+		return
+	}
+
+	var item document
+	if !decodeBody(w, r, &item) {
+		return
+	}
+	itemType := stringField(item, "type")
+	if itemType != "PRODUCT" && itemType != "CATEGORY" {
+		writeFieldError(w, "type", "must be PRODUCT or CATEGORY")
+		return
+	}
+	ref, _ := item["item"].(document)
+	refID := stringField(ref, "id")
+	if refID == "" {
+		writeFieldError(w, "item.id", "must not be empty")
+		return
+	}
+
+	items, ok := s.segmentItems[id]
+	if !ok {
+		items = newCollection()
+		s.segmentItems[id] = items
+	}
+	for _, existing := range items.list() {
+		existingRef, _ := existing["item"].(document)
+		if stringField(existing, "type") == itemType && stringField(existingRef, "id") == refID {
+			writeConflict(w, "Item", refID)
+			return
+		}
+	}
+
+	itemID := s.newID("item")
+	item["id"] = itemID
+	items.put(itemID, item)
+
+	writeJSON(w, http.StatusCreated, document{"id": itemID})
+}
+
+func (s *Server) listSegmentItems(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, ok := s.customerSegments.get(id); !ok {
+		writeNotFound(w, "Segment", id)
+		return
+	}
+
+	var docs []document
+	if items, ok := s.segmentItems[id]; ok {
+		docs = items.list()
+	}
+	listPage(w, r, docs)
+}
+
+func (s *Server) deleteSegmentItem(w http.ResponseWriter, r *http.Request) {
+	id, itemID := r.PathValue("id"), r.PathValue("itemId")
+	items, ok := s.segmentItems[id]
+	if !ok || !items.remove(itemID) {
+		writeNotFound(w, "Item", itemID)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,141 @@
+package emporixfake
+
+import "net/http"
+
+func (s *Server) registerSequentialIDRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /sequential-id/{tenant}/schemas", s.createSequenceSchema)
+	mux.HandleFunc("GET /sequential-id/{tenant}/schemas", s.listSequenceSchemas)
+	mux.HandleFunc("GET /sequential-id/{tenant}/schemas/{id}", s.getSequenceSchema)
+	mux.HandleFunc("PUT /sequential-id/{tenant}/schemas/{id}", s.updateSequenceSchema)
+	mux.HandleFunc("DELETE /sequential-id/{tenant}/schemas/{id}", s.deleteSequenceSchema)
+	mux.HandleFunc("POST /sequential-id/{tenant}/schemas/{id}/setActive", s.activateSequenceSchema)
+}
+
+// Every schema type has at most one active schema, kept in activeSequences.
+// New schemas are inactive; activating one deactivates the previous schema of its type.
+
+func (s *Server) createSequenceSchema(w http.ResponseWriter, r *http.Request) {
+	var schema document
+	if !decodeBody(w, r, &schema) {
+		return
+	}
+	if !validSequenceSchema(w, schema) {
+		return
+	}
+
+	id := s.newID("sequence")
+	schema["id"] = id
+	delete(schema, "active")
+	setMetadataVersion(schema, 1)
+	s.sequenceSchemas.put(id, schema)
+
+	writeJSON(w, http.StatusCreated, document{"id": id})
+}
+
+func (s *Server) listSequenceSchemas(w http.ResponseWriter, r *http.Request) {
+	docs := s.sequenceSchemas.list()
+	for i := range docs {
+		docs[i] = s.withActive(docs[i])
+	}
+	listPage(w, r, docs)
+}
+
+func (s *Server) getSequenceSchema(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	schema, ok := s.sequenceSchemas.get(id)
+	if !ok {
+		writeNotFound(w, "Schema", id)
+		return
+	}
+	writeJSON(w, http.StatusOK, s.withActive(schema))
+}
+
+func (s *Server) updateSequenceSchema(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	schema, ok := s.sequenceSchemas.get(id)
+	if !ok {
+		writeNotFound(w, "Schema", id)
+		return
+	}
+
+	var update document
+	if !decodeBody(w, r, &update) {
+		return
+	}
+	if !checkVersion(w, schema, update) {
+		return
+	}
+	if !validSequenceSchema(w, update) {
+		return
+	}
+	if stringField(update, "schemaType") != stringField(schema, "schemaType") {
+		writeFieldError(w, "schemaType", "cannot be changed")
+		return
+	}
+	if update["counterStart"] != schema["counterStart"] {
+		writeFieldError(w, "counterStart", "cannot be changed")
+		return
+	}
+
+	update["id"] = id
+	delete(update, "active")
+	setMetadataVersion(update, versionOf(schema)+1)
+	s.sequenceSchemas.put(id, update)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteSequenceSchema(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	schema, ok := s.sequenceSchemas.get(id)
+	if !ok {
+		writeNotFound(w, "Schema", id)
+		return
+	}
+	s.sequenceSchemas.remove(id)
+	if schemaType := stringField(schema, "schemaType"); s.activeSequences[schemaType] == id {
+		delete(s.activeSequences, schemaType)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) activateSequenceSchema(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	schema, ok := s.sequenceSchemas.get(id)
+	if !ok {
+		writeNotFound(w, "Schema", id)
+		return
+	}
+	s.activeSequences[stringField(schema, "schemaType")] = id
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// withActive returns a copy of the schema with its active flag set
+func (s *Server) withActive(schema document) document {
+	out := document{}
+	for k, v := range schema {
+		out[k] = v
+	}
+	out["active"] = s.activeSequences[stringField(schema, "schemaType")] == stringField(schema, "id")
+	return out
+}
+
+// validSequenceSchema writes a 400 unless the schema has a name and a type, and its counter
+// starts at or above zero and below its maximum value
+func validSequenceSchema(w http.ResponseWriter, schema document) bool {
+	if stringField(schema, "name") == "" {
+		writeFieldError(w, "name", "must not be empty")
+		return false
+	}
+	if stringField(schema, "schemaType") == "" {
+		writeFieldError(w, "schemaType", "must not be empty")
+		return false
+	}
+	counterStart, _ := schema["counterStart"].(float64)
+	maxValue, _ := schema["maxValue"].(float64)
+	if counterStart < 0 || counterStart >= maxValue {
+		writeFieldError(w, "counterStart", "must be at least 0 and below maxValue")
+		return false
+	}
+	return true
+}
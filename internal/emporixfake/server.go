@@ -0,0 +1,468 @@
+// Package emporixfake provides an in-process, stateful fake of the Emporix API endpoints
+// used by the provider, so the provider can be tested without a tenant or network access.
+//
+// The fake keeps every object in memory, hands out OAuth tokens for a fixed set of client
+// credentials, and mimics the API semantics the provider relies on: 404 for unknown objects,
+// 409 for duplicates and stale metadata versions, and version counters that increase on update.
+package emporixfake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	// DefaultTenant is the tenant served by a fake created with New
+	DefaultTenant = "faketenant"
+	// DefaultClientID is the client ID accepted by the fake's token endpoint
+	DefaultClientID = "fake-client-id"
+	// DefaultClientSecret is the client secret accepted by the fake's token endpoint
+	DefaultClientSecret = "fake-client-secret"
+
+	// tokenLifetime is the expires_in returned by the token endpoint, in seconds
+	tokenLifetime = 3599
+)
+
+// document is a JSON object as stored by the fake
+type document = map[string]interface{}
+
+// Server is a running fake Emporix API. Point the provider's api_url at Server.URL.
+type Server struct {
+	*httptest.Server
+
+	Tenant       string
+	ClientID     string
+	ClientSecret string
+
+	mu     sync.Mutex
+	tokens map[string]bool
+	nextID int
+
+	sites               *collection
+	paymentModes        *collection
+	countries           *collection
+	currencies          *collection
+	units               *collection
+	categories          *collection
+	categoryAssignments map[string]*collection // by category
+	priceLists          *collection
+	iamGroups           *collection
+	iamGroupUsers       map[string]*collection // by group
+	apiClients          *collection
+	customerSegments    *collection
+	segmentCustomers    map[string]*collection // by segment
+	segmentItems        map[string]*collection // by segment
+	locations           *collection
+	sequenceSchemas     *collection
+	activeSequences     map[string]string // schema ID by schema type
+	coupons             *collection
+	rewardPoints        document
+	taxes               *collection
+	configurations      *collection
+	webhooks            *collection
+	eventSubscriptions  map[string]string
+	shippingZones       map[string]*collection // by site
+	shippingMethods     map[string]*collection // by site and zone, see zoneKey
+	deliveryTimes       *collection
+	schemas             *collection
+	customEntityTypes   *collection
+	customEntities      map[string]*collection // by custom entity type
+}
+
+// New starts a fake for DefaultTenant with the default client credentials and a
+// pre-populated set of countries, like a fresh Emporix tenant. Call Close when done.
+func New() *Server {
+	s := &Server{
+		Tenant:       DefaultTenant,
+		ClientID:     DefaultClientID,
+		ClientSecret: DefaultClientSecret,
+
+		tokens:              map[string]bool{},
+		sites:               newCollection(),
+		paymentModes:        newCollection(),
+		countries:           newCollection(),
+		currencies:          newCollection(),
+		units:               newCollection(),
+		categories:          newCollection(),
+		categoryAssignments: map[string]*collection{},
+		priceLists:          newCollection(),
+		iamGroups:           newCollection(),
+		iamGroupUsers:       map[string]*collection{},
+		apiClients:          newCollection(),
+		customerSegments:    newCollection(),
+		segmentCustomers:    map[string]*collection{},
+		segmentItems:        map[string]*collection{},
+		locations:           newCollection(),
+		sequenceSchemas:     newCollection(),
+		activeSequences:     map[string]string{},
+		coupons:             newCollection(),
+		taxes:               newCollection(),
+		configurations:      newCollection(),
+		webhooks:            newCollection(),
+		eventSubscriptions:  map[string]string{},
+		shippingZones:       map[string]*collection{},
+		shippingMethods:     map[string]*collection{},
+		deliveryTimes:       newCollection(),
+		schemas:             newCollection(),
+		customEntityTypes:   newCollection(),
+		customEntities:      map[string]*collection{},
+	}
+	s.seed()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /oauth/token", s.handleToken)
+	s.registerSiteRoutes(mux)
+	s.registerCatalogRoutes(mux)
+	s.registerCategoryRoutes(mux)
+	s.registerIAMRoutes(mux)
+	s.registerCustomerSegmentRoutes(mux)
+	s.registerLocationRoutes(mux)
+	s.registerSequentialIDRoutes(mux)
+	s.registerCouponRoutes(mux)
+	s.registerWebhookRoutes(mux)
+	s.registerShippingRoutes(mux)
+	s.registerSchemaRoutes(mux)
+
+	s.Server = httptest.NewServer(s.authenticate(mux))
+	return s
+}
+
+// seed pre-populates the objects every Emporix tenant starts with
+func (s *Server) seed() {
+	countries := []struct {
+		code    string
+		name    string
+		regions []string
+	}{
+		{"AT", "Austria", []string{"EUROPE", "EU"}},
+		{"BE", "Belgium", []string{"EUROPE", "EU"}},
+		{"CA", "Canada", []string{"NORTH_AMERICA"}},
+		{"CH", "Switzerland", []string{"EUROPE"}},
+		{"DE", "Germany", []string{"EUROPE", "EU"}},
+		{"ES", "Spain", []string{"EUROPE", "EU"}},
+		{"FR", "France", []string{"EUROPE", "EU"}},
+		{"GB", "United Kingdom", []string{"EUROPE"}},
+		{"IT", "Italy", []string{"EUROPE", "EU"}},
+		{"NL", "Netherlands", []string{"EUROPE", "EU"}},
+		{"PL", "Poland", []string{"EUROPE", "EU"}},
+		{"US", "United States", []string{"NORTH_AMERICA"}},
+	}
+	for _, c := range countries {
+		regions := make([]interface{}, len(c.regions))
+		for i, r := range c.regions {
+			regions[i] = r
+		}
+		s.countries.put(c.code, document{
+			"code":     c.code,
+			"name":     document{"en": c.name},
+			"regions":  regions,
+			"active":   false,
+			"metadata": document{"version": float64(1)},
+		})
+	}
+
+	s.rewardPoints = document{
+		"active":            false,
+		"earnRate":          float64(1),
+		"redemptionRate":    float64(100),
+		"minPointsToRedeem": float64(0),
+		"pointsExpiryDays":  float64(0),
+		"metadata":          document{"version": float64(1)},
+	}
+
+	for _, eventType := range []string{"order.created", "order.updated", "customer.created", "product.created", "product.updated"} {
+		s.eventSubscriptions[eventType] = "NONE"
+	}
+}
+
+// handleToken implements the client_credentials grant of /oauth/token
+func (s *Server) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_request", err.Error())
+		return
+	}
+	if r.PostForm.Get("grant_type") != "client_credentials" {
+		writeError(w, http.StatusBadRequest, "unsupported_grant_type", "only client_credentials is supported")
+		return
+	}
+	if r.PostForm.Get("client_id") != s.ClientID || r.PostForm.Get("client_secret") != s.ClientSecret {
+		writeError(w, http.StatusUnauthorized, "invalid_client", "invalid client credentials")
+		return
+	}
+
+	s.mu.Lock()
+	s.nextID++
+	token := fmt.Sprintf("fake-token-%d", s.nextID)
+	s.tokens[token] = true
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, document{
+		"access_token": token,
+		"token_type":   "Bearer",
+		"expires_in":   tokenLifetime,
+		"scope":        r.PostForm.Get("scope"),
+	})
+}
+
+// RevokeTokens invalidates every issued access token, so the next API call answers 401
+func (s *Server) RevokeTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens = map[string]bool{}
+}
+
+// authenticate rejects API calls without a valid bearer token or for another tenant,
+// and serializes all requests so handlers can share state without further locking
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !s.tokens[token] {
+			writeError(w, http.StatusUnauthorized, "unauthorized", "missing or invalid access token")
+			return
+		}
+
+		// Every service path has the form /{service}/{tenant}/...
+		segments := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 3)
+		if len(segments) < 2 || segments[1] != strings.ToLower(s.Tenant) {
+			writeError(w, http.StatusForbidden, "forbidden", "access to this tenant is not allowed")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// newID returns a unique identifier for objects whose ID is generated by the API
+func (s *Server) newID(prefix string) string {
+	s.nextID++
+	return fmt.Sprintf("%s-%06d", prefix, s.nextID)
+}
+
+// collection is an insertion-ordered set of documents keyed by ID
+type collection struct {
+	keys  []string
+	items map[string]document
+}
+
+func newCollection() *collection {
+	return &collection{items: map[string]document{}}
+}
+
+func (c *collection) get(key string) (document, bool) {
+	doc, ok := c.items[key]
+	return doc, ok
+}
+
+func (c *collection) put(key string, doc document) {
+	if _, exists := c.items[key]; !exists {
+		c.keys = append(c.keys, key)
+	}
+	c.items[key] = doc
+}
+
+func (c *collection) remove(key string) bool {
+	if _, exists := c.items[key]; !exists {
+		return false
+	}
+	delete(c.items, key)
+	for i, k := range c.keys {
+		if k == key {
+			c.keys = append(c.keys[:i], c.keys[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+func (c *collection) list() []document {
+	docs := make([]document, 0, len(c.keys))
+	for _, key := range c.keys {
+		docs = append(docs, c.items[key])
+	}
+	return docs
+}
+
+// writeJSON writes v as the JSON response body with the given status
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error body in the format used by most Emporix services
+func writeError(w http.ResponseWriter, status int, errorType, message string) {
+	writeJSON(w, status, document{
+		"status":  status,
+		"type":    errorType,
+		"message": message,
+	})
+}
+
+// writeFieldError writes a validation error that points at a single request field
+func writeFieldError(w http.ResponseWriter, field, message string) {
+	writeJSON(w, http.StatusBadRequest, document{
+		"status":  http.StatusBadRequest,
+		"type":    "validation_violation",
+		"message": "Request body is invalid",
+		"details": []document{{"field": field, "type": "invalid_value", "message": message}},
+	})
+}
+
+func writeNotFound(w http.ResponseWriter, kind, id string) {
+	writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("%s with id %s not found", kind, id))
+}
+
+func writeConflict(w http.ResponseWriter, kind, id string) {
+	writeError(w, http.StatusConflict, "conflict", fmt.Sprintf("%s with id %s already exists", kind, id))
+}
+
+// decodeBody decodes the JSON request body into v, writing a 400 on failure
+func decodeBody(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_body", fmt.Sprintf("request body is not valid JSON: %s", err))
+		return false
+	}
+	return true
+}
+
+// stringField returns doc[key] if it is a non-empty string
+func stringField(doc document, key string) string {
+	s, _ := doc[key].(string)
+	return s
+}
+
+// versionOf returns the version stored under metadata.version (or at the top level for services that keep it there)
+func versionOf(doc document) int {
+	if metadata, ok := doc["metadata"].(document); ok {
+		if v, ok := metadata["version"].(float64); ok {
+			return int(v)
+		}
+	}
+	if v, ok := doc["version"].(float64); ok {
+		return int(v)
+	}
+	return 0
+}
+
+// setMetadataVersion stores version under metadata.version, keeping other metadata
+func setMetadataVersion(doc document, version int) {
+	metadata, ok := doc["metadata"].(document)
+	if !ok {
+		metadata = document{}
+		doc["metadata"] = metadata
+	}
+	metadata["version"] = float64(version)
+}
+
+// checkVersion enforces optimistic locking: a version sent in the request must match the stored one
+func checkVersion(w http.ResponseWriter, stored, requested document) bool {
+	requestedVersion := versionOf(requested)
+	if requestedVersion != 0 && requestedVersion != versionOf(stored) {
+		writeError(w, http.StatusConflict, "optimistic_lock_conflict",
+			fmt.Sprintf("version %d does not match current version %d", requestedVersion, versionOf(stored)))
+		return false
+	}
+	return true
+}
+
+// listPage applies the q filter and pageNumber/pageSize paging of Emporix list endpoints
+func listPage(w http.ResponseWriter, r *http.Request, docs []document) {
+	query := r.URL.Query()
+
+	if q := query.Get("q"); q != "" {
+		var filtered []document
+		for _, doc := range docs {
+			if matchesQuery(doc, q) {
+				filtered = append(filtered, doc)
+			}
+		}
+		docs = filtered
+	}
+
+	pageNumber, pageSize := 1, len(docs)
+	if v := query.Get("pageNumber"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			writeError(w, http.StatusBadRequest, "invalid_parameter", "pageNumber must be a positive integer")
+			return
+		}
+		pageNumber = n
+	}
+	if v := query.Get("pageSize"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			writeError(w, http.StatusBadRequest, "invalid_parameter", "pageSize must be a positive integer")
+			return
+		}
+		pageSize = n
+	}
+
+	start := (pageNumber - 1) * pageSize
+	if start > len(docs) {
+		start = len(docs)
+	}
+	end := start + pageSize
+	if end > len(docs) {
+		end = len(docs)
+	}
+
+	w.Header().Set("X-Total-Count", strconv.Itoa(len(docs)))
+	writeJSON(w, http.StatusOK, append([]document{}, docs[start:end]...))
+}
+
+// matchesQuery evaluates a space separated list of field:value conditions, all of which must match.
+// A condition on an array field matches when any element equals the value.
+func matchesQuery(doc document, q string) bool {
+	for _, condition := range strings.Fields(q) {
+		field, value, ok := strings.Cut(condition, ":")
+		if !ok {
+			return false
+		}
+		if !matchesValue(doc[field], value) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchesValue(fieldValue interface{}, value string) bool {
+	switch v := fieldValue.(type) {
+	case string:
+		return v == value
+	case bool:
+		return strconv.FormatBool(v) == value
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64) == value
+	case []interface{}:
+		for _, element := range v {
+			if matchesValue(element, value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// sortedKeys returns the keys of m in a stable order for deterministic responses
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
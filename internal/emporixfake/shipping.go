@@ -0,0 +1,320 @@
+package emporixfake
+
+import (
+	"net/http"
+)
+
+func (s *Server) registerShippingRoutes(mux *http.ServeMux) {
+	// /shipping/{tenant}/delivery-times/{id} and /shipping/{tenant}/{site}/zones overlap
+	// as mux patterns, so both are served through routeShipping
+	mux.HandleFunc("/shipping/{tenant}/{segment}/{name}", s.routeShipping)
+
+	mux.HandleFunc("GET /shipping/{tenant}/{site}/zones/{zone}", s.getShippingZone)
+	mux.HandleFunc("PUT /shipping/{tenant}/{site}/zones/{zone}", s.updateShippingZone)
+	mux.HandleFunc("DELETE /shipping/{tenant}/{site}/zones/{zone}", s.deleteShippingZone)
+
+	mux.HandleFunc("POST /shipping/{tenant}/{site}/zones/{zone}/methods", s.createShippingMethod)
+	mux.HandleFunc("GET /shipping/{tenant}/{site}/zones/{zone}/methods", s.listShippingMethods)
+	mux.HandleFunc("GET /shipping/{tenant}/{site}/zones/{zone}/methods/{id}", s.getShippingMethod)
+	mux.HandleFunc("PUT /shipping/{tenant}/{site}/zones/{zone}/methods/{id}", s.updateShippingMethod)
+	mux.HandleFunc("DELETE /shipping/{tenant}/{site}/zones/{zone}/methods/{id}", s.deleteShippingMethod)
+
+	mux.HandleFunc("POST /shipping/{tenant}/delivery-times", s.createDeliveryTime)
+	mux.HandleFunc("GET /shipping/{tenant}/delivery-times", s.listDeliveryTimes)
+}
+
+func (s *Server) routeShipping(w http.ResponseWriter, r *http.Request) {
+	segment, name := r.PathValue("segment"), r.PathValue("name")
+
+	switch {
+	case segment == "delivery-times":
+		r.SetPathValue("id", name)
+		switch r.Method {
+		case http.MethodGet:
+			s.getDeliveryTime(w, r)
+		case http.MethodPut:
+			s.updateDeliveryTime(w, r)
+		case http.MethodDelete:
+			s.deleteDeliveryTime(w, r)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	case name == "zones":
+		r.SetPathValue("site", segment)
+		switch r.Method {
+		case http.MethodGet:
+			s.listShippingZones(w, r)
+		case http.MethodPost:
+			s.createShippingZone(w, r)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// Shipping zones are stored per site
+
+func (s *Server) siteZones(site string) *collection {
+	zones, ok := s.shippingZones[site]
+	if !ok {
+		zones = newCollection()
+		s.shippingZones[site] = zones
+	}
+	return zones
+}
+
+func (s *Server) createShippingZone(w http.ResponseWriter, r *http.Request) {
+	var zone document
+	if !decodeBody(w, r, &zone) {
+		return
+	}
+
+	id := stringField(zone, "id")
+	if id == "" {
+		writeFieldError(w, "id", "must not be empty")
+		return
+	}
+	zones := s.siteZones(r.PathValue("site"))
+	if _, exists := zones.get(id); exists {
+		writeConflict(w, "Shipping zone", id)
+		return
+	}
+
+	if isDefault, _ := zone["default"].(bool); isDefault {
+		clearDefaultZone(zones)
+	}
+	zones.put(id, zone)
+
+	writeJSON(w, http.StatusCreated, zone)
+}
+
+func (s *Server) listShippingZones(w http.ResponseWriter, r *http.Request) {
+	listPage(w, r, s.siteZones(r.PathValue("site")).list())
+}
+
+func (s *Server) getShippingZone(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("zone")
+	zone, ok := s.siteZones(r.PathValue("site")).get(id)
+	if !ok {
+		writeNotFound(w, "Shipping zone", id)
+		return
+	}
+	writeJSON(w, http.StatusOK, zone)
+}
+
+func (s *Server) updateShippingZone(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("zone")
+	zones := s.siteZones(r.PathValue("site"))
+	if _, ok := zones.get(id); !ok {
+		writeNotFound(w, "Shipping zone", id)
+		return
+	}
+
+	var zone document
+	if !decodeBody(w, r, &zone) {
+		return
+	}
+
+	zone["id"] = id
+	if isDefault, _ := zone["default"].(bool); isDefault {
+		clearDefaultZone(zones)
+	}
+	zones.put(id, zone)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteShippingZone(w http.ResponseWriter, r *http.Request) {
+	site, id := r.PathValue("site"), r.PathValue("zone")
+	zones := s.siteZones(site)
+	zone, ok := zones.get(id)
+	if !ok {
+		writeNotFound(w, "Shipping zone", id)
+		return
+	}
+
+	// The default zone can only be removed once it is the last zone of the site
+	if isDefault, _ := zone["default"].(bool); isDefault && len(zones.keys) > 1 {
+		writeError(w, http.StatusConflict, "conflict", "The default shipping zone cannot be deleted while other zones exist")
+		return
+	}
+
+	zones.remove(id)
+	delete(s.shippingMethods, zoneKey(site, id))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func clearDefaultZone(zones *collection) {
+	for _, zone := range zones.list() {
+		zone["default"] = false
+	}
+}
+
+// Shipping methods are stored per site and zone
+
+func zoneKey(site, zone string) string {
+	return site + "/" + zone
+}
+
+// zoneMethods returns the methods of a zone, writing a 404 if the zone does not exist
+func (s *Server) zoneMethods(w http.ResponseWriter, r *http.Request) (*collection, bool) {
+	site, zone := r.PathValue("site"), r.PathValue("zone")
+	if _, ok := s.siteZones(site).get(zone); !ok {
+		writeNotFound(w, "Shipping zone", zone)
+		return nil, false
+	}
+
+	methods, ok := s.shippingMethods[zoneKey(site, zone)]
+	if !ok {
+		methods = newCollection()
+		s.shippingMethods[zoneKey(site, zone)] = methods
+	}
+	return methods, true
+}
+
+func (s *Server) createShippingMethod(w http.ResponseWriter, r *http.Request) {
+	methods, ok := s.zoneMethods(w, r)
+	if !ok {
+		return
+	}
+
+	var method document
+	if !decodeBody(w, r, &method) {
+		return
+	}
+
+	id := stringField(method, "id")
+	if id == "" {
+		writeFieldError(w, "id", "must not be empty")
+		return
+	}
+	if _, exists := methods.get(id); exists {
+		writeConflict(w, "Shipping method", id)
+		return
+	}
+	methods.put(id, method)
+
+	writeJSON(w, http.StatusCreated, method)
+}
+
+func (s *Server) listShippingMethods(w http.ResponseWriter, r *http.Request) {
+	methods, ok := s.zoneMethods(w, r)
+	if !ok {
+		return
+	}
+	listPage(w, r, methods.list())
+}
+
+func (s *Server) getShippingMethod(w http.ResponseWriter, r *http.Request) {
+	methods, ok := s.zoneMethods(w, r)
+	if !ok {
+		return
+	}
+
+	id := r.PathValue("id")
+	method, ok := methods.get(id)
+	if !ok {
+		writeNotFound(w, "Shipping method", id)
+		return
+	}
+	writeJSON(w, http.StatusOK, method)
+}
+
+func (s *Server) updateShippingMethod(w http.ResponseWriter, r *http.Request) {
+	methods, ok := s.zoneMethods(w, r)
+	if !ok {
+		return
+	}
+
+	id := r.PathValue("id")
+	if _, ok := methods.get(id); !ok {
+		writeNotFound(w, "Shipping method", id)
+		return
+	}
+
+	var method document
+	if !decodeBody(w, r, &method) {
+		return
+	}
+	method["id"] = id
+	methods.put(id, method)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteShippingMethod(w http.ResponseWriter, r *http.Request) {
+	methods, ok := s.zoneMethods(w, r)
+	if !ok {
+		return
+	}
+
+	id := r.PathValue("id")
+	if !methods.remove(id) {
+		writeNotFound(w, "Shipping method", id)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Delivery times get a generated ID
+
+func (s *Server) createDeliveryTime(w http.ResponseWriter, r *http.Request) {
+	var deliveryTime document
+	if !decodeBody(w, r, &deliveryTime) {
+		return
+	}
+
+	if stringField(deliveryTime, "name") == "" {
+		writeFieldError(w, "name", "must not be empty")
+		return
+	}
+
+	id := s.newID("deliverytime")
+	deliveryTime["id"] = id
+	s.deliveryTimes.put(id, deliveryTime)
+
+	writeJSON(w, http.StatusCreated, deliveryTime)
+}
+
+func (s *Server) listDeliveryTimes(w http.ResponseWriter, r *http.Request) {
+	listPage(w, r, s.deliveryTimes.list())
+}
+
+func (s *Server) getDeliveryTime(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	deliveryTime, ok := s.deliveryTimes.get(id)
+	if !ok {
+		writeNotFound(w, "Delivery time", id)
+		return
+	}
+	writeJSON(w, http.StatusOK, deliveryTime)
+}
+
+func (s *Server) updateDeliveryTime(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, ok := s.deliveryTimes.get(id); !ok {
+		writeNotFound(w, "Delivery time", id)
+		return
+	}
+
+	var deliveryTime document
+	if !decodeBody(w, r, &deliveryTime) {
+		return
+	}
+	deliveryTime["id"] = id
+	s.deliveryTimes.put(id, deliveryTime)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteDeliveryTime(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if !s.deliveryTimes.remove(id) {
+		writeNotFound(w, "Delivery time", id)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,244 @@
+package emporixfake
+
+import (
+	"net/http"
+	"strings"
+)
+
+func (s *Server) registerSiteRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /site/{tenant}/sites", s.createSite)
+	mux.HandleFunc("GET /site/{tenant}/sites", s.listSites)
+	mux.HandleFunc("GET /site/{tenant}/sites/{code}", s.getSite)
+	mux.HandleFunc("PATCH /site/{tenant}/sites/{code}", s.patchSite)
+	mux.HandleFunc("DELETE /site/{tenant}/sites/{code}", s.deleteSite)
+	mux.HandleFunc("POST /site/{tenant}/sites/{code}/mixins", s.postSiteMixins)
+	mux.HandleFunc("PUT /site/{tenant}/sites/{code}/mixins/{name}", s.putSiteMixin)
+	mux.HandleFunc("DELETE /site/{tenant}/sites/{code}/mixins/{name}", s.deleteSiteMixin)
+}
+
+func (s *Server) createSite(w http.ResponseWriter, r *http.Request) {
+	var site document
+	if !decodeBody(w, r, &site) {
+		return
+	}
+
+	code := stringField(site, "code")
+	if code == "" {
+		writeFieldError(w, "code", "must not be empty")
+		return
+	}
+	if _, exists := s.sites.get(code); exists {
+		writeConflict(w, "Site", code)
+		return
+	}
+
+	// Mixins are only accepted through the mixins endpoint
+	delete(site, "mixins")
+	delete(site, "metadata")
+	setMetadataVersion(site, 1)
+
+	if isDefault, _ := site["default"].(bool); isDefault {
+		s.clearDefaultSite()
+	}
+	s.sites.put(code, site)
+
+	writeJSON(w, http.StatusCreated, document{"code": code})
+}
+
+func (s *Server) listSites(w http.ResponseWriter, r *http.Request) {
+	sites := make([]document, 0)
+	for _, site := range s.sites.list() {
+		sites = append(sites, siteResponse(site, false))
+	}
+	listPage(w, r, sites)
+}
+
+func (s *Server) getSite(w http.ResponseWriter, r *http.Request) {
+	code := r.PathValue("code")
+	site, ok := s.sites.get(code)
+	if !ok {
+		writeNotFound(w, "Site", code)
+		return
+	}
+
+	expandMixins := strings.Contains(r.URL.Query().Get("expand"), "mixin")
+	writeJSON(w, http.StatusOK, siteResponse(site, expandMixins))
+}
+
+// patchSite applies a JSON merge patch: top-level fields are replaced, null removes a field
+func (s *Server) patchSite(w http.ResponseWriter, r *http.Request) {
+	code := r.PathValue("code")
+	site, ok := s.sites.get(code)
+	if !ok {
+		writeNotFound(w, "Site", code)
+		return
+	}
+
+	var patch document
+	if !decodeBody(w, r, &patch) {
+		return
+	}
+
+	for field, value := range patch {
+		switch field {
+		case "code", "mixins", "metadata":
+			continue
+		}
+		if value == nil {
+			delete(site, field)
+			continue
+		}
+		site[field] = value
+	}
+	if isDefault, _ := patch["default"].(bool); isDefault {
+		s.clearDefaultSite()
+		site["default"] = true
+	}
+	setMetadataVersion(site, versionOf(site)+1)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteSite(w http.ResponseWriter, r *http.Request) {
+	code := r.PathValue("code")
+	if !s.sites.remove(code) {
+		writeNotFound(w, "Site", code)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// postSiteMixins adds mixins sent as {"<name>": {<fields>, "metadata": {"schema": "<url>"}}}
+func (s *Server) postSiteMixins(w http.ResponseWriter, r *http.Request) {
+	code := r.PathValue("code")
+	site, ok := s.sites.get(code)
+	if !ok {
+		writeNotFound(w, "Site", code)
+		return
+	}
+
+	var mixins document
+	if !decodeBody(w, r, &mixins) {
+		return
+	}
+
+	for name, value := range mixins {
+		fields, ok := value.(document)
+		if !ok {
+			writeFieldError(w, name, "mixin must be a JSON object")
+			return
+		}
+		if _, exists := siteMixins(site)[name]; exists {
+			writeConflict(w, "Mixin", name)
+			return
+		}
+		setSiteMixin(site, name, fields)
+	}
+	setMetadataVersion(site, versionOf(site)+1)
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *Server) putSiteMixin(w http.ResponseWriter, r *http.Request) {
+	code := r.PathValue("code")
+	site, ok := s.sites.get(code)
+	if !ok {
+		writeNotFound(w, "Site", code)
+		return
+	}
+
+	var fields document
+	if !decodeBody(w, r, &fields) {
+		return
+	}
+
+	setSiteMixin(site, r.PathValue("name"), fields)
+	setMetadataVersion(site, versionOf(site)+1)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteSiteMixin(w http.ResponseWriter, r *http.Request) {
+	code := r.PathValue("code")
+	site, ok := s.sites.get(code)
+	if !ok {
+		writeNotFound(w, "Site", code)
+		return
+	}
+
+	name := r.PathValue("name")
+	if _, exists := siteMixins(site)[name]; !exists {
+		writeNotFound(w, "Mixin", name)
+		return
+	}
+
+	delete(siteMixins(site), name)
+	delete(siteMixinSchemas(site), name)
+	setMetadataVersion(site, versionOf(site)+1)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) clearDefaultSite() {
+	for _, site := range s.sites.list() {
+		site["default"] = false
+	}
+}
+
+// setSiteMixin stores the mixin fields under mixins and its schema URL under metadata.mixins
+func setSiteMixin(site document, name string, fields document) {
+	data := document{}
+	schemaURL := ""
+	for k, v := range fields {
+		if k == "metadata" {
+			if metadata, ok := v.(document); ok {
+				schemaURL, _ = metadata["schema"].(string)
+			}
+			continue
+		}
+		data[k] = v
+	}
+
+	siteMixins(site)[name] = data
+	siteMixinSchemas(site)[name] = schemaURL
+}
+
+func siteMixins(site document) document {
+	mixins, ok := site["mixins"].(document)
+	if !ok {
+		mixins = document{}
+		site["mixins"] = mixins
+	}
+	return mixins
+}
+
+func siteMixinSchemas(site document) document {
+	metadata, ok := site["metadata"].(document)
+	if !ok {
+		metadata = document{}
+		site["metadata"] = metadata
+	}
+	schemas, ok := metadata["mixins"].(document)
+	if !ok {
+		schemas = document{}
+		metadata["mixins"] = schemas
+	}
+	return schemas
+}
+
+// siteResponse copies a stored site, leaving out mixins unless they were expanded
+func siteResponse(site document, expandMixins bool) document {
+	response := document{}
+	for k, v := range site {
+		response[k] = v
+	}
+
+	mixins, _ := site["mixins"].(document)
+	if !expandMixins || len(mixins) == 0 {
+		delete(response, "mixins")
+		if metadata, ok := site["metadata"].(document); ok {
+			response["metadata"] = document{"version": metadata["version"]}
+		}
+	}
+	return response
+}
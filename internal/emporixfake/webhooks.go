@@ -0,0 +1,193 @@
+package emporixfake
+
+import (
+	"net/http"
+	"strings"
+)
+
+// secretFields are accepted on write but never returned; responses report secretKeyExists instead
+var secretFields = []string{"secretKey", "apiKey"}
+
+func (s *Server) registerWebhookRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /webhook/{tenant}/config", s.createWebhook)
+	mux.HandleFunc("GET /webhook/{tenant}/config", s.listWebhooks)
+	mux.HandleFunc("GET /webhook/{tenant}/config/{code}", s.getWebhook)
+	mux.HandleFunc("PATCH /webhook/{tenant}/config/{code}", s.patchWebhook)
+	mux.HandleFunc("DELETE /webhook/{tenant}/config/{code}", s.deleteWebhook)
+
+	mux.HandleFunc("GET /webhook/{tenant}/event-subscriptions", s.listEventSubscriptions)
+	mux.HandleFunc("PATCH /webhook/{tenant}/event-subscriptions", s.patchEventSubscriptions)
+}
+
+func (s *Server) createWebhook(w http.ResponseWriter, r *http.Request) {
+	var webhook document
+	if !decodeBody(w, r, &webhook) {
+		return
+	}
+
+	code := stringField(webhook, "code")
+	if code == "" {
+		writeFieldError(w, "code", "must not be empty")
+		return
+	}
+	if _, exists := s.webhooks.get(code); exists {
+		writeConflict(w, "Webhook configuration", code)
+		return
+	}
+
+	webhook["version"] = float64(1)
+	s.webhooks.put(code, webhook)
+
+	writeJSON(w, http.StatusCreated, document{"code": code})
+}
+
+func (s *Server) listWebhooks(w http.ResponseWriter, r *http.Request) {
+	configs := make([]document, 0)
+	for _, webhook := range s.webhooks.list() {
+		configs = append(configs, webhookResponse(webhook))
+	}
+	writeJSON(w, http.StatusOK, document{"configs": configs})
+}
+
+func (s *Server) getWebhook(w http.ResponseWriter, r *http.Request) {
+	code := r.PathValue("code")
+	webhook, ok := s.webhooks.get(code)
+	if !ok {
+		writeNotFound(w, "Webhook configuration", code)
+		return
+	}
+	writeJSON(w, http.StatusOK, webhookResponse(webhook))
+}
+
+// patchWebhook applies UPSERT and REMOVE operations on /active and
+// /configuration/{provider}/{field}. The provider segment only selects the
+// request schema; fields are stored flat under configuration.
+func (s *Server) patchWebhook(w http.ResponseWriter, r *http.Request) {
+	code := r.PathValue("code")
+	webhook, ok := s.webhooks.get(code)
+	if !ok {
+		writeNotFound(w, "Webhook configuration", code)
+		return
+	}
+
+	var operations []document
+	if !decodeBody(w, r, &operations) {
+		return
+	}
+
+	for _, operation := range operations {
+		op := stringField(operation, "op")
+		path := stringField(operation, "path")
+		if op != "UPSERT" && op != "REMOVE" {
+			writeFieldError(w, "op", "unsupported operation "+op)
+			return
+		}
+
+		if path == "/active" {
+			active, ok := operation["value"].(bool)
+			if op != "UPSERT" || !ok {
+				writeFieldError(w, "value", "/active requires an UPSERT with a boolean value")
+				return
+			}
+			webhook["active"] = active
+			continue
+		}
+
+		segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+		if len(segments) != 3 || segments[0] != "configuration" {
+			writeFieldError(w, "path", "unsupported path "+path)
+			return
+		}
+		configuration, ok := webhook["configuration"].(document)
+		if !ok {
+			configuration = document{}
+			webhook["configuration"] = configuration
+		}
+		if op == "REMOVE" {
+			delete(configuration, segments[2])
+		} else {
+			configuration[segments[2]] = operation["value"]
+		}
+	}
+	webhook["version"] = float64(versionOf(webhook) + 1)
+
+	writeJSON(w, http.StatusOK, webhookResponse(webhook))
+}
+
+func (s *Server) deleteWebhook(w http.ResponseWriter, r *http.Request) {
+	code := r.PathValue("code")
+	if !s.webhooks.remove(code) {
+		writeNotFound(w, "Webhook configuration", code)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listEventSubscriptions(w http.ResponseWriter, r *http.Request) {
+	entries := make([]document, 0, len(s.eventSubscriptions))
+	for _, eventType := range sortedKeys(s.eventSubscriptions) {
+		entries = append(entries, document{
+			"event":        document{"type": eventType},
+			"subscription": s.eventSubscriptions[eventType],
+		})
+	}
+	writeJSON(w, http.StatusOK, entries)
+}
+
+// patchEventSubscriptions reports a result per event type, like the real endpoint
+func (s *Server) patchEventSubscriptions(w http.ResponseWriter, r *http.Request) {
+	var updates []document
+	if !decodeBody(w, r, &updates) {
+		return
+	}
+
+	results := make([]document, 0, len(updates))
+	for _, update := range updates {
+		eventType := stringField(update, "eventType")
+		result := document{"eventType": eventType, "code": http.StatusOK, "status": "OK"}
+
+		_, known := s.eventSubscriptions[eventType]
+		switch action := stringField(update, "action"); {
+		case !known:
+			result["code"] = http.StatusNotFound
+			result["status"] = "NOT_FOUND"
+			result["message"] = "Unknown event type " + eventType
+		case action == "SUBSCRIBE":
+			s.eventSubscriptions[eventType] = "SUBSCRIBED"
+		case action == "UNSUBSCRIBE":
+			s.eventSubscriptions[eventType] = "UNSUBSCRIBED"
+		default:
+			result["code"] = http.StatusBadRequest
+			result["status"] = "BAD_REQUEST"
+			result["message"] = "Unknown action " + action
+		}
+		results = append(results, result)
+	}
+
+	writeJSON(w, http.StatusOK, results)
+}
+
+// webhookResponse copies a stored webhook with its secrets replaced by secretKeyExists
+func webhookResponse(webhook document) document {
+	response := document{}
+	for k, v := range webhook {
+		response[k] = v
+	}
+
+	configuration, ok := webhook["configuration"].(document)
+	if !ok {
+		return response
+	}
+	redacted := document{}
+	for k, v := range configuration {
+		redacted[k] = v
+	}
+	for _, field := range secretFields {
+		if _, exists := redacted[field]; exists {
+			delete(redacted, field)
+			redacted["secretKeyExists"] = true
+		}
+	}
+	response["configuration"] = redacted
+	return response
+}
@@ -0,0 +1,300 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// APIError is returned when the Emporix API answers with an unexpected status code.
+// Use errors.As (or AsAPIError) to inspect it; a 404 is still reported as NotFoundError.
+type APIError struct {
+	StatusCode int
+	Method     string
+	Path       string
+
+	// Code is the Emporix error code (errorCode or type in the error body), if any
+	Code string
+	// Message is the human readable message from the error body, if any
+	Message string
+	// Details holds the individual problems reported by the API, e.g. one per invalid field
+	Details []APIErrorDetail
+
+	// Body is the raw response body, kept for errors the API didn't return as JSON
+	Body string
+}
+
+// APIErrorDetail is a single problem reported in the details section of an Emporix error body
+type APIErrorDetail struct {
+	// Field is the API field the problem refers to, e.g. "taxClasses[0].rate"; empty for general problems
+	Field   string
+	Code    string
+	Message string
+}
+
+func (e *APIError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "unexpected status code: %d", e.StatusCode)
+	if e.Method != "" {
+		fmt.Fprintf(&b, " (%s %s)", e.Method, e.Path)
+	}
+
+	if e.Code == "" && e.Message == "" && len(e.Details) == 0 {
+		fmt.Fprintf(&b, ", body: %s", e.Body)
+		return b.String()
+	}
+
+	if e.Code != "" {
+		fmt.Fprintf(&b, ", code: %s", e.Code)
+	}
+	if e.Message != "" {
+		fmt.Fprintf(&b, ", message: %s", e.Message)
+	}
+	for _, detail := range e.Details {
+		b.WriteString("\n  - ")
+		b.WriteString(detail.String())
+	}
+	return b.String()
+}
+
+func (d APIErrorDetail) String() string {
+	message := d.Message
+	if message == "" {
+		message = d.Code
+	}
+	if d.Field == "" {
+		return message
+	}
+	return fmt.Sprintf("%s: %s", d.Field, message)
+}
+
+// FieldErrors returns the details that refer to a specific API field
+func (e *APIError) FieldErrors() []APIErrorDetail {
+	var fieldErrors []APIErrorDetail
+	for _, detail := range e.Details {
+		if detail.Field != "" {
+			fieldErrors = append(fieldErrors, detail)
+		}
+	}
+	return fieldErrors
+}
+
+// AsAPIError returns the APIError wrapped in err, if any
+func AsAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	return nil, false
+}
+
+// emporixErrorBody covers the error formats used across Emporix services, e.g.
+//
+//	{"status":400,"type":"validation_violation","message":"...","details":[{"field":"name","type":"missing_member","message":"..."}]}
+//	{"status":400,"errorCode":"INVALID_RATE","errorMessage":"...","details":["..."]}
+type emporixErrorBody struct {
+	Type         string            `json:"type"`
+	ErrorCode    json.RawMessage   `json:"errorCode"`
+	Message      string            `json:"message"`
+	ErrorMessage string            `json:"errorMessage"`
+	Details      []json.RawMessage `json:"details"`
+	Errors       []json.RawMessage `json:"errors"`
+}
+
+type emporixErrorDetail struct {
+	Field     string `json:"field"`
+	Property  string `json:"property"`
+	Type      string `json:"type"`
+	ErrorCode string `json:"errorCode"`
+	Message   string `json:"message"`
+}
+
+// newAPIError builds an APIError from a response, parsing the Emporix error body when possible
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Body:       string(body),
+	}
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		apiErr.Path = resp.Request.URL.Path
+	}
+
+	var parsed emporixErrorBody
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return apiErr
+	}
+
+	apiErr.Code = parsed.Type
+	if code := rawJSONString(parsed.ErrorCode); code != "" {
+		apiErr.Code = code
+	}
+	apiErr.Message = parsed.Message
+	if apiErr.Message == "" {
+		apiErr.Message = parsed.ErrorMessage
+	}
+
+	for _, raw := range append(parsed.Details, parsed.Errors...) {
+		var detail emporixErrorDetail
+		if err := json.Unmarshal(raw, &detail); err != nil {
+			// Some services return details as plain strings
+			var message string
+			if json.Unmarshal(raw, &message) == nil && message != "" {
+				apiErr.Details = append(apiErr.Details, APIErrorDetail{Message: message})
+			}
+			continue
+		}
+
+		field := detail.Field
+		if field == "" {
+			field = detail.Property
+		}
+		code := detail.Type
+		if code == "" {
+			code = detail.ErrorCode
+		}
+		apiErr.Details = append(apiErr.Details, APIErrorDetail{Field: field, Code: code, Message: detail.Message})
+	}
+
+	return apiErr
+}
+
+// rawJSONString returns a JSON string or number as a plain string
+func rawJSONString(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	var n json.Number
+	if err := json.Unmarshal(raw, &n); err == nil {
+		return n.String()
+	}
+	return ""
+}
+
+// apiFieldPathFunc maps a field reported by the API to a Terraform attribute path.
+// Resources use it for fields whose API name doesn't follow from the schema.
+type apiFieldPathFunc func(field string) (path.Path, bool)
+
+// schemaWithTypes is satisfied by the schema of a plan, state or config (e.g. req.Plan.Schema)
+type schemaWithTypes interface {
+	TypeAtPath(context.Context, path.Path) (attr.Type, diag.Diagnostics)
+}
+
+// apiFieldSegmentRegex splits an API field such as "taxClasses[0].rate" into names and list indexes
+var apiFieldSegmentRegex = regexp.MustCompile(`[^.\[\]]+|\[\d+\]`)
+
+// schemaFieldPath resolves an API field such as "taxClasses[0].rate" or "name.en" to the
+// matching Terraform attribute path (tax_classes[0].rate, name["en"]) by converting names
+// to snake_case and walking the schema. It stops at the deepest attribute that exists, so
+// an error inside e.g. a set or a JSON-encoded attribute still points at that attribute.
+func schemaFieldPath(ctx context.Context, s schemaWithTypes, field string) (path.Path, bool) {
+	var current path.Path
+	found := false
+
+	for _, segment := range apiFieldSegmentRegex.FindAllString(field, -1) {
+		var next path.Path
+
+		switch {
+		case !found:
+			if strings.HasPrefix(segment, "[") {
+				return current, false
+			}
+			next = path.Root(camelToSnake(segment))
+		default:
+			parentType, diags := s.TypeAtPath(ctx, current)
+			if diags.HasError() {
+				return current, found
+			}
+			switch parentType.(type) {
+			case types.ListType:
+				index, err := strconv.Atoi(strings.Trim(segment, "[]"))
+				if err != nil {
+					return current, found
+				}
+				next = current.AtListIndex(index)
+			case types.ObjectType:
+				next = current.AtName(camelToSnake(segment))
+			case types.MapType:
+				next = current.AtMapKey(segment)
+			default:
+				return current, found
+			}
+		}
+
+		if _, diags := s.TypeAtPath(ctx, next); diags.HasError() {
+			return current, found
+		}
+		current = next
+		found = true
+	}
+
+	return current, found
+}
+
+// camelToSnake converts an API field name such as "countryCode" to "country_code"
+func camelToSnake(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// addAPIErrorDiagnostics reports a failed API call. Field errors returned by the API are
+// attached to the matching Terraform attribute, so e.g. an invalid tax rate points at the
+// right line of configuration. Anything that can't be mapped is reported as a general error
+// with the given summary and detail, exactly as before.
+// fieldPath may be nil; when set it is tried before the schema-based mapping.
+func addAPIErrorDiagnostics(ctx context.Context, diags *diag.Diagnostics, s schemaWithTypes, fieldPath apiFieldPathFunc, err error, summary, detail string) {
+	apiErr, ok := AsAPIError(err)
+	if !ok || len(apiErr.FieldErrors()) == 0 {
+		diags.AddError(summary, detail)
+		return
+	}
+
+	unmapped := len(apiErr.FieldErrors()) < len(apiErr.Details)
+	for _, fieldErr := range apiErr.FieldErrors() {
+		attributePath, mapped := path.Empty(), false
+		if fieldPath != nil {
+			attributePath, mapped = fieldPath(fieldErr.Field)
+		}
+		if !mapped && s != nil {
+			attributePath, mapped = schemaFieldPath(ctx, s, fieldErr.Field)
+		}
+		if !mapped {
+			unmapped = true
+			continue
+		}
+
+		diags.AddAttributeError(
+			attributePath,
+			summary,
+			fmt.Sprintf("The Emporix API rejected this value (HTTP %d %s %s): %s", apiErr.StatusCode, apiErr.Method, apiErr.Path, fieldErr.String()),
+		)
+	}
+
+	if unmapped {
+		diags.AddError(summary, detail)
+	}
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func newTestErrorResponse(statusCode int) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Request: &http.Request{
+			Method: "POST",
+			URL:    &url.URL{Path: "/tax/test/taxes"},
+		},
+	}
+}
+
+func TestNewAPIError_ParsesValidationDetails(t *testing.T) {
+	body := `{"status":400,"type":"validation_violation","message":"There are validation problems","details":[{"field":"taxClasses[1].rate","type":"invalid_value","message":"must be less than or equal to 100"},{"type":"other","message":"general problem"}]}`
+
+	apiErr := newAPIError(newTestErrorResponse(http.StatusBadRequest), []byte(body))
+
+	if apiErr.StatusCode != 400 || apiErr.Method != "POST" || apiErr.Path != "/tax/test/taxes" {
+		t.Fatalf("unexpected request info: %+v", apiErr)
+	}
+	if apiErr.Code != "validation_violation" || apiErr.Message != "There are validation problems" {
+		t.Fatalf("unexpected code/message: %q / %q", apiErr.Code, apiErr.Message)
+	}
+	if len(apiErr.Details) != 2 {
+		t.Fatalf("expected 2 details, got %d", len(apiErr.Details))
+	}
+	fieldErrors := apiErr.FieldErrors()
+	if len(fieldErrors) != 1 || fieldErrors[0].Field != "taxClasses[1].rate" || fieldErrors[0].Code != "invalid_value" {
+		t.Fatalf("unexpected field errors: %+v", fieldErrors)
+	}
+	if !strings.Contains(apiErr.Error(), "taxClasses[1].rate: must be less than or equal to 100") {
+		t.Fatalf("expected field error in message, got: %s", apiErr.Error())
+	}
+}
+
+func TestNewAPIError_ParsesErrorCodeAndStringDetails(t *testing.T) {
+	body := `{"status":409,"errorCode":"ALREADY_EXISTS","errorMessage":"Currency already exists","details":["code EUR is taken"]}`
+
+	apiErr := newAPIError(newTestErrorResponse(http.StatusConflict), []byte(body))
+
+	if apiErr.Code != "ALREADY_EXISTS" || apiErr.Message != "Currency already exists" {
+		t.Fatalf("unexpected code/message: %q / %q", apiErr.Code, apiErr.Message)
+	}
+	if len(apiErr.Details) != 1 || apiErr.Details[0].Message != "code EUR is taken" {
+		t.Fatalf("unexpected details: %+v", apiErr.Details)
+	}
+}
+
+func TestNewAPIError_KeepsRawBodyWhenNotJSON(t *testing.T) {
+	apiErr := newAPIError(newTestErrorResponse(http.StatusInternalServerError), []byte("upstream failure"))
+
+	if got := apiErr.Error(); !strings.Contains(got, "unexpected status code: 500") || !strings.Contains(got, "body: upstream failure") {
+		t.Fatalf("unexpected error message: %s", got)
+	}
+}
+
+func TestAsAPIError_WorksThroughWrapping(t *testing.T) {
+	err := fmt.Errorf("failed to create tax: %w", newAPIError(newTestErrorResponse(http.StatusBadRequest), []byte(`{}`)))
+
+	apiErr, ok := AsAPIError(err)
+	if !ok || apiErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected wrapped APIError to be found, got %v", err)
+	}
+	if IsNotFound(err) {
+		t.Fatalf("an APIError must not be reported as not found")
+	}
+}
+
+func TestSchemaFieldPath(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewTaxResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	testCases := map[string]struct {
+		field    string
+		expected path.Path
+		found    bool
+	}{
+		"top-level attribute":     {field: "countryCode", expected: path.Root("country_code"), found: true},
+		"list element attribute":  {field: "taxClasses[1].rate", expected: path.Root("tax_classes").AtListIndex(1).AtName("rate"), found: true},
+		"map key":                 {field: "taxClasses[0].name.en", expected: path.Root("tax_classes").AtListIndex(0).AtName("name").AtMapKey("en"), found: true},
+		"unknown nested field":    {field: "taxClasses[0].unknown", expected: path.Root("tax_classes").AtListIndex(0), found: true},
+		"unknown top-level field": {field: "somethingElse", found: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, found := schemaFieldPath(ctx, schemaResp.Schema, tc.field)
+			if found != tc.found {
+				t.Fatalf("expected found=%t, got %t", tc.found, found)
+			}
+			if found && !got.Equal(tc.expected) {
+				t.Fatalf("expected path %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
+
+// Shipping methods nest monetary amounts in objects and in the fees list
+func TestSchemaFieldPath_ShippingMethod(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewShippingMethodResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	testCases := map[string]struct {
+		field    string
+		expected path.Path
+	}{
+		"object attribute":              {field: "maxOrderValue.currency", expected: path.Root("max_order_value").AtName("currency")},
+		"list element object attribute": {field: "fees[1].cost.amount", expected: path.Root("fees").AtListIndex(1).AtName("cost").AtName("amount")},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, found := schemaFieldPath(ctx, schemaResp.Schema, tc.field)
+			if !found || !got.Equal(tc.expected) {
+				t.Fatalf("expected path %s, got %s (found=%t)", tc.expected, got, found)
+			}
+		})
+	}
+}
+
+func TestAddAPIErrorDiagnostics(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewTaxResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	t.Run("field errors become attribute errors", func(t *testing.T) {
+		err := newAPIError(newTestErrorResponse(http.StatusBadRequest),
+			[]byte(`{"details":[{"field":"taxClasses[0].rate","message":"invalid rate"},{"field":"location.countryCode","message":"unknown country"}]}`))
+
+		var diags diag.Diagnostics
+		addAPIErrorDiagnostics(ctx, &diags, schemaResp.Schema, taxFieldPath, err, "Client Error", "Unable to create tax configuration")
+
+		if diags.ErrorsCount() != 2 {
+			t.Fatalf("expected 2 errors, got %d: %v", diags.ErrorsCount(), diags)
+		}
+		expected := []path.Path{
+			path.Root("tax_classes").AtListIndex(0).AtName("rate"),
+			path.Root("country_code"),
+		}
+		for i, d := range diags.Errors() {
+			withPath, ok := d.(diag.DiagnosticWithPath)
+			if !ok || !withPath.Path().Equal(expected[i]) {
+				t.Fatalf("expected diagnostic %d at %s, got %v", i, expected[i], d)
+			}
+		}
+	})
+
+	t.Run("unmapped errors fall back to a general error", func(t *testing.T) {
+		err := newAPIError(newTestErrorResponse(http.StatusBadRequest),
+			[]byte(`{"details":[{"field":"somethingElse","message":"bad"}]}`))
+
+		var diags diag.Diagnostics
+		addAPIErrorDiagnostics(ctx, &diags, schemaResp.Schema, nil, err, "Client Error", "Unable to create tax configuration")
+
+		if diags.ErrorsCount() != 1 {
+			t.Fatalf("expected 1 error, got %d", diags.ErrorsCount())
+		}
+		if _, ok := diags.Errors()[0].(diag.DiagnosticWithPath); ok {
+			t.Fatalf("expected a general error without attribute path")
+		}
+	})
+}
@@ -63,6 +63,23 @@ func getWebhookMutex(tenant string) *sync.Mutex {
 	return webhookMutexes[tenant]
 }
 
+// Global mutex map for per-tenant sequential ID schema activation
+var (
+	sequentialIDSchemaMutexes     = make(map[string]*sync.Mutex)
+	sequentialIDSchemaMutexesLock sync.Mutex
+)
+
+// getSequentialIDSchemaMutex returns the mutex for a specific tenant's sequential ID schema activation
+func getSequentialIDSchemaMutex(tenant string) *sync.Mutex {
+	sequentialIDSchemaMutexesLock.Lock()
+	defer sequentialIDSchemaMutexesLock.Unlock()
+
+	if _, exists := sequentialIDSchemaMutexes[tenant]; !exists {
+		sequentialIDSchemaMutexes[tenant] = &sync.Mutex{}
+	}
+	return sequentialIDSchemaMutexes[tenant]
+}
+
 // Global mutex map for per-tenant shipping method operations
 var (
 	shippingMethodMutexes     = make(map[string]*sync.Mutex)
@@ -80,28 +97,85 @@ func getShippingMethodMutex(tenant string) *sync.Mutex {
 	return shippingMethodMutexes[tenant]
 }
 
+// Global mutex map for per-tenant language operations, which all rewrite the project_lang configuration
+var (
+	languageMutexes     = make(map[string]*sync.Mutex)
+	languageMutexesLock sync.Mutex
+)
+
+// getLanguageMutex returns the mutex for a specific tenant's language operations
+func getLanguageMutex(tenant string) *sync.Mutex {
+	languageMutexesLock.Lock()
+	defer languageMutexesLock.Unlock()
+
+	if _, exists := languageMutexes[tenant]; !exists {
+		languageMutexes[tenant] = &sync.Mutex{}
+	}
+	return languageMutexes[tenant]
+}
+
+// Global mutex map for per-tenant category operations. Creating, moving or deleting a category
+// renumbers the positions of its siblings, so concurrent writes to the tree must not interleave.
+var (
+	categoryMutexes     = make(map[string]*sync.Mutex)
+	categoryMutexesLock sync.Mutex
+)
+
+// getCategoryMutex returns the mutex for a specific tenant's category tree operations
+func getCategoryMutex(tenant string) *sync.Mutex {
+	categoryMutexesLock.Lock()
+	defer categoryMutexesLock.Unlock()
+
+	if _, exists := categoryMutexes[tenant]; !exists {
+		categoryMutexes[tenant] = &sync.Mutex{}
+	}
+	return categoryMutexes[tenant]
+}
+
+// httpTransport is the RoundTripper used for API and OAuth requests; nil means
+// http.DefaultTransport. Acceptance tests swap it to record or replay interactions.
+var httpTransport http.RoundTripper
+
 type EmporixClient struct {
-	Tenant      string
-	AccessToken string
-	ApiUrl      string
-	httpClient  *http.Client
+	Tenant     string
+	ApiUrl     string
+	httpClient *http.Client
+	tokens     tokenSource
+
+	// MaxRetries is the number of retries after the first attempt for throttled (429)
+	// or transient gateway (502/503/504) responses. Zero disables retries.
+	MaxRetries int
+	// RetryWaitMin is the base delay of the exponential backoff between retries
+	RetryWaitMin time.Duration
+	// RetryWaitMax caps the delay between retries, including delays requested via Retry-After
+	RetryWaitMax time.Duration
 }
 
+// NewEmporixClient creates a client that authenticates with a fixed access token
 func NewEmporixClient(tenant, accessToken, apiUrl string) *EmporixClient {
+	return newEmporixClientWithTokenSource(tenant, &staticTokenSource{token: accessToken}, apiUrl)
+}
+
+// newEmporixClientWithTokenSource creates a client that asks the given token source for
+// a bearer token on every request, so tokens can be refreshed during long applies
+func newEmporixClientWithTokenSource(tenant string, tokens tokenSource, apiUrl string) *EmporixClient {
 	return &EmporixClient{
-		Tenant:      tenant,
-		AccessToken: accessToken,
-		ApiUrl:      apiUrl,
+		Tenant: tenant,
+		ApiUrl: apiUrl,
+		tokens: tokens,
 		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout:   30 * time.Second,
+			Transport: httpTransport,
 		},
+		MaxRetries:   defaultMaxRetries,
+		RetryWaitMin: defaultRetryWaitMin,
+		RetryWaitMax: defaultRetryWaitMax,
 	}
 }
 
 func (c *EmporixClient) doRequest(ctx context.Context, method, path string, body interface{}, headers map[string]string) (*http.Response, error) {
 	url := fmt.Sprintf("%s%s", c.ApiUrl, path)
 
-	var bodyReader io.Reader
 	var bodyBytes []byte
 	if body != nil {
 		var err error
@@ -109,58 +183,117 @@ func (c *EmporixClient) doRequest(ctx context.Context, method, path string, body
 		if err != nil {
 			return nil, fmt.Errorf("error marshaling request body: %w", err)
 		}
-		bodyReader = bytes.NewBuffer(bodyBytes)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
-	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
-	}
+	// A 401 is replayed once with a fresh token; it doesn't count against MaxRetries
+	tokenRefreshed := false
+
+	for attempt := 0; ; attempt++ {
+		accessToken, err := c.tokens.Token(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error obtaining access token: %w", err)
+		}
 
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.AccessToken))
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "*/*")
+		// The body reader is consumed by each attempt, so rebuild it from the marshaled bytes
+		var bodyReader io.Reader
+		if bodyBytes != nil {
+			bodyReader = bytes.NewBuffer(bodyBytes)
+		}
 
-	// Add custom headers if provided
-	for key, value := range headers {
-		req.Header.Set(key, value)
-	}
+		req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
+		if err != nil {
+			return nil, fmt.Errorf("error creating request: %w", err)
+		}
 
-	// Log request
-	c.logRequest(ctx, req, bodyBytes)
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", accessToken))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Accept", "*/*")
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("error making request: %w", err)
-	}
+		// Add custom headers if provided
+		for key, value := range headers {
+			req.Header.Set(key, value)
+		}
 
-	// Read body once for logging (and error checking)
-	var respBody []byte
-	if resp.Body != nil {
-		respBody, err = io.ReadAll(resp.Body)
-		resp.Body.Close()
+		// Log request
+		c.logRequest(ctx, req, bodyBytes)
+
+		resp, err := c.httpClient.Do(req)
 		if err != nil {
-			return nil, fmt.Errorf("error reading response body: %w", err)
+			if attempt < c.MaxRetries && shouldRetryError(ctx, method, err) {
+				if waitErr := c.waitBeforeRetry(ctx, method, url, attempt, nil, err.Error()); waitErr != nil {
+					return nil, fmt.Errorf("error making request: %w", err)
+				}
+				continue
+			}
+			return nil, fmt.Errorf("error making request: %w", err)
+		}
+
+		// Read body once for logging (and error checking)
+		var respBody []byte
+		if resp.Body != nil {
+			respBody, err = io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err != nil {
+				return nil, fmt.Errorf("error reading response body: %w", err)
+			}
+			// Restore body for caller
+			resp.Body = io.NopCloser(bytes.NewBuffer(respBody))
+		}
+
+		// Log response with body
+		c.logResponseWithBody(ctx, resp.StatusCode, resp.Status, respBody)
+
+		// The token may have expired or been revoked early; a 401 means the request was
+		// rejected before being processed, so replaying it is safe for every method
+		if resp.StatusCode == http.StatusUnauthorized && !tokenRefreshed && c.tokens.Invalidate(accessToken) {
+			tflog.Debug(ctx, "API rejected the access token, refreshing it and replaying the request", map[string]interface{}{
+				"subsystem": "http",
+				"method":    method,
+				"url":       url,
+			})
+			tokenRefreshed = true
+			attempt--
+			continue
 		}
-		// Restore body for caller
-		resp.Body = io.NopCloser(bytes.NewBuffer(respBody))
+
+		if attempt < c.MaxRetries && shouldRetryResponse(method, resp.StatusCode) {
+			if waitErr := c.waitBeforeRetry(ctx, method, url, attempt, resp, resp.Status); waitErr != nil {
+				// Context is done; hand the last response to the caller so it reports the real status
+				return resp, nil
+			}
+			continue
+		}
+
+		return resp, nil
 	}
+}
 
-	// Log response with body
-	c.logResponseWithBody(ctx, resp.StatusCode, resp.Status, respBody)
+// waitBeforeRetry logs the upcoming retry and sleeps for the backoff delay of the given attempt
+func (c *EmporixClient) waitBeforeRetry(ctx context.Context, method, url string, attempt int, resp *http.Response, reason string) error {
+	wait := retryBackoff(attempt, c.RetryWaitMin, c.RetryWaitMax, resp)
+
+	tflog.Warn(ctx, "Retrying API request", map[string]interface{}{
+		"subsystem":   "http",
+		"method":      method,
+		"url":         url,
+		"reason":      reason,
+		"attempt":     attempt + 1,
+		"max_retries": c.MaxRetries,
+		"wait":        wait.String(),
+	})
 
-	return resp, nil
+	return sleepWithContext(ctx, wait)
 }
 
-// checkResponse validates HTTP response status and returns detailed error
-func (c *EmporixClient) checkResponse(ctx context.Context, statusCode int, body []byte, expectedStatuses ...int) error {
+// checkResponse validates HTTP response status and returns an *APIError for unexpected statuses
+func (c *EmporixClient) checkResponse(ctx context.Context, resp *http.Response, body []byte, expectedStatuses ...int) error {
 	for _, expected := range expectedStatuses {
-		if statusCode == expected {
+		if resp.StatusCode == expected {
 			return nil
 		}
 	}
 
-	return fmt.Errorf("unexpected status code: %d, body: %s", statusCode, string(body))
+	return newAPIError(resp, body)
 }
 
 func (c *EmporixClient) logRequest(ctx context.Context, req *http.Request, bodyBytes []byte) {
@@ -237,7 +370,7 @@ func (c *EmporixClient) CreateSite(ctx context.Context, site *SiteSettings) erro
 	if readErr != nil {
 		return fmt.Errorf("error reading response body: %w", readErr)
 	}
-	return c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusCreated)
+	return c.checkResponse(ctx, resp, bodyBytes, http.StatusCreated)
 }
 
 func (c *EmporixClient) GetSite(ctx context.Context, siteCode string) (*SiteSettings, error) {
@@ -257,7 +390,7 @@ func (c *EmporixClient) GetSite(ctx context.Context, siteCode string) (*SiteSett
 	if readErr != nil {
 		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusOK); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
 		return nil, err
 	}
 
@@ -269,6 +402,13 @@ func (c *EmporixClient) GetSite(ctx context.Context, siteCode string) (*SiteSett
 	return &site, nil
 }
 
+// ListSites retrieves all sites of the tenant, following pagination. Mixins are not expanded.
+func (c *EmporixClient) ListSites(ctx context.Context, opts *ListOptions) ([]SiteSettings, error) {
+	path := fmt.Sprintf("/site/%s/sites", strings.ToLower(c.Tenant))
+
+	return listAllPages[SiteSettings](ctx, c, path, opts, nil)
+}
+
 func (c *EmporixClient) UpdateSite(ctx context.Context, siteCode string, patchData map[string]interface{}) error {
 	path := fmt.Sprintf("/site/%s/sites/%s", strings.ToLower(c.Tenant), siteCode)
 	resp, err := c.doRequest(ctx, "PATCH", path, patchData, nil)
@@ -282,7 +422,7 @@ func (c *EmporixClient) UpdateSite(ctx context.Context, siteCode string, patchDa
 	if readErr != nil {
 		return fmt.Errorf("error reading response body: %w", readErr)
 	}
-	return c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusNoContent, http.StatusOK)
+	return c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent, http.StatusOK)
 }
 
 func (c *EmporixClient) DeleteSite(ctx context.Context, siteCode string) error {
@@ -298,7 +438,7 @@ func (c *EmporixClient) DeleteSite(ctx context.Context, siteCode string) error {
 	if readErr != nil {
 		return fmt.Errorf("error reading response body: %w", readErr)
 	}
-	return c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusNoContent, http.StatusOK)
+	return c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent, http.StatusOK)
 }
 
 // PostSiteMixin creates a new mixin using POST
@@ -327,7 +467,7 @@ func (c *EmporixClient) PostSiteMixin(ctx context.Context, siteCode string, mixi
 	if readErr != nil {
 		return fmt.Errorf("error reading response body: %w", readErr)
 	}
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusCreated, http.StatusOK); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusCreated, http.StatusOK); err != nil {
 		return fmt.Errorf("failed to create site mixin %s: %w", mixinName, err)
 	}
 
@@ -357,7 +497,7 @@ func (c *EmporixClient) PutSiteMixin(ctx context.Context, siteCode string, mixin
 	if readErr != nil {
 		return fmt.Errorf("error reading response body: %w", readErr)
 	}
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusOK, http.StatusNoContent); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK, http.StatusNoContent); err != nil {
 		return fmt.Errorf("failed to put site mixin %s: %w", mixinName, err)
 	}
 
@@ -378,7 +518,7 @@ func (c *EmporixClient) DeleteSiteMixin(ctx context.Context, siteCode string, mi
 	if readErr != nil {
 		return fmt.Errorf("error reading response body: %w", readErr)
 	}
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusOK, http.StatusNoContent); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK, http.StatusNoContent); err != nil {
 		return fmt.Errorf("failed to delete mixin %s: %w", mixinName, err)
 	}
 
@@ -399,7 +539,7 @@ func (c *EmporixClient) CreatePaymentMode(ctx context.Context, paymentMode *Paym
 	if readErr != nil {
 		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusOK); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
 		return nil, err
 	}
 
@@ -411,88 +551,2008 @@ func (c *EmporixClient) CreatePaymentMode(ctx context.Context, paymentMode *Paym
 	return &created, nil
 }
 
-// GetPaymentMode retrieves a single payment mode by ID
-func (c *EmporixClient) GetPaymentMode(ctx context.Context, id string) (*PaymentMode, error) {
-	path := fmt.Sprintf("/payment-gateway/%s/paymentmodes/config/%s", strings.ToLower(c.Tenant), id)
-	resp, err := c.doRequest(ctx, "GET", path, nil, nil)
+// GetPaymentMode retrieves a single payment mode by ID
+func (c *EmporixClient) GetPaymentMode(ctx context.Context, id string) (*PaymentMode, error) {
+	path := fmt.Sprintf("/payment-gateway/%s/paymentmodes/config/%s", strings.ToLower(c.Tenant), id)
+	resp, err := c.doRequest(ctx, "GET", path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{}
+	}
+
+	// Read body (already logged in doRequest)
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	var paymentMode PaymentMode
+	if err := json.Unmarshal(bodyBytes, &paymentMode); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	return &paymentMode, nil
+}
+
+// ListPaymentModes retrieves all payment modes of the tenant
+func (c *EmporixClient) ListPaymentModes(ctx context.Context) ([]PaymentMode, error) {
+	path := fmt.Sprintf("/payment-gateway/%s/paymentmodes/config", strings.ToLower(c.Tenant))
+
+	return listUnpaged[PaymentMode](ctx, c, path, nil)
+}
+
+// UpdatePaymentMode updates an existing payment mode
+func (c *EmporixClient) UpdatePaymentMode(ctx context.Context, id string, updateData *PaymentModeUpdate) (*PaymentMode, error) {
+	path := fmt.Sprintf("/payment-gateway/%s/paymentmodes/config/%s", strings.ToLower(c.Tenant), id)
+	resp, err := c.doRequest(ctx, "PUT", path, updateData, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Read body (already logged in doRequest)
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	// WORKAROUND: The API returns stale data in the PUT response.
+	// Do a separate GET request to fetch the actual updated state.
+	// This prevents "Provider produced inconsistent result after apply" errors.
+	tflog.Debug(ctx, "Update succeeded, fetching current state via GET (API returns stale data in PUT response)")
+
+	return c.GetPaymentMode(ctx, id)
+}
+
+// DeletePaymentMode deletes a payment mode
+func (c *EmporixClient) DeletePaymentMode(ctx context.Context, id string) error {
+	path := fmt.Sprintf("/payment-gateway/%s/paymentmodes/config/%s", strings.ToLower(c.Tenant), id)
+	resp, err := c.doRequest(ctx, "DELETE", path, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Read body (already logged in doRequest)
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return fmt.Errorf("error reading response body: %w", readErr)
+	}
+	return c.checkResponse(ctx, resp, bodyBytes, http.StatusOK, http.StatusNoContent)
+}
+
+// GetCountry retrieves a country by code
+func (c *EmporixClient) GetCountry(ctx context.Context, code string) (*Country, error) {
+	path := fmt.Sprintf("/country/%s/countries/%s", strings.ToLower(c.Tenant), code)
+
+	headers := map[string]string{
+		"X-Version": "v2",
+	}
+
+	resp, err := c.doRequest(ctx, "GET", path, nil, headers)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{}
+	}
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	var country Country
+	if err := json.Unmarshal(bodyBytes, &country); err != nil {
+		return nil, fmt.Errorf("error decoding country response: %w", err)
+	}
+
+	return &country, nil
+}
+
+// ListCountries retrieves all countries matching the options, following pagination
+func (c *EmporixClient) ListCountries(ctx context.Context, opts *ListOptions) ([]Country, error) {
+	path := fmt.Sprintf("/country/%s/countries", strings.ToLower(c.Tenant))
+
+	headers := map[string]string{
+		"X-Version": "v2",
+	}
+
+	return listAllPages[Country](ctx, c, path, opts, headers)
+}
+
+// UpdateCountry updates a country's active status
+func (c *EmporixClient) UpdateCountry(ctx context.Context, code string, updateData *CountryUpdate) (*Country, error) {
+	// First, get current country to retrieve metadata.version (required for PATCH)
+	country, err := c.GetCountry(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("error getting country before update: %w", err)
+	}
+
+	// Add metadata.version to update data (required by API)
+	if country.Metadata != nil && country.Metadata.Version > 0 {
+		if updateData.Metadata == nil {
+			updateData.Metadata = &Metadata{}
+		}
+		updateData.Metadata.Version = country.Metadata.Version
+	}
+
+	path := fmt.Sprintf("/country/%s/countries/%s", strings.ToLower(c.Tenant), code)
+
+	headers := map[string]string{
+		"X-Version": "v2",
+	}
+
+	resp, err := c.doRequest(ctx, "PATCH", path, updateData, headers)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
+		return nil, err
+	}
+
+	// PATCH returns 204 No Content, so fetch current state via GET
+	tflog.Debug(ctx, "Update succeeded, fetching current state via GET")
+
+	return c.GetCountry(ctx, code)
+}
+
+// languagesConfigurationKey is the tenant configuration holding the languages of the tenant
+const languagesConfigurationKey = "project_lang"
+
+// getLanguages reads the languages of the tenant along with the configuration they are stored in.
+// The project_lang value is usually a JSON encoded string, but a plain array is accepted too.
+// A tenant without the configuration has no languages, and a nil configuration is returned.
+func (c *EmporixClient) getLanguages(ctx context.Context) ([]Language, *TenantConfiguration, error) {
+	config, err := c.GetTenantConfiguration(ctx, languagesConfigurationKey)
+	if IsNotFound(err) {
+		return []Language{}, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data []byte
+	if encoded, ok := config.Value.(string); ok {
+		data = []byte(encoded)
+	} else if data, err = json.Marshal(config.Value); err != nil {
+		return nil, nil, fmt.Errorf("error encoding %s configuration: %w", languagesConfigurationKey, err)
+	}
+
+	var languages []Language
+	if err := json.Unmarshal(data, &languages); err != nil {
+		return nil, nil, fmt.Errorf("error decoding %s configuration: %w", languagesConfigurationKey, err)
+	}
+	return languages, config, nil
+}
+
+// putLanguages writes the languages of the tenant, keeping the value format of the existing configuration
+func (c *EmporixClient) putLanguages(ctx context.Context, languages []Language, config *TenantConfiguration) error {
+	data, err := json.Marshal(languages)
+	if err != nil {
+		return fmt.Errorf("error encoding %s configuration: %w", languagesConfigurationKey, err)
+	}
+
+	var value interface{} = string(data)
+	if config != nil {
+		if _, ok := config.Value.(string); !ok {
+			value = languages
+		}
+	}
+
+	if config == nil {
+		_, err = c.CreateTenantConfiguration(ctx, &TenantConfigurationCreate{Key: languagesConfigurationKey, Value: value})
+		return err
+	}
+
+	_, err = c.UpdateTenantConfiguration(ctx, languagesConfigurationKey, &TenantConfigurationUpdate{
+		Key:     languagesConfigurationKey,
+		Value:   value,
+		Version: config.Version,
+		Secured: config.Secured,
+	})
+	return err
+}
+
+// ListLanguages retrieves all languages of the tenant
+func (c *EmporixClient) ListLanguages(ctx context.Context) ([]Language, error) {
+	languages, _, err := c.getLanguages(ctx)
+	return languages, err
+}
+
+// GetLanguage retrieves a language of the tenant by code
+func (c *EmporixClient) GetLanguage(ctx context.Context, code string) (*Language, error) {
+	languages, _, err := c.getLanguages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range languages {
+		if languages[i].Code == code {
+			return &languages[i], nil
+		}
+	}
+	return nil, &NotFoundError{}
+}
+
+// CreateLanguage adds a language to the tenant. A default language replaces the current default.
+func (c *EmporixClient) CreateLanguage(ctx context.Context, language *Language) (*Language, error) {
+	mutex := getLanguageMutex(c.Tenant)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	languages, config, err := c.getLanguages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, existing := range languages {
+		if existing.Code == language.Code {
+			return nil, fmt.Errorf("language %s already exists in the %s configuration, import it instead", language.Code, languagesConfigurationKey)
+		}
+	}
+
+	languages = append(languages, *language)
+	if language.Default {
+		setDefaultLanguage(languages, language.Code)
+	}
+
+	if err := c.putLanguages(ctx, languages, config); err != nil {
+		return nil, err
+	}
+	return c.GetLanguage(ctx, language.Code)
+}
+
+// UpdateLanguage updates a language of the tenant. A default language replaces the current default.
+func (c *EmporixClient) UpdateLanguage(ctx context.Context, code string, language *Language) (*Language, error) {
+	mutex := getLanguageMutex(c.Tenant)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	languages, config, err := c.getLanguages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	found := false
+	for i := range languages {
+		if languages[i].Code == code {
+			languages[i] = *language
+			languages[i].Code = code
+			found = true
+		}
+	}
+	if !found {
+		return nil, &NotFoundError{}
+	}
+	if language.Default {
+		setDefaultLanguage(languages, code)
+	}
+
+	if err := c.putLanguages(ctx, languages, config); err != nil {
+		return nil, err
+	}
+	return c.GetLanguage(ctx, code)
+}
+
+// DeleteLanguage removes a language from the tenant
+func (c *EmporixClient) DeleteLanguage(ctx context.Context, code string) error {
+	mutex := getLanguageMutex(c.Tenant)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	languages, config, err := c.getLanguages(ctx)
+	if err != nil {
+		return err
+	}
+
+	remaining := make([]Language, 0, len(languages))
+	for _, language := range languages {
+		if language.Code != code {
+			remaining = append(remaining, language)
+		}
+	}
+	if len(remaining) == len(languages) {
+		return &NotFoundError{}
+	}
+
+	return c.putLanguages(ctx, remaining, config)
+}
+
+// setDefaultLanguage makes the language with the given code the only default language
+func setDefaultLanguage(languages []Language, code string) {
+	for i := range languages {
+		languages[i].Default = languages[i].Code == code
+	}
+}
+
+// CreateCurrency creates a new currency
+func (c *EmporixClient) CreateCurrency(ctx context.Context, currency *CurrencyCreate) (*Currency, error) {
+	path := fmt.Sprintf("/currency/%s/currencies", strings.ToLower(c.Tenant))
+
+	// Name is always a map, so always use Content-Language: *
+	headers := map[string]string{
+		"Content-Language": "*",
+	}
+
+	resp, err := c.doRequest(ctx, "POST", path, currency, headers)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusCreated); err != nil {
+		return nil, err
+	}
+
+	// API may not return complete data in CREATE response (especially when using Content-Language: *)
+	// Always fetch the currency after creation to get complete state with all translations
+	var createdCurrency Currency
+	if err := json.Unmarshal(bodyBytes, &createdCurrency); err != nil {
+		return nil, fmt.Errorf("error decoding currency response: %w", err)
+	}
+
+	tflog.Debug(ctx, "Currency created, fetching complete state via GET")
+
+	// Fetch complete currency data with all translations
+	return c.GetCurrency(ctx, createdCurrency.Code)
+}
+
+// GetCurrency retrieves a currency by code
+func (c *EmporixClient) GetCurrency(ctx context.Context, code string) (*Currency, error) {
+	path := fmt.Sprintf("/currency/%s/currencies/%s", strings.ToLower(c.Tenant), code)
+
+	// Always use Accept-Language: * to retrieve all translations
+	headers := map[string]string{
+		"Accept-Language": "*",
+	}
+
+	resp, err := c.doRequest(ctx, "GET", path, nil, headers)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{}
+	}
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	var currency Currency
+	if err := json.Unmarshal(bodyBytes, &currency); err != nil {
+		return nil, fmt.Errorf("error decoding currency response: %w", err)
+	}
+
+	return &currency, nil
+}
+
+// ListCurrencies retrieves all currencies matching the options, following pagination
+func (c *EmporixClient) ListCurrencies(ctx context.Context, opts *ListOptions) ([]Currency, error) {
+	path := fmt.Sprintf("/currency/%s/currencies", strings.ToLower(c.Tenant))
+
+	// Always use Accept-Language: * to retrieve all translations
+	headers := map[string]string{
+		"Accept-Language": "*",
+	}
+
+	return listAllPages[Currency](ctx, c, path, opts, headers)
+}
+
+// UpdateCurrency updates a currency
+func (c *EmporixClient) UpdateCurrency(ctx context.Context, code string, updateData *CurrencyUpdate) (*Currency, error) {
+	// First, get current currency to retrieve metadata.version (required for PUT)
+	currency, err := c.GetCurrency(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("error getting currency before update: %w", err)
+	}
+
+	// Add metadata.version to update data (required by API)
+	if currency.Metadata != nil && currency.Metadata.Version > 0 {
+		if updateData.Metadata == nil {
+			updateData.Metadata = &Metadata{}
+		}
+		updateData.Metadata.Version = currency.Metadata.Version
+	}
+
+	path := fmt.Sprintf("/currency/%s/currencies/%s", strings.ToLower(c.Tenant), code)
+
+	// Name is always a map, so always use Content-Language: *
+	headers := map[string]string{
+		"Content-Language": "*",
+	}
+
+	resp, err := c.doRequest(ctx, "PUT", path, updateData, headers)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
+		return nil, err
+	}
+
+	// PUT returns 204 No Content, so fetch current state via GET
+	tflog.Debug(ctx, "Update succeeded, fetching current state via GET")
+
+	return c.GetCurrency(ctx, code)
+}
+
+// DeleteCurrency deletes a currency by code
+func (c *EmporixClient) DeleteCurrency(ctx context.Context, code string) error {
+	path := fmt.Sprintf("/currency/%s/currencies/%s", strings.ToLower(c.Tenant), code)
+
+	resp, err := c.doRequest(ctx, "DELETE", path, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateUnit creates a new measurement unit
+func (c *EmporixClient) CreateUnit(ctx context.Context, unit *Unit) (*Unit, error) {
+	path := fmt.Sprintf("/unit-handling/%s/units", strings.ToLower(c.Tenant))
+
+	// Name is always a map, so always use Content-Language: *
+	headers := map[string]string{
+		"Content-Language": "*",
+	}
+
+	resp, err := c.doRequest(ctx, "POST", path, unit, headers)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusCreated); err != nil {
+		return nil, err
+	}
+
+	// The POST response only contains the code, so fetch the complete unit
+	tflog.Debug(ctx, "Unit created, fetching complete state via GET")
+
+	return c.GetUnit(ctx, unit.Code)
+}
+
+// GetUnit retrieves a measurement unit by code
+func (c *EmporixClient) GetUnit(ctx context.Context, code string) (*Unit, error) {
+	path := fmt.Sprintf("/unit-handling/%s/units/%s", strings.ToLower(c.Tenant), code)
+
+	// Always use Accept-Language: * to retrieve all translations
+	headers := map[string]string{
+		"Accept-Language": "*",
+	}
+
+	resp, err := c.doRequest(ctx, "GET", path, nil, headers)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{}
+	}
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	var unit Unit
+	if err := json.Unmarshal(bodyBytes, &unit); err != nil {
+		return nil, fmt.Errorf("error decoding unit response: %w", err)
+	}
+
+	return &unit, nil
+}
+
+// ListUnits retrieves all measurement units matching the options, following pagination
+func (c *EmporixClient) ListUnits(ctx context.Context, opts *ListOptions) ([]Unit, error) {
+	path := fmt.Sprintf("/unit-handling/%s/units", strings.ToLower(c.Tenant))
+
+	// Always use Accept-Language: * to retrieve all translations
+	headers := map[string]string{
+		"Accept-Language": "*",
+	}
+
+	return listAllPages[Unit](ctx, c, path, opts, headers)
+}
+
+// UpdateUnit updates a measurement unit
+func (c *EmporixClient) UpdateUnit(ctx context.Context, code string, unit *Unit) (*Unit, error) {
+	// First, get current unit to retrieve metadata.version (required for PUT)
+	current, err := c.GetUnit(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("error getting unit before update: %w", err)
+	}
+
+	updateData := *unit
+	updateData.Code = code
+	updateData.Metadata = nil
+	if current.Metadata != nil && current.Metadata.Version > 0 {
+		updateData.Metadata = &Metadata{Version: current.Metadata.Version}
+	}
+
+	path := fmt.Sprintf("/unit-handling/%s/units/%s", strings.ToLower(c.Tenant), code)
+
+	// Name is always a map, so always use Content-Language: *
+	headers := map[string]string{
+		"Content-Language": "*",
+	}
+
+	resp, err := c.doRequest(ctx, "PUT", path, &updateData, headers)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
+		return nil, err
+	}
+
+	// PUT returns 204 No Content, so fetch current state via GET
+	tflog.Debug(ctx, "Update succeeded, fetching current state via GET")
+
+	return c.GetUnit(ctx, code)
+}
+
+// DeleteUnit deletes a measurement unit by code
+func (c *EmporixClient) DeleteUnit(ctx context.Context, code string) error {
+	path := fmt.Sprintf("/unit-handling/%s/units/%s", strings.ToLower(c.Tenant), code)
+
+	resp, err := c.doRequest(ctx, "DELETE", path, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateCategory creates a new category in the category tree
+func (c *EmporixClient) CreateCategory(ctx context.Context, category *Category) (*Category, error) {
+	// Lock for this tenant's category tree operations
+	mu := getCategoryMutex(c.Tenant)
+	mu.Lock()
+	defer mu.Unlock()
+
+	path := fmt.Sprintf("/category/%s/categories", strings.ToLower(c.Tenant))
+
+	// Localized fields are always maps, so always use Content-Language: *
+	headers := map[string]string{
+		"Content-Language": "*",
+	}
+
+	resp, err := c.doRequest(ctx, "POST", path, category, headers)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusCreated); err != nil {
+		return nil, err
+	}
+
+	// API returns { "id": "string" } on create
+	var createResp struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(bodyBytes, &createResp); err != nil {
+		return nil, fmt.Errorf("error decoding category create response: %w", err)
+	}
+
+	tflog.Debug(ctx, "Category created, fetching complete state via GET", map[string]interface{}{
+		"id": createResp.ID,
+	})
+
+	return c.GetCategory(ctx, createResp.ID)
+}
+
+// GetCategory retrieves a category by ID
+func (c *EmporixClient) GetCategory(ctx context.Context, id string) (*Category, error) {
+	path := fmt.Sprintf("/category/%s/categories/%s", strings.ToLower(c.Tenant), id)
+
+	// Always use Accept-Language: * to retrieve all translations
+	headers := map[string]string{
+		"Accept-Language": "*",
+	}
+
+	resp, err := c.doRequest(ctx, "GET", path, nil, headers)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{}
+	}
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	var category Category
+	if err := json.Unmarshal(bodyBytes, &category); err != nil {
+		return nil, fmt.Errorf("error decoding category response: %w", err)
+	}
+
+	return &category, nil
+}
+
+// ListCategories retrieves all categories matching the options, following pagination
+func (c *EmporixClient) ListCategories(ctx context.Context, opts *ListOptions) ([]Category, error) {
+	path := fmt.Sprintf("/category/%s/categories", strings.ToLower(c.Tenant))
+
+	// Always use Accept-Language: * to retrieve all translations
+	headers := map[string]string{
+		"Accept-Language": "*",
+	}
+
+	return listAllPages[Category](ctx, c, path, opts, headers)
+}
+
+// UpdateCategory fully updates a category, including its parent and position
+func (c *EmporixClient) UpdateCategory(ctx context.Context, id string, category *Category) (*Category, error) {
+	// Lock for this tenant's category tree operations
+	mu := getCategoryMutex(c.Tenant)
+	mu.Lock()
+	defer mu.Unlock()
+
+	// First, get current category to retrieve metadata.version (required for PUT)
+	current, err := c.GetCategory(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting category before update: %w", err)
+	}
+
+	updateData := *category
+	updateData.ID = ""
+	updateData.Metadata = &Metadata{}
+	if category.Metadata != nil {
+		updateData.Metadata.Mixins = category.Metadata.Mixins
+	}
+	if current.Metadata != nil {
+		updateData.Metadata.Version = current.Metadata.Version
+	}
+
+	path := fmt.Sprintf("/category/%s/categories/%s", strings.ToLower(c.Tenant), id)
+
+	// Localized fields are always maps, so always use Content-Language: *
+	headers := map[string]string{
+		"Content-Language": "*",
+	}
+
+	resp, err := c.doRequest(ctx, "PUT", path, &updateData, headers)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
+		return nil, err
+	}
+
+	// PUT returns 204 No Content, so fetch current state via GET
+	tflog.Debug(ctx, "Update succeeded, fetching current state via GET")
+
+	return c.GetCategory(ctx, id)
+}
+
+// DeleteCategory deletes a category. Subcategories are kept, since Terraform manages
+// them as separate resources and deletes them on its own.
+func (c *EmporixClient) DeleteCategory(ctx context.Context, id string) error {
+	// Lock for this tenant's category tree operations
+	mu := getCategoryMutex(c.Tenant)
+	mu.Lock()
+	defer mu.Unlock()
+
+	path := fmt.Sprintf("/category/%s/categories/%s?withSubcategories=false", strings.ToLower(c.Tenant), id)
+
+	resp, err := c.doRequest(ctx, "DELETE", path, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateCategoryAssignment assigns a product to a category
+func (c *EmporixClient) CreateCategoryAssignment(ctx context.Context, categoryID, productID string) (*CategoryAssignment, error) {
+	path := fmt.Sprintf("/category/%s/categories/%s/assignments", strings.ToLower(c.Tenant), categoryID)
+
+	assignment := &CategoryAssignment{
+		Ref: CategoryAssignmentRef{ID: productID, Type: "PRODUCT"},
+	}
+
+	resp, err := c.doRequest(ctx, "POST", path, assignment, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusCreated); err != nil {
+		return nil, err
+	}
+
+	tflog.Debug(ctx, "Category assignment created, fetching complete state via GET")
+
+	return c.GetCategoryAssignment(ctx, categoryID, productID)
+}
+
+// GetCategoryAssignment retrieves the assignment of a product to a category. The API has no
+// endpoint for a single assignment by product, so the category's assignments are searched.
+func (c *EmporixClient) GetCategoryAssignment(ctx context.Context, categoryID, productID string) (*CategoryAssignment, error) {
+	assignments, err := c.ListCategoryAssignments(ctx, categoryID, nil)
+	if err != nil {
+		if apiErr, ok := AsAPIError(err); ok && apiErr.StatusCode == http.StatusNotFound {
+			return nil, &NotFoundError{}
+		}
+		return nil, err
+	}
+
+	for i := range assignments {
+		if assignments[i].Ref.ID == productID {
+			return &assignments[i], nil
+		}
+	}
+
+	return nil, &NotFoundError{}
+}
+
+// ListCategoryAssignments retrieves all assignments of a category, following pagination
+func (c *EmporixClient) ListCategoryAssignments(ctx context.Context, categoryID string, opts *ListOptions) ([]CategoryAssignment, error) {
+	path := fmt.Sprintf("/category/%s/categories/%s/assignments", strings.ToLower(c.Tenant), categoryID)
+
+	return listAllPages[CategoryAssignment](ctx, c, path, opts, nil)
+}
+
+// DeleteCategoryAssignment removes the assignment of a product from a category
+func (c *EmporixClient) DeleteCategoryAssignment(ctx context.Context, categoryID, productID string) error {
+	path := fmt.Sprintf("/category/%s/categories/%s/assignments/references/%s", strings.ToLower(c.Tenant), categoryID, productID)
+
+	resp, err := c.doRequest(ctx, "DELETE", path, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreatePriceList creates a new price list
+func (c *EmporixClient) CreatePriceList(ctx context.Context, priceList *PriceList) (*PriceList, error) {
+	path := fmt.Sprintf("/price/%s/price-lists", strings.ToLower(c.Tenant))
+
+	// Name is always a map, so always use Content-Language: *
+	headers := map[string]string{
+		"Content-Language": "*",
+	}
+
+	resp, err := c.doRequest(ctx, "POST", path, priceList, headers)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusCreated); err != nil {
+		return nil, err
+	}
+
+	// API returns { "id": "string" } on create
+	var createResp struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(bodyBytes, &createResp); err != nil {
+		return nil, fmt.Errorf("error decoding price list create response: %w", err)
+	}
+
+	tflog.Debug(ctx, "Price list created, fetching complete state via GET", map[string]interface{}{
+		"id": createResp.ID,
+	})
+
+	return c.GetPriceList(ctx, createResp.ID)
+}
+
+// GetPriceList retrieves a price list by ID
+func (c *EmporixClient) GetPriceList(ctx context.Context, id string) (*PriceList, error) {
+	path := fmt.Sprintf("/price/%s/price-lists/%s", strings.ToLower(c.Tenant), id)
+
+	// Always use Accept-Language: * to retrieve all translations
+	headers := map[string]string{
+		"Accept-Language": "*",
+	}
+
+	resp, err := c.doRequest(ctx, "GET", path, nil, headers)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{}
+	}
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	var priceList PriceList
+	if err := json.Unmarshal(bodyBytes, &priceList); err != nil {
+		return nil, fmt.Errorf("error decoding price list response: %w", err)
+	}
+
+	return &priceList, nil
+}
+
+// ListPriceLists retrieves all price lists matching the options, following pagination
+func (c *EmporixClient) ListPriceLists(ctx context.Context, opts *ListOptions) ([]PriceList, error) {
+	path := fmt.Sprintf("/price/%s/price-lists", strings.ToLower(c.Tenant))
+
+	// Always use Accept-Language: * to retrieve all translations
+	headers := map[string]string{
+		"Accept-Language": "*",
+	}
+
+	return listAllPages[PriceList](ctx, c, path, opts, headers)
+}
+
+// UpdatePriceList fully updates a price list
+func (c *EmporixClient) UpdatePriceList(ctx context.Context, id string, priceList *PriceList) (*PriceList, error) {
+	// First, get current price list to retrieve metadata.version (required for PUT)
+	current, err := c.GetPriceList(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting price list before update: %w", err)
+	}
+
+	updateData := *priceList
+	updateData.ID = ""
+	updateData.Metadata = nil
+	if current.Metadata != nil && current.Metadata.Version > 0 {
+		updateData.Metadata = &Metadata{Version: current.Metadata.Version}
+	}
+
+	path := fmt.Sprintf("/price/%s/price-lists/%s", strings.ToLower(c.Tenant), id)
+
+	// Name is always a map, so always use Content-Language: *
+	headers := map[string]string{
+		"Content-Language": "*",
+	}
+
+	resp, err := c.doRequest(ctx, "PUT", path, &updateData, headers)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
+		return nil, err
+	}
+
+	// PUT returns 204 No Content, so fetch current state via GET
+	tflog.Debug(ctx, "Update succeeded, fetching current state via GET")
+
+	return c.GetPriceList(ctx, id)
+}
+
+// DeletePriceList deletes a price list by ID
+func (c *EmporixClient) DeletePriceList(ctx context.Context, id string) error {
+	path := fmt.Sprintf("/price/%s/price-lists/%s", strings.ToLower(c.Tenant), id)
+
+	resp, err := c.doRequest(ctx, "DELETE", path, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateIAMGroup creates a new IAM group
+func (c *EmporixClient) CreateIAMGroup(ctx context.Context, group *IAMGroup) (*IAMGroup, error) {
+	path := fmt.Sprintf("/iam/%s/groups", strings.ToLower(c.Tenant))
+
+	// Localized fields are always maps, so always use Content-Language: *
+	headers := map[string]string{
+		"Content-Language": "*",
+	}
+
+	resp, err := c.doRequest(ctx, "POST", path, group, headers)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusCreated); err != nil {
+		return nil, err
+	}
+
+	// API returns { "id": "string" } on create
+	var createResp struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(bodyBytes, &createResp); err != nil {
+		return nil, fmt.Errorf("error decoding IAM group create response: %w", err)
+	}
+
+	tflog.Debug(ctx, "IAM group created, fetching complete state via GET", map[string]interface{}{
+		"id": createResp.ID,
+	})
+
+	return c.GetIAMGroup(ctx, createResp.ID)
+}
+
+// GetIAMGroup retrieves an IAM group by ID
+func (c *EmporixClient) GetIAMGroup(ctx context.Context, id string) (*IAMGroup, error) {
+	path := fmt.Sprintf("/iam/%s/groups/%s", strings.ToLower(c.Tenant), id)
+
+	// Always use Accept-Language: * to retrieve all translations
+	headers := map[string]string{
+		"Accept-Language": "*",
+	}
+
+	resp, err := c.doRequest(ctx, "GET", path, nil, headers)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{}
+	}
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	var group IAMGroup
+	if err := json.Unmarshal(bodyBytes, &group); err != nil {
+		return nil, fmt.Errorf("error decoding IAM group response: %w", err)
+	}
+
+	return &group, nil
+}
+
+// ListIAMGroups retrieves all IAM groups matching the options, following pagination
+func (c *EmporixClient) ListIAMGroups(ctx context.Context, opts *ListOptions) ([]IAMGroup, error) {
+	path := fmt.Sprintf("/iam/%s/groups", strings.ToLower(c.Tenant))
+
+	// Always use Accept-Language: * to retrieve all translations
+	headers := map[string]string{
+		"Accept-Language": "*",
+	}
+
+	return listAllPages[IAMGroup](ctx, c, path, opts, headers)
+}
+
+// UpdateIAMGroup fully updates an IAM group, including its template and access control bindings
+func (c *EmporixClient) UpdateIAMGroup(ctx context.Context, id string, group *IAMGroup) (*IAMGroup, error) {
+	// First, get current group to retrieve metadata.version (required for PUT)
+	current, err := c.GetIAMGroup(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting IAM group before update: %w", err)
+	}
+
+	updateData := *group
+	updateData.ID = ""
+	updateData.Metadata = nil
+	if current.Metadata != nil && current.Metadata.Version > 0 {
+		updateData.Metadata = &Metadata{Version: current.Metadata.Version}
+	}
+
+	path := fmt.Sprintf("/iam/%s/groups/%s", strings.ToLower(c.Tenant), id)
+
+	// Localized fields are always maps, so always use Content-Language: *
+	headers := map[string]string{
+		"Content-Language": "*",
+	}
+
+	resp, err := c.doRequest(ctx, "PUT", path, &updateData, headers)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
+		return nil, err
+	}
+
+	// PUT returns 204 No Content, so fetch current state via GET
+	tflog.Debug(ctx, "Update succeeded, fetching current state via GET")
+
+	return c.GetIAMGroup(ctx, id)
+}
+
+// DeleteIAMGroup deletes an IAM group by ID. Its members lose the group's permissions,
+// the users themselves are kept.
+func (c *EmporixClient) DeleteIAMGroup(ctx context.Context, id string) error {
+	path := fmt.Sprintf("/iam/%s/groups/%s", strings.ToLower(c.Tenant), id)
+
+	resp, err := c.doRequest(ctx, "DELETE", path, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateIAMGroupMember adds a user to an IAM group. The API needs the user's type, which
+// always is the user type of the group.
+func (c *EmporixClient) CreateIAMGroupMember(ctx context.Context, groupID, userID, userType string) (*IAMGroupUser, error) {
+	path := fmt.Sprintf("/iam/%s/groups/%s/users", strings.ToLower(c.Tenant), groupID)
+
+	membership := map[string]string{
+		"userId":   userID,
+		"userType": userType,
+	}
+
+	resp, err := c.doRequest(ctx, "POST", path, membership, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusCreated, http.StatusNoContent); err != nil {
+		return nil, err
+	}
+
+	tflog.Debug(ctx, "User added to IAM group, fetching complete state via GET")
+
+	return c.GetIAMGroupMember(ctx, groupID, userID)
+}
+
+// GetIAMGroupMember retrieves a member of an IAM group. The API has no endpoint for a
+// single member, so the group's users are searched.
+func (c *EmporixClient) GetIAMGroupMember(ctx context.Context, groupID, userID string) (*IAMGroupUser, error) {
+	users, err := c.ListIAMGroupMembers(ctx, groupID, nil)
+	if err != nil {
+		if apiErr, ok := AsAPIError(err); ok && apiErr.StatusCode == http.StatusNotFound {
+			return nil, &NotFoundError{}
+		}
+		return nil, err
+	}
+
+	for i := range users {
+		if users[i].ID == userID {
+			return &users[i], nil
+		}
+	}
+
+	return nil, &NotFoundError{}
+}
+
+// ListIAMGroupMembers retrieves all members of an IAM group, following pagination
+func (c *EmporixClient) ListIAMGroupMembers(ctx context.Context, groupID string, opts *ListOptions) ([]IAMGroupUser, error) {
+	path := fmt.Sprintf("/iam/%s/groups/%s/users", strings.ToLower(c.Tenant), groupID)
+
+	return listAllPages[IAMGroupUser](ctx, c, path, opts, nil)
+}
+
+// DeleteIAMGroupMember removes a user from an IAM group
+func (c *EmporixClient) DeleteIAMGroupMember(ctx context.Context, groupID, userID string) error {
+	path := fmt.Sprintf("/iam/%s/groups/%s/users/%s", strings.ToLower(c.Tenant), groupID, userID)
+
+	resp, err := c.doRequest(ctx, "DELETE", path, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateAPIClient creates a new API client. The returned client carries the client secret,
+// which the API only reveals on creation.
+func (c *EmporixClient) CreateAPIClient(ctx context.Context, apiClient *APIClient) (*APIClient, error) {
+	path := fmt.Sprintf("/iam/%s/api-keys", strings.ToLower(c.Tenant))
+
+	resp, err := c.doRequest(ctx, "POST", path, apiClient, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusCreated); err != nil {
+		return nil, err
+	}
+
+	// API returns { "id", "clientId", "clientSecret" } on create
+	var createResp struct {
+		ID           string `json:"id"`
+		ClientSecret string `json:"clientSecret"`
+	}
+	if err := json.Unmarshal(bodyBytes, &createResp); err != nil {
+		return nil, fmt.Errorf("error decoding API client create response: %w", err)
+	}
+
+	tflog.Debug(ctx, "API client created, fetching complete state via GET", map[string]interface{}{
+		"id": createResp.ID,
+	})
+
+	created, err := c.GetAPIClient(ctx, createResp.ID)
+	if err != nil {
+		return nil, err
+	}
+	created.ClientSecret = createResp.ClientSecret
+
+	return created, nil
+}
+
+// GetAPIClient retrieves an API client by ID. The client secret is never returned.
+func (c *EmporixClient) GetAPIClient(ctx context.Context, id string) (*APIClient, error) {
+	path := fmt.Sprintf("/iam/%s/api-keys/%s", strings.ToLower(c.Tenant), id)
+
+	resp, err := c.doRequest(ctx, "GET", path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{}
+	}
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	var apiClient APIClient
+	if err := json.Unmarshal(bodyBytes, &apiClient); err != nil {
+		return nil, fmt.Errorf("error decoding API client response: %w", err)
+	}
+
+	return &apiClient, nil
+}
+
+// UpdateAPIClient updates the name, description and scopes of an API client.
+// Its credentials stay the same.
+func (c *EmporixClient) UpdateAPIClient(ctx context.Context, id string, apiClient *APIClient) (*APIClient, error) {
+	// First, get current API client to retrieve metadata.version (required for PUT)
+	current, err := c.GetAPIClient(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting API client before update: %w", err)
+	}
+
+	updateData := *apiClient
+	updateData.ID = ""
+	updateData.ClientID = ""
+	updateData.ClientSecret = ""
+	updateData.Metadata = nil
+	if current.Metadata != nil && current.Metadata.Version > 0 {
+		updateData.Metadata = &Metadata{Version: current.Metadata.Version}
+	}
+
+	path := fmt.Sprintf("/iam/%s/api-keys/%s", strings.ToLower(c.Tenant), id)
+
+	resp, err := c.doRequest(ctx, "PUT", path, &updateData, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
+		return nil, err
+	}
+
+	// PUT returns 204 No Content, so fetch current state via GET
+	tflog.Debug(ctx, "Update succeeded, fetching current state via GET")
+
+	return c.GetAPIClient(ctx, id)
+}
+
+// DeleteAPIClient deletes an API client by ID, revoking its credentials
+func (c *EmporixClient) DeleteAPIClient(ctx context.Context, id string) error {
+	path := fmt.Sprintf("/iam/%s/api-keys/%s", strings.ToLower(c.Tenant), id)
+
+	resp, err := c.doRequest(ctx, "DELETE", path, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateCustomerSegment creates a new customer segment
+func (c *EmporixClient) CreateCustomerSegment(ctx context.Context, segment *CustomerSegment) (*CustomerSegment, error) {
+	path := fmt.Sprintf("/customer-segment/%s/segments", strings.ToLower(c.Tenant))
+
+	// Localized fields are always maps, so always use Content-Language: *
+	headers := map[string]string{
+		"Content-Language": "*",
+	}
+
+	resp, err := c.doRequest(ctx, "POST", path, segment, headers)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusCreated); err != nil {
+		return nil, err
+	}
+
+	// API returns { "id": "string" } on create
+	var createResp struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(bodyBytes, &createResp); err != nil {
+		return nil, fmt.Errorf("error decoding customer segment create response: %w", err)
+	}
+
+	tflog.Debug(ctx, "Customer segment created, fetching complete state via GET", map[string]interface{}{
+		"id": createResp.ID,
+	})
+
+	return c.GetCustomerSegment(ctx, createResp.ID)
+}
+
+// GetCustomerSegment retrieves a customer segment by ID
+func (c *EmporixClient) GetCustomerSegment(ctx context.Context, id string) (*CustomerSegment, error) {
+	path := fmt.Sprintf("/customer-segment/%s/segments/%s", strings.ToLower(c.Tenant), id)
+
+	// Always use Accept-Language: * to retrieve all translations
+	headers := map[string]string{
+		"Accept-Language": "*",
+	}
+
+	resp, err := c.doRequest(ctx, "GET", path, nil, headers)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{}
+	}
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	var segment CustomerSegment
+	if err := json.Unmarshal(bodyBytes, &segment); err != nil {
+		return nil, fmt.Errorf("error decoding customer segment response: %w", err)
+	}
+
+	return &segment, nil
+}
+
+// ListCustomerSegments retrieves all customer segments matching the options, following pagination
+func (c *EmporixClient) ListCustomerSegments(ctx context.Context, opts *ListOptions) ([]CustomerSegment, error) {
+	path := fmt.Sprintf("/customer-segment/%s/segments", strings.ToLower(c.Tenant))
+
+	// Always use Accept-Language: * to retrieve all translations
+	headers := map[string]string{
+		"Accept-Language": "*",
+	}
+
+	return listAllPages[CustomerSegment](ctx, c, path, opts, headers)
+}
+
+// UpdateCustomerSegment fully updates a customer segment. Members and items are not affected.
+func (c *EmporixClient) UpdateCustomerSegment(ctx context.Context, id string, segment *CustomerSegment) (*CustomerSegment, error) {
+	// First, get current segment to retrieve metadata.version (required for PUT)
+	current, err := c.GetCustomerSegment(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting customer segment before update: %w", err)
+	}
+
+	updateData := *segment
+	updateData.ID = ""
+	updateData.Metadata = nil
+	if current.Metadata != nil && current.Metadata.Version > 0 {
+		updateData.Metadata = &Metadata{Version: current.Metadata.Version}
+	}
+
+	path := fmt.Sprintf("/customer-segment/%s/segments/%s", strings.ToLower(c.Tenant), id)
+
+	// Localized fields are always maps, so always use Content-Language: *
+	headers := map[string]string{
+		"Content-Language": "*",
+	}
+
+	resp, err := c.doRequest(ctx, "PUT", path, &updateData, headers)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
+		return nil, err
+	}
+
+	// PUT returns 204 No Content, so fetch current state via GET
+	tflog.Debug(ctx, "Update succeeded, fetching current state via GET")
+
+	return c.GetCustomerSegment(ctx, id)
+}
+
+// DeleteCustomerSegment deletes a customer segment by ID, together with its members and items
+func (c *EmporixClient) DeleteCustomerSegment(ctx context.Context, id string) error {
+	path := fmt.Sprintf("/customer-segment/%s/segments/%s", strings.ToLower(c.Tenant), id)
+
+	resp, err := c.doRequest(ctx, "DELETE", path, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ListCustomerSegmentMembers retrieves all customers of a customer segment, following pagination
+func (c *EmporixClient) ListCustomerSegmentMembers(ctx context.Context, segmentID string, opts *ListOptions) ([]CustomerSegmentMember, error) {
+	path := fmt.Sprintf("/customer-segment/%s/segments/%s/customers", strings.ToLower(c.Tenant), segmentID)
+
+	return listAllPages[CustomerSegmentMember](ctx, c, path, opts, nil)
+}
+
+// AddCustomerSegmentMember adds a customer to a customer segment. Adding a member twice is not an error.
+func (c *EmporixClient) AddCustomerSegmentMember(ctx context.Context, segmentID, customerID string) error {
+	path := fmt.Sprintf("/customer-segment/%s/segments/%s/customers/%s", strings.ToLower(c.Tenant), segmentID, customerID)
+
+	resp, err := c.doRequest(ctx, "PUT", path, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RemoveCustomerSegmentMember removes a customer from a customer segment
+func (c *EmporixClient) RemoveCustomerSegmentMember(ctx context.Context, segmentID, customerID string) error {
+	path := fmt.Sprintf("/customer-segment/%s/segments/%s/customers/%s", strings.ToLower(c.Tenant), segmentID, customerID)
+
+	resp, err := c.doRequest(ctx, "DELETE", path, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateCustomerSegmentItem attaches a product or category to a customer segment
+func (c *EmporixClient) CreateCustomerSegmentItem(ctx context.Context, segmentID, itemType, itemID string) (*CustomerSegmentItem, error) {
+	path := fmt.Sprintf("/customer-segment/%s/segments/%s/items", strings.ToLower(c.Tenant), segmentID)
+
+	item := &CustomerSegmentItem{
+		Type: itemType,
+		Item: CustomerSegmentItemRef{ID: itemID},
+	}
+
+	resp, err := c.doRequest(ctx, "POST", path, item, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusCreated); err != nil {
+		return nil, err
+	}
+
+	tflog.Debug(ctx, "Customer segment item created, fetching complete state via GET")
+
+	return c.GetCustomerSegmentItem(ctx, segmentID, itemType, itemID)
+}
+
+// GetCustomerSegmentItem retrieves the attachment of a product or category to a customer segment.
+// The API has no endpoint for a single item by the attached object, so the segment's items are searched.
+func (c *EmporixClient) GetCustomerSegmentItem(ctx context.Context, segmentID, itemType, itemID string) (*CustomerSegmentItem, error) {
+	items, err := c.ListCustomerSegmentItems(ctx, segmentID, nil)
+	if err != nil {
+		if apiErr, ok := AsAPIError(err); ok && apiErr.StatusCode == http.StatusNotFound {
+			return nil, &NotFoundError{}
+		}
+		return nil, err
+	}
+
+	for i := range items {
+		if items[i].Type == itemType && items[i].Item.ID == itemID {
+			return &items[i], nil
+		}
+	}
+
+	return nil, &NotFoundError{}
+}
+
+// ListCustomerSegmentItems retrieves all items of a customer segment, following pagination
+func (c *EmporixClient) ListCustomerSegmentItems(ctx context.Context, segmentID string, opts *ListOptions) ([]CustomerSegmentItem, error) {
+	path := fmt.Sprintf("/customer-segment/%s/segments/%s/items", strings.ToLower(c.Tenant), segmentID)
+
+	return listAllPages[CustomerSegmentItem](ctx, c, path, opts, nil)
+}
+
+// DeleteCustomerSegmentItem detaches an item, by the ID of the attachment, from a customer segment
+func (c *EmporixClient) DeleteCustomerSegmentItem(ctx context.Context, segmentID, id string) error {
+	path := fmt.Sprintf("/customer-segment/%s/segments/%s/items/%s", strings.ToLower(c.Tenant), segmentID, id)
+
+	resp, err := c.doRequest(ctx, "DELETE", path, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateLocation creates a new location
+func (c *EmporixClient) CreateLocation(ctx context.Context, location *PhysicalLocation) (*PhysicalLocation, error) {
+	path := fmt.Sprintf("/availability/%s/locations", strings.ToLower(c.Tenant))
+
+	resp, err := c.doRequest(ctx, "POST", path, location, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusCreated); err != nil {
+		return nil, err
+	}
+
+	// API returns { "id": "string" } on create
+	var createResp struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(bodyBytes, &createResp); err != nil {
+		return nil, fmt.Errorf("error decoding location create response: %w", err)
+	}
+
+	tflog.Debug(ctx, "Location created, fetching complete state via GET", map[string]interface{}{
+		"id": createResp.ID,
+	})
+
+	return c.GetLocation(ctx, createResp.ID)
+}
+
+// GetLocation retrieves a location by ID
+func (c *EmporixClient) GetLocation(ctx context.Context, id string) (*PhysicalLocation, error) {
+	path := fmt.Sprintf("/availability/%s/locations/%s", strings.ToLower(c.Tenant), id)
+
+	resp, err := c.doRequest(ctx, "GET", path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{}
+	}
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	var location PhysicalLocation
+	if err := json.Unmarshal(bodyBytes, &location); err != nil {
+		return nil, fmt.Errorf("error decoding location response: %w", err)
+	}
+
+	return &location, nil
+}
+
+// ListLocations retrieves all locations matching the options, following pagination
+func (c *EmporixClient) ListLocations(ctx context.Context, opts *ListOptions) ([]PhysicalLocation, error) {
+	path := fmt.Sprintf("/availability/%s/locations", strings.ToLower(c.Tenant))
+	return listAllPages[PhysicalLocation](ctx, c, path, opts, nil)
+}
+
+// UpdateLocation fully updates a location
+func (c *EmporixClient) UpdateLocation(ctx context.Context, id string, location *PhysicalLocation) (*PhysicalLocation, error) {
+	// First, get current location to retrieve metadata.version (required for PUT)
+	current, err := c.GetLocation(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting location before update: %w", err)
+	}
+
+	updateData := *location
+	updateData.ID = ""
+	updateData.Metadata = nil
+	if current.Metadata != nil && current.Metadata.Version > 0 {
+		updateData.Metadata = &Metadata{Version: current.Metadata.Version}
+	}
+
+	path := fmt.Sprintf("/availability/%s/locations/%s", strings.ToLower(c.Tenant), id)
+
+	resp, err := c.doRequest(ctx, "PUT", path, &updateData, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
+		return nil, err
+	}
+
+	// PUT returns 204 No Content, so fetch current state via GET
+	tflog.Debug(ctx, "Update succeeded, fetching current state via GET")
+
+	return c.GetLocation(ctx, id)
+}
+
+// DeleteLocation deletes a location by ID
+func (c *EmporixClient) DeleteLocation(ctx context.Context, id string) error {
+	path := fmt.Sprintf("/availability/%s/locations/%s", strings.ToLower(c.Tenant), id)
+
+	resp, err := c.doRequest(ctx, "DELETE", path, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateSequentialIDSchema creates a new sequential ID schema. New schemas are inactive.
+func (c *EmporixClient) CreateSequentialIDSchema(ctx context.Context, schema *SequentialIDSchema) (*SequentialIDSchema, error) {
+	path := fmt.Sprintf("/sequential-id/%s/schemas", strings.ToLower(c.Tenant))
+
+	createData := *schema
+	createData.Active = false
+
+	resp, err := c.doRequest(ctx, "POST", path, &createData, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusCreated); err != nil {
+		return nil, err
+	}
+
+	// API returns { "id": "string" } on create
+	var createResp struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(bodyBytes, &createResp); err != nil {
+		return nil, fmt.Errorf("error decoding sequential ID schema create response: %w", err)
+	}
+
+	tflog.Debug(ctx, "Sequential ID schema created, fetching complete state via GET", map[string]interface{}{
+		"id": createResp.ID,
+	})
+
+	return c.GetSequentialIDSchema(ctx, createResp.ID)
+}
+
+// GetSequentialIDSchema retrieves a sequential ID schema by ID
+func (c *EmporixClient) GetSequentialIDSchema(ctx context.Context, id string) (*SequentialIDSchema, error) {
+	path := fmt.Sprintf("/sequential-id/%s/schemas/%s", strings.ToLower(c.Tenant), id)
+
+	resp, err := c.doRequest(ctx, "GET", path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{}
+	}
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	var schema SequentialIDSchema
+	if err := json.Unmarshal(bodyBytes, &schema); err != nil {
+		return nil, fmt.Errorf("error decoding sequential ID schema response: %w", err)
+	}
+
+	return &schema, nil
+}
+
+// ListSequentialIDSchemas retrieves all sequential ID schemas matching the options, following pagination
+func (c *EmporixClient) ListSequentialIDSchemas(ctx context.Context, opts *ListOptions) ([]SequentialIDSchema, error) {
+	path := fmt.Sprintf("/sequential-id/%s/schemas", strings.ToLower(c.Tenant))
+	return listAllPages[SequentialIDSchema](ctx, c, path, opts, nil)
+}
+
+// UpdateSequentialIDSchema fully updates a sequential ID schema. The schema type and counter start
+// cannot be changed, and the active flag is left alone, see SetActiveSequentialIDSchema.
+func (c *EmporixClient) UpdateSequentialIDSchema(ctx context.Context, id string, schema *SequentialIDSchema) (*SequentialIDSchema, error) {
+	// First, get current schema to retrieve metadata.version (required for PUT)
+	current, err := c.GetSequentialIDSchema(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting sequential ID schema before update: %w", err)
+	}
+
+	updateData := *schema
+	updateData.ID = ""
+	updateData.Active = false
+	updateData.Metadata = nil
+	if current.Metadata != nil && current.Metadata.Version > 0 {
+		updateData.Metadata = &Metadata{Version: current.Metadata.Version}
+	}
+
+	path := fmt.Sprintf("/sequential-id/%s/schemas/%s", strings.ToLower(c.Tenant), id)
+
+	resp, err := c.doRequest(ctx, "PUT", path, &updateData, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, &NotFoundError{}
-	}
-
-	// Read body (already logged in doRequest)
 	bodyBytes, readErr := io.ReadAll(resp.Body)
 	if readErr != nil {
 		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusOK); err != nil {
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
 		return nil, err
 	}
 
-	var paymentMode PaymentMode
-	if err := json.Unmarshal(bodyBytes, &paymentMode); err != nil {
-		return nil, fmt.Errorf("error decoding response: %w", err)
-	}
+	// PUT returns 204 No Content, so fetch current state via GET
+	tflog.Debug(ctx, "Update succeeded, fetching current state via GET")
 
-	return &paymentMode, nil
+	return c.GetSequentialIDSchema(ctx, id)
 }
 
-// UpdatePaymentMode updates an existing payment mode
-func (c *EmporixClient) UpdatePaymentMode(ctx context.Context, id string, updateData *PaymentModeUpdate) (*PaymentMode, error) {
-	path := fmt.Sprintf("/payment-gateway/%s/paymentmodes/config/%s", strings.ToLower(c.Tenant), id)
-	resp, err := c.doRequest(ctx, "PUT", path, updateData, nil)
+// SetActiveSequentialIDSchema makes a schema the active schema of its type, which deactivates
+// the schema that was active before. Callers serialize activation with getSequentialIDSchemaMutex.
+func (c *EmporixClient) SetActiveSequentialIDSchema(ctx context.Context, id string) error {
+	path := fmt.Sprintf("/sequential-id/%s/schemas/%s/setActive", strings.ToLower(c.Tenant), id)
+
+	resp, err := c.doRequest(ctx, "POST", path, nil, nil)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer resp.Body.Close()
 
-	// Read body (already logged in doRequest)
 	bodyBytes, readErr := io.ReadAll(resp.Body)
 	if readErr != nil {
-		return nil, fmt.Errorf("error reading response body: %w", readErr)
-	}
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusOK); err != nil {
-		return nil, err
+		return fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	// WORKAROUND: The API returns stale data in the PUT response.
-	// Do a separate GET request to fetch the actual updated state.
-	// This prevents "Provider produced inconsistent result after apply" errors.
-	tflog.Debug(ctx, "Update succeeded, fetching current state via GET (API returns stale data in PUT response)")
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
+		return err
+	}
 
-	return c.GetPaymentMode(ctx, id)
+	return nil
 }
 
-// DeletePaymentMode deletes a payment mode
-func (c *EmporixClient) DeletePaymentMode(ctx context.Context, id string) error {
-	path := fmt.Sprintf("/payment-gateway/%s/paymentmodes/config/%s", strings.ToLower(c.Tenant), id)
+// DeleteSequentialIDSchema deletes a sequential ID schema by ID
+func (c *EmporixClient) DeleteSequentialIDSchema(ctx context.Context, id string) error {
+	path := fmt.Sprintf("/sequential-id/%s/schemas/%s", strings.ToLower(c.Tenant), id)
+
 	resp, err := c.doRequest(ctx, "DELETE", path, nil, nil)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
-	// Read body (already logged in doRequest)
 	bodyBytes, readErr := io.ReadAll(resp.Body)
 	if readErr != nil {
 		return fmt.Errorf("error reading response body: %w", readErr)
 	}
-	return c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusOK, http.StatusNoContent)
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
+		return err
+	}
+
+	return nil
 }
 
-// GetCountry retrieves a country by code
-func (c *EmporixClient) GetCountry(ctx context.Context, code string) (*Country, error) {
-	path := fmt.Sprintf("/country/%s/countries/%s", strings.ToLower(c.Tenant), code)
+// CreateCoupon creates a new coupon
+func (c *EmporixClient) CreateCoupon(ctx context.Context, coupon *Coupon) (*Coupon, error) {
+	path := fmt.Sprintf("/coupon/%s/coupons", strings.ToLower(c.Tenant))
 
-	headers := map[string]string{
-		"X-Version": "v2",
+	resp, err := c.doRequest(ctx, "POST", path, coupon, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	resp, err := c.doRequest(ctx, "GET", path, nil, headers)
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusCreated); err != nil {
+		return nil, err
+	}
+
+	tflog.Debug(ctx, "Coupon created, fetching complete state via GET", map[string]interface{}{
+		"code": coupon.Code,
+	})
+
+	return c.GetCoupon(ctx, coupon.Code)
+}
+
+// GetCoupon retrieves a coupon by code
+func (c *EmporixClient) GetCoupon(ctx context.Context, code string) (*Coupon, error) {
+	path := fmt.Sprintf("/coupon/%s/coupons/%s", strings.ToLower(c.Tenant), code)
+
+	resp, err := c.doRequest(ctx, "GET", path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -507,41 +2567,42 @@ func (c *EmporixClient) GetCountry(ctx context.Context, code string) (*Country,
 		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusOK); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
 		return nil, err
 	}
 
-	var country Country
-	if err := json.Unmarshal(bodyBytes, &country); err != nil {
-		return nil, fmt.Errorf("error decoding country response: %w", err)
+	var coupon Coupon
+	if err := json.Unmarshal(bodyBytes, &coupon); err != nil {
+		return nil, fmt.Errorf("error decoding coupon response: %w", err)
 	}
 
-	return &country, nil
+	return &coupon, nil
 }
 
-// UpdateCountry updates a country's active status
-func (c *EmporixClient) UpdateCountry(ctx context.Context, code string, updateData *CountryUpdate) (*Country, error) {
-	// First, get current country to retrieve metadata.version (required for PATCH)
-	country, err := c.GetCountry(ctx, code)
+// ListCoupons retrieves all coupons matching the options, following pagination
+func (c *EmporixClient) ListCoupons(ctx context.Context, opts *ListOptions) ([]Coupon, error) {
+	path := fmt.Sprintf("/coupon/%s/coupons", strings.ToLower(c.Tenant))
+	return listAllPages[Coupon](ctx, c, path, opts, nil)
+}
+
+// UpdateCoupon fully updates a coupon
+func (c *EmporixClient) UpdateCoupon(ctx context.Context, code string, coupon *Coupon) (*Coupon, error) {
+	// First, get current coupon to retrieve metadata.version (required for PUT)
+	current, err := c.GetCoupon(ctx, code)
 	if err != nil {
-		return nil, fmt.Errorf("error getting country before update: %w", err)
+		return nil, fmt.Errorf("error getting coupon before update: %w", err)
 	}
 
-	// Add metadata.version to update data (required by API)
-	if country.Metadata != nil && country.Metadata.Version > 0 {
-		if updateData.Metadata == nil {
-			updateData.Metadata = &Metadata{}
-		}
-		updateData.Metadata.Version = country.Metadata.Version
+	updateData := *coupon
+	updateData.Code = code
+	updateData.Metadata = nil
+	if current.Metadata != nil && current.Metadata.Version > 0 {
+		updateData.Metadata = &Metadata{Version: current.Metadata.Version}
 	}
 
-	path := fmt.Sprintf("/country/%s/countries/%s", strings.ToLower(c.Tenant), code)
-
-	headers := map[string]string{
-		"X-Version": "v2",
-	}
+	path := fmt.Sprintf("/coupon/%s/coupons/%s", strings.ToLower(c.Tenant), code)
 
-	resp, err := c.doRequest(ctx, "PATCH", path, updateData, headers)
+	resp, err := c.doRequest(ctx, "PUT", path, &updateData, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -552,113 +2613,82 @@ func (c *EmporixClient) UpdateCountry(ctx context.Context, code string, updateDa
 		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusNoContent); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
 		return nil, err
 	}
 
-	// PATCH returns 204 No Content, so fetch current state via GET
+	// PUT returns 204 No Content, so fetch current state via GET
 	tflog.Debug(ctx, "Update succeeded, fetching current state via GET")
 
-	return c.GetCountry(ctx, code)
+	return c.GetCoupon(ctx, code)
 }
 
-// CreateCurrency creates a new currency
-func (c *EmporixClient) CreateCurrency(ctx context.Context, currency *CurrencyCreate) (*Currency, error) {
-	path := fmt.Sprintf("/currency/%s/currencies", strings.ToLower(c.Tenant))
-
-	// Name is always a map, so always use Content-Language: *
-	headers := map[string]string{
-		"Content-Language": "*",
-	}
+// DeleteCoupon deletes a coupon by code
+func (c *EmporixClient) DeleteCoupon(ctx context.Context, code string) error {
+	path := fmt.Sprintf("/coupon/%s/coupons/%s", strings.ToLower(c.Tenant), code)
 
-	resp, err := c.doRequest(ctx, "POST", path, currency, headers)
+	resp, err := c.doRequest(ctx, "DELETE", path, nil, nil)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer resp.Body.Close()
 
 	bodyBytes, readErr := io.ReadAll(resp.Body)
 	if readErr != nil {
-		return nil, fmt.Errorf("error reading response body: %w", readErr)
-	}
-
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusCreated); err != nil {
-		return nil, err
+		return fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	// API may not return complete data in CREATE response (especially when using Content-Language: *)
-	// Always fetch the currency after creation to get complete state with all translations
-	var createdCurrency Currency
-	if err := json.Unmarshal(bodyBytes, &createdCurrency); err != nil {
-		return nil, fmt.Errorf("error decoding currency response: %w", err)
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
+		return err
 	}
 
-	tflog.Debug(ctx, "Currency created, fetching complete state via GET")
-
-	// Fetch complete currency data with all translations
-	return c.GetCurrency(ctx, createdCurrency.Code)
+	return nil
 }
 
-// GetCurrency retrieves a currency by code
-func (c *EmporixClient) GetCurrency(ctx context.Context, code string) (*Currency, error) {
-	path := fmt.Sprintf("/currency/%s/currencies/%s", strings.ToLower(c.Tenant), code)
-
-	// Always use Accept-Language: * to retrieve all translations
-	headers := map[string]string{
-		"Accept-Language": "*",
-	}
+// GetRewardPointsConfig retrieves the reward points configuration of the tenant
+func (c *EmporixClient) GetRewardPointsConfig(ctx context.Context) (*RewardPointsConfig, error) {
+	path := fmt.Sprintf("/reward-points/%s/config", strings.ToLower(c.Tenant))
 
-	resp, err := c.doRequest(ctx, "GET", path, nil, headers)
+	resp, err := c.doRequest(ctx, "GET", path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, &NotFoundError{}
-	}
-
 	bodyBytes, readErr := io.ReadAll(resp.Body)
 	if readErr != nil {
 		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusOK); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
 		return nil, err
 	}
 
-	var currency Currency
-	if err := json.Unmarshal(bodyBytes, &currency); err != nil {
-		return nil, fmt.Errorf("error decoding currency response: %w", err)
+	var config RewardPointsConfig
+	if err := json.Unmarshal(bodyBytes, &config); err != nil {
+		return nil, fmt.Errorf("error decoding reward points config response: %w", err)
 	}
 
-	return &currency, nil
+	return &config, nil
 }
 
-// UpdateCurrency updates a currency
-func (c *EmporixClient) UpdateCurrency(ctx context.Context, code string, updateData *CurrencyUpdate) (*Currency, error) {
-	// First, get current currency to retrieve metadata.version (required for PUT)
-	currency, err := c.GetCurrency(ctx, code)
+// UpdateRewardPointsConfig fully updates the reward points configuration of the tenant
+func (c *EmporixClient) UpdateRewardPointsConfig(ctx context.Context, config *RewardPointsConfig) (*RewardPointsConfig, error) {
+	// First, get current config to retrieve metadata.version (required for PUT)
+	current, err := c.GetRewardPointsConfig(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("error getting currency before update: %w", err)
+		return nil, fmt.Errorf("error getting reward points config before update: %w", err)
 	}
 
-	// Add metadata.version to update data (required by API)
-	if currency.Metadata != nil && currency.Metadata.Version > 0 {
-		if updateData.Metadata == nil {
-			updateData.Metadata = &Metadata{}
-		}
-		updateData.Metadata.Version = currency.Metadata.Version
+	updateData := *config
+	updateData.Metadata = nil
+	if current.Metadata != nil && current.Metadata.Version > 0 {
+		updateData.Metadata = &Metadata{Version: current.Metadata.Version}
 	}
 
-	path := fmt.Sprintf("/currency/%s/currencies/%s", strings.ToLower(c.Tenant), code)
-
-	// Name is always a map, so always use Content-Language: *
-	headers := map[string]string{
-		"Content-Language": "*",
-	}
+	path := fmt.Sprintf("/reward-points/%s/config", strings.ToLower(c.Tenant))
 
-	resp, err := c.doRequest(ctx, "PUT", path, updateData, headers)
+	resp, err := c.doRequest(ctx, "PUT", path, &updateData, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -669,36 +2699,14 @@ func (c *EmporixClient) UpdateCurrency(ctx context.Context, code string, updateD
 		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusNoContent); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
 		return nil, err
 	}
 
 	// PUT returns 204 No Content, so fetch current state via GET
 	tflog.Debug(ctx, "Update succeeded, fetching current state via GET")
 
-	return c.GetCurrency(ctx, code)
-}
-
-// DeleteCurrency deletes a currency by code
-func (c *EmporixClient) DeleteCurrency(ctx context.Context, code string) error {
-	path := fmt.Sprintf("/currency/%s/currencies/%s", strings.ToLower(c.Tenant), code)
-
-	resp, err := c.doRequest(ctx, "DELETE", path, nil, nil)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	bodyBytes, readErr := io.ReadAll(resp.Body)
-	if readErr != nil {
-		return fmt.Errorf("error reading response body: %w", readErr)
-	}
-
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusNoContent); err != nil {
-		return err
-	}
-
-	return nil
+	return c.GetRewardPointsConfig(ctx)
 }
 
 // CreateTax creates a new tax configuration
@@ -721,7 +2729,7 @@ func (c *EmporixClient) CreateTax(ctx context.Context, taxCreate *TaxCreate) (*T
 		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusCreated); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusCreated); err != nil {
 		return nil, err
 	}
 
@@ -763,7 +2771,7 @@ func (c *EmporixClient) GetTax(ctx context.Context, locationCode string) (*Tax,
 		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusOK); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
 		return nil, err
 	}
 
@@ -775,6 +2783,18 @@ func (c *EmporixClient) GetTax(ctx context.Context, locationCode string) (*Tax,
 	return &tax, nil
 }
 
+// ListTaxes retrieves all tax configurations of the tenant, following pagination
+func (c *EmporixClient) ListTaxes(ctx context.Context, opts *ListOptions) ([]Tax, error) {
+	path := fmt.Sprintf("/tax/%s/taxes", strings.ToLower(c.Tenant))
+
+	// Always use Accept-Language: * to retrieve all translations
+	headers := map[string]string{
+		"Accept-Language": "*",
+	}
+
+	return listAllPages[Tax](ctx, c, path, opts, headers)
+}
+
 // UpdateTax updates a tax configuration
 func (c *EmporixClient) UpdateTax(ctx context.Context, locationCode string, updateData *TaxUpdate) (*Tax, error) {
 	// First, get current tax to retrieve metadata.version (required for PUT)
@@ -809,7 +2829,7 @@ func (c *EmporixClient) UpdateTax(ctx context.Context, locationCode string, upda
 		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusNoContent); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
 		return nil, err
 	}
 
@@ -834,7 +2854,7 @@ func (c *EmporixClient) DeleteTax(ctx context.Context, locationCode string) erro
 		return fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusNoContent); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
 		return err
 	}
 
@@ -859,7 +2879,7 @@ func (c *EmporixClient) CreateTenantConfiguration(ctx context.Context, config *T
 		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusCreated); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusCreated); err != nil {
 		return nil, err
 	}
 
@@ -895,7 +2915,7 @@ func (c *EmporixClient) GetTenantConfiguration(ctx context.Context, key string)
 		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusOK); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
 		return nil, err
 	}
 
@@ -907,6 +2927,13 @@ func (c *EmporixClient) GetTenantConfiguration(ctx context.Context, key string)
 	return &config, nil
 }
 
+// ListTenantConfigurations retrieves all tenant configurations
+func (c *EmporixClient) ListTenantConfigurations(ctx context.Context) ([]TenantConfiguration, error) {
+	path := fmt.Sprintf("/configuration/%s/configurations", strings.ToLower(c.Tenant))
+
+	return listUnpaged[TenantConfiguration](ctx, c, path, nil)
+}
+
 // UpdateTenantConfiguration updates a tenant configuration
 func (c *EmporixClient) UpdateTenantConfiguration(ctx context.Context, key string, updateData *TenantConfigurationUpdate) (*TenantConfiguration, error) {
 	path := fmt.Sprintf("/configuration/%s/configurations/%s", strings.ToLower(c.Tenant), key)
@@ -922,7 +2949,7 @@ func (c *EmporixClient) UpdateTenantConfiguration(ctx context.Context, key strin
 		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusOK); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
 		return nil, err
 	}
 
@@ -949,7 +2976,7 @@ func (c *EmporixClient) DeleteTenantConfiguration(ctx context.Context, key strin
 		return fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusNoContent); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
 		return err
 	}
 
@@ -975,7 +3002,7 @@ func (c *EmporixClient) ListWebhooks(ctx context.Context) ([]WebhookConfigGet, e
 		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusOK); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
 		return nil, err
 	}
 
@@ -1007,7 +3034,7 @@ func (c *EmporixClient) CreateWebhook(ctx context.Context, config *webhookCreate
 		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusCreated); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusCreated); err != nil {
 		// Provide a more helpful error message for 409 Conflict (resource already exists)
 		if resp.StatusCode == http.StatusConflict {
 			return nil, fmt.Errorf("webhook configuration with code %q already exists. "+
@@ -1051,7 +3078,7 @@ func (c *EmporixClient) GetWebhook(ctx context.Context, code string) (*WebhookCo
 		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusOK); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
 		return nil, err
 	}
 
@@ -1084,7 +3111,7 @@ func (c *EmporixClient) UpdateWebhook(ctx context.Context, code string, patches
 		return nil, nil
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusOK); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
 		return nil, err
 	}
 
@@ -1115,7 +3142,7 @@ func (c *EmporixClient) DeleteWebhook(ctx context.Context, code string) error {
 	}
 
 	// API may return 200, 204, or 205 for successful deletion
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusOK, http.StatusNoContent, http.StatusAccepted); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK, http.StatusNoContent, http.StatusAccepted); err != nil {
 		return err
 	}
 
@@ -1134,7 +3161,7 @@ func (c *EmporixClient) ListEventSubscriptions(ctx context.Context) ([]WebhookEv
 	if readErr != nil {
 		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusOK); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
 		return nil, err
 	}
 
@@ -1161,7 +3188,7 @@ func (c *EmporixClient) UpdateEventSubscriptions(ctx context.Context, updates []
 		return fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusOK, http.StatusMultiStatus); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK, http.StatusMultiStatus); err != nil {
 		return err
 	}
 
@@ -1202,7 +3229,7 @@ func (c *EmporixClient) CreateShippingZone(ctx context.Context, site string, zon
 	}
 
 	// Accept both 201 Created and 200 OK for successful creates
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusCreated, http.StatusOK); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusCreated, http.StatusOK); err != nil {
 		return nil, err
 	}
 
@@ -1250,7 +3277,7 @@ func (c *EmporixClient) GetShippingZone(ctx context.Context, site, zoneID string
 		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusOK); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
 		return nil, err
 	}
 
@@ -1262,8 +3289,8 @@ func (c *EmporixClient) GetShippingZone(ctx context.Context, site, zoneID string
 	return &zone, nil
 }
 
-// ListShippingZones retrieves all shipping zones for a site
-func (c *EmporixClient) ListShippingZones(ctx context.Context, site string) ([]ShippingZone, error) {
+// ListShippingZones retrieves all shipping zones for a site, following pagination
+func (c *EmporixClient) ListShippingZones(ctx context.Context, site string, opts *ListOptions) ([]ShippingZone, error) {
 	// Lock for this tenant's shipping zone operations
 	mu := getShippingZoneMutex(c.Tenant)
 	mu.Lock()
@@ -1271,27 +3298,7 @@ func (c *EmporixClient) ListShippingZones(ctx context.Context, site string) ([]S
 
 	path := fmt.Sprintf("/shipping/%s/%s/zones", strings.ToLower(c.Tenant), site)
 
-	resp, err := c.doRequest(ctx, "GET", path, nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	bodyBytes, readErr := io.ReadAll(resp.Body)
-	if readErr != nil {
-		return nil, fmt.Errorf("error reading response body: %w", readErr)
-	}
-
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusOK); err != nil {
-		return nil, err
-	}
-
-	var zones []ShippingZone
-	if err := json.Unmarshal(bodyBytes, &zones); err != nil {
-		return nil, fmt.Errorf("error decoding shipping zones list: %w", err)
-	}
-
-	return zones, nil
+	return listAllPages[ShippingZone](ctx, c, path, opts, nil)
 }
 
 // UpdateShippingZone updates a shipping zone
@@ -1315,7 +3322,7 @@ func (c *EmporixClient) UpdateShippingZone(ctx context.Context, site, zoneID str
 	}
 
 	// Accept both 200 OK and 204 No Content for successful updates
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusOK, http.StatusNoContent); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK, http.StatusNoContent); err != nil {
 		return nil, err
 	}
 
@@ -1359,7 +3366,7 @@ func (c *EmporixClient) DeleteShippingZone(ctx context.Context, site, zoneID str
 		return fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusNoContent); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
 		return err
 	}
 
@@ -1386,7 +3393,7 @@ func (c *EmporixClient) CreateSchema(ctx context.Context, schema *SchemaCreate)
 		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusCreated); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusCreated); err != nil {
 		return nil, err
 	}
 
@@ -1426,7 +3433,7 @@ func (c *EmporixClient) GetSchema(ctx context.Context, id string) (*Schema, erro
 		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusOK); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
 		return nil, err
 	}
 
@@ -1438,6 +3445,18 @@ func (c *EmporixClient) GetSchema(ctx context.Context, id string) (*Schema, erro
 	return &schema, nil
 }
 
+// ListSchemas retrieves all schemas of the tenant, following pagination
+func (c *EmporixClient) ListSchemas(ctx context.Context, opts *ListOptions) ([]Schema, error) {
+	path := fmt.Sprintf("/schema/%s/schemas", strings.ToLower(c.Tenant))
+
+	// Always use Accept-Language: * to retrieve all translations
+	headers := map[string]string{
+		"Accept-Language": "*",
+	}
+
+	return listAllPages[Schema](ctx, c, path, opts, headers)
+}
+
 // UpdateSchema updates a schema
 func (c *EmporixClient) UpdateSchema(ctx context.Context, id string, updateData *SchemaUpdate) (*Schema, error) {
 	// First, get current schema to retrieve metadata.version (required for PUT)
@@ -1472,7 +3491,7 @@ func (c *EmporixClient) UpdateSchema(ctx context.Context, id string, updateData
 		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusNoContent); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
 		return nil, err
 	}
 
@@ -1497,7 +3516,7 @@ func (c *EmporixClient) DeleteSchema(ctx context.Context, id string) error {
 		return fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusNoContent); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
 		return err
 	}
 
@@ -1524,7 +3543,7 @@ func (c *EmporixClient) CreateCustomEntityType(ctx context.Context, typeCreate *
 		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusCreated); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusCreated); err != nil {
 		return nil, err
 	}
 
@@ -1563,7 +3582,7 @@ func (c *EmporixClient) GetCustomEntityType(ctx context.Context, id string) (*Cu
 		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusOK); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
 		return nil, err
 	}
 
@@ -1575,6 +3594,18 @@ func (c *EmporixClient) GetCustomEntityType(ctx context.Context, id string) (*Cu
 	return &entityType, nil
 }
 
+// ListCustomEntityTypes retrieves all custom schema types of the tenant, following pagination
+func (c *EmporixClient) ListCustomEntityTypes(ctx context.Context, opts *ListOptions) ([]CustomEntityType, error) {
+	path := fmt.Sprintf("/schema/%s/custom-entities", strings.ToLower(c.Tenant))
+
+	// Always use Accept-Language: * to retrieve all translations
+	headers := map[string]string{
+		"Accept-Language": "*",
+	}
+
+	return listAllPages[CustomEntityType](ctx, c, path, opts, headers)
+}
+
 // UpdateCustomEntityType updates (upserts) a custom schema type
 func (c *EmporixClient) UpdateCustomEntityType(ctx context.Context, id string, updateData *CustomEntityTypeUpdate) (*CustomEntityType, error) {
 	// First, get the current type to retrieve metadata.version (used for optimistic locking)
@@ -1609,7 +3640,7 @@ func (c *EmporixClient) UpdateCustomEntityType(ctx context.Context, id string, u
 	}
 
 	// Documented as 201 (created) or 204 (updated); neither carries a body, so fetch via GET.
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusCreated, http.StatusNoContent, http.StatusOK); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusCreated, http.StatusNoContent, http.StatusOK); err != nil {
 		return nil, err
 	}
 
@@ -1634,7 +3665,7 @@ func (c *EmporixClient) DeleteCustomEntityType(ctx context.Context, id string) e
 		return fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusNoContent); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
 		if resp.StatusCode == http.StatusBadRequest {
 			return fmt.Errorf("%w (custom entity types cannot be deleted while schemas or instances still reference them)", err)
 		}
@@ -1664,7 +3695,7 @@ func (c *EmporixClient) CreateCustomEntityInstance(ctx context.Context, entityTy
 		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusCreated, http.StatusOK); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusCreated, http.StatusOK); err != nil {
 		return nil, err
 	}
 
@@ -1713,7 +3744,7 @@ func (c *EmporixClient) GetCustomEntityInstance(ctx context.Context, entityType,
 		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusOK); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
 		return nil, err
 	}
 
@@ -1725,6 +3756,18 @@ func (c *EmporixClient) GetCustomEntityInstance(ctx context.Context, entityType,
 	return &instance, nil
 }
 
+// ListCustomEntityInstances retrieves all instances of a custom entity type, following pagination
+func (c *EmporixClient) ListCustomEntityInstances(ctx context.Context, entityType string, opts *ListOptions) ([]CustomEntityInstance, error) {
+	path := fmt.Sprintf("/schema/%s/custom-entities/%s/instances", strings.ToLower(c.Tenant), entityType)
+
+	// Always use Accept-Language: * to retrieve all translations
+	headers := map[string]string{
+		"Accept-Language": "*",
+	}
+
+	return listAllPages[CustomEntityInstance](ctx, c, path, opts, headers)
+}
+
 // UpdateCustomEntityInstance updates (upserts) a custom entity instance
 func (c *EmporixClient) UpdateCustomEntityInstance(ctx context.Context, entityType, id string, updateData *CustomEntityInstanceUpdate) (*CustomEntityInstance, error) {
 	// First, get the current instance to retrieve metadata.version (used for optimistic locking)
@@ -1759,7 +3802,7 @@ func (c *EmporixClient) UpdateCustomEntityInstance(ctx context.Context, entityTy
 	}
 
 	// The API may return the updated instance directly (200) or no content (204).
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusOK, http.StatusNoContent); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK, http.StatusNoContent); err != nil {
 		return nil, err
 	}
 
@@ -1791,7 +3834,7 @@ func (c *EmporixClient) DeleteCustomEntityInstance(ctx context.Context, entityTy
 		return fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusNoContent); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent); err != nil {
 		return err
 	}
 
@@ -1815,7 +3858,7 @@ func (c *EmporixClient) CreateDeliveryTime(ctx context.Context, deliveryTime *De
 	}
 
 	// Accept both 201 Created and 200 OK
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusCreated, http.StatusOK); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusCreated, http.StatusOK); err != nil {
 		return nil, err
 	}
 
@@ -1855,7 +3898,7 @@ func (c *EmporixClient) GetDeliveryTime(ctx context.Context, id string) (*Delive
 		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusOK); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
 		return nil, err
 	}
 
@@ -1867,6 +3910,13 @@ func (c *EmporixClient) GetDeliveryTime(ctx context.Context, id string) (*Delive
 	return &deliveryTime, nil
 }
 
+// ListDeliveryTimes retrieves all delivery times of the tenant, following pagination
+func (c *EmporixClient) ListDeliveryTimes(ctx context.Context, opts *ListOptions) ([]DeliveryTime, error) {
+	path := fmt.Sprintf("/shipping/%s/delivery-times", strings.ToLower(c.Tenant))
+
+	return listAllPages[DeliveryTime](ctx, c, path, opts, nil)
+}
+
 // UpdateDeliveryTime updates a delivery time
 func (c *EmporixClient) UpdateDeliveryTime(ctx context.Context, id string, deliveryTime *DeliveryTime) (*DeliveryTime, error) {
 	path := fmt.Sprintf("/shipping/%s/delivery-times/%s", strings.ToLower(c.Tenant), id)
@@ -1883,7 +3933,7 @@ func (c *EmporixClient) UpdateDeliveryTime(ctx context.Context, id string, deliv
 	}
 
 	// Accept both 200 OK and 204 No Content
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusOK, http.StatusNoContent); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK, http.StatusNoContent); err != nil {
 		return nil, err
 	}
 
@@ -1919,7 +3969,7 @@ func (c *EmporixClient) DeleteDeliveryTime(ctx context.Context, id string) error
 		return fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusNoContent, http.StatusOK); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent, http.StatusOK); err != nil {
 		return err
 	}
 
@@ -1947,7 +3997,7 @@ func (c *EmporixClient) CreateShippingMethod(ctx context.Context, site, zoneID s
 		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusCreated, http.StatusOK); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusCreated, http.StatusOK); err != nil {
 		return nil, err
 	}
 
@@ -1983,7 +4033,7 @@ func (c *EmporixClient) GetShippingMethod(ctx context.Context, site, zoneID, id
 		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusOK); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
 		return nil, err
 	}
 
@@ -1995,6 +4045,18 @@ func (c *EmporixClient) GetShippingMethod(ctx context.Context, site, zoneID, id
 	return &shippingMethod, nil
 }
 
+// ListShippingMethods retrieves all shipping methods of a zone, following pagination
+func (c *EmporixClient) ListShippingMethods(ctx context.Context, site, zoneID string, opts *ListOptions) ([]ShippingMethod, error) {
+	// Lock for this tenant's shipping method operations
+	mu := getShippingMethodMutex(c.Tenant)
+	mu.Lock()
+	defer mu.Unlock()
+
+	path := fmt.Sprintf("/shipping/%s/%s/zones/%s/methods", strings.ToLower(c.Tenant), site, zoneID)
+
+	return listAllPages[ShippingMethod](ctx, c, path, opts, nil)
+}
+
 // UpdateShippingMethod updates a shipping method
 func (c *EmporixClient) UpdateShippingMethod(ctx context.Context, site, zoneID, id string, shippingMethod *ShippingMethod) (*ShippingMethod, error) {
 	// Lock for this tenant's shipping method operations
@@ -2015,7 +4077,7 @@ func (c *EmporixClient) UpdateShippingMethod(ctx context.Context, site, zoneID,
 		return nil, fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusOK, http.StatusNoContent); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK, http.StatusNoContent); err != nil {
 		return nil, err
 	}
 
@@ -2056,7 +4118,7 @@ func (c *EmporixClient) DeleteShippingMethod(ctx context.Context, site, zoneID,
 		return fmt.Errorf("error reading response body: %w", readErr)
 	}
 
-	if err := c.checkResponse(ctx, resp.StatusCode, bodyBytes, http.StatusNoContent, http.StatusOK); err != nil {
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusNoContent, http.StatusOK); err != nil {
 		return err
 	}
 
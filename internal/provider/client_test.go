@@ -0,0 +1,753 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"terraform-provider-emporix/internal/emporixfake"
+)
+
+// newFakeAPIClient returns a client authenticated against a fresh fake Emporix API
+func newFakeAPIClient(t *testing.T) (*EmporixClient, *emporixfake.Server) {
+	t.Helper()
+
+	server := emporixfake.New()
+	t.Cleanup(server.Close)
+
+	tokens := newClientCredentialsTokenSource(server.URL, server.ClientID, server.ClientSecret, "")
+	return newEmporixClientWithTokenSource(server.Tenant, tokens, server.URL), server
+}
+
+func TestFakeAPI_SiteLifecycle(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newFakeAPIClient(t)
+
+	site := &SiteSettings{
+		Code:            "main",
+		Name:            "Main Site",
+		Active:          true,
+		DefaultLanguage: "en",
+		Languages:       []string{"en"},
+		Currency:        "EUR",
+	}
+	if err := client.CreateSite(ctx, site); err != nil {
+		t.Fatalf("unexpected error creating site: %s", err)
+	}
+
+	err := client.CreateSite(ctx, site)
+	if apiErr, ok := AsAPIError(err); !ok || apiErr.StatusCode != http.StatusConflict {
+		t.Fatalf("expected a 409 APIError for a duplicate site, got %v", err)
+	}
+
+	if err := client.UpdateSite(ctx, "main", map[string]interface{}{"name": "Renamed"}); err != nil {
+		t.Fatalf("unexpected error updating site: %s", err)
+	}
+	if err := client.PostSiteMixin(ctx, "main", "branding", map[string]interface{}{"color": "blue"}, "https://example.com/branding.json"); err != nil {
+		t.Fatalf("unexpected error adding mixin: %s", err)
+	}
+
+	got, err := client.GetSite(ctx, "main")
+	if err != nil {
+		t.Fatalf("unexpected error reading site: %s", err)
+	}
+	if got.Name != "Renamed" {
+		t.Errorf("expected name to be updated, got %q", got.Name)
+	}
+	if got.Metadata == nil || got.Metadata.Version != 3 {
+		t.Errorf("expected version 3 after two updates, got %+v", got.Metadata)
+	}
+	if got.Metadata == nil || got.Metadata.Mixins["branding"] != "https://example.com/branding.json" {
+		t.Errorf("expected mixin schema URL in metadata, got %+v", got.Metadata)
+	}
+	if mixin, ok := got.Mixins["branding"].(map[string]interface{}); !ok || mixin["color"] != "blue" {
+		t.Errorf("expected expanded mixin fields, got %v", got.Mixins)
+	}
+
+	if err := client.DeleteSite(ctx, "main"); err != nil {
+		t.Fatalf("unexpected error deleting site: %s", err)
+	}
+	if _, err := client.GetSite(ctx, "main"); !IsNotFound(err) {
+		t.Fatalf("expected NotFoundError after delete, got %v", err)
+	}
+}
+
+func TestFakeAPI_OptimisticLocking(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newFakeAPIClient(t)
+
+	created, err := client.CreateCurrency(ctx, &CurrencyCreate{Code: "EUR", Name: map[string]string{"en": "Euro"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating currency: %s", err)
+	}
+	if created.Metadata == nil || created.Metadata.Version != 1 {
+		t.Fatalf("expected version 1 after create, got %+v", created.Metadata)
+	}
+
+	updated, err := client.UpdateCurrency(ctx, "EUR", &CurrencyUpdate{Name: map[string]string{"en": "Euro", "de": "Euro"}})
+	if err != nil {
+		t.Fatalf("unexpected error updating currency: %s", err)
+	}
+	if updated.Metadata == nil || updated.Metadata.Version != 2 {
+		t.Fatalf("expected version 2 after update, got %+v", updated.Metadata)
+	}
+
+	// A write based on the stale version is rejected
+	resp, err := client.doRequest(ctx, "PUT", "/currency/"+client.Tenant+"/currencies/EUR", &CurrencyUpdate{
+		Name:     map[string]string{"en": "Stale"},
+		Metadata: &Metadata{Version: 1},
+	}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusConflict {
+		t.Fatalf("expected 409 for a stale version, got %d", resp.StatusCode)
+	}
+}
+
+func TestFakeAPI_Units(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newFakeAPIClient(t)
+
+	gram := &Unit{Code: "g", Name: map[string]string{"en": "Gram"}, Type: "mass", Factor: 0.001}
+	created, err := client.CreateUnit(ctx, gram)
+	if err != nil {
+		t.Fatalf("unexpected error creating unit: %s", err)
+	}
+	if created.Type != "mass" || created.Factor != 0.001 || created.Name["en"] != "Gram" {
+		t.Errorf("expected the created unit to be read back, got %+v", created)
+	}
+
+	gram.Name = map[string]string{"en": "Gram", "de": "Gramm"}
+	updated, err := client.UpdateUnit(ctx, "g", gram)
+	if err != nil {
+		t.Fatalf("unexpected error updating unit: %s", err)
+	}
+	if updated.Name["de"] != "Gramm" || updated.Metadata == nil || updated.Metadata.Version != 2 {
+		t.Errorf("expected the translation and version 2 after update, got %+v", updated)
+	}
+
+	if err := client.DeleteUnit(ctx, "g"); err != nil {
+		t.Fatalf("unexpected error deleting unit: %s", err)
+	}
+	if _, err := client.GetUnit(ctx, "g"); !IsNotFound(err) {
+		t.Fatalf("expected NotFoundError after delete, got %v", err)
+	}
+}
+
+func TestFakeAPI_Categories(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newFakeAPIClient(t)
+
+	shoes, err := client.CreateCategory(ctx, &Category{Name: map[string]string{"en": "Shoes"}, Published: true})
+	if err != nil {
+		t.Fatalf("unexpected error creating category: %s", err)
+	}
+	if shoes.ID == "" || shoes.LocalizedSlug["en"] != "shoes" {
+		t.Errorf("expected a generated ID and slug, got %+v", shoes)
+	}
+
+	var children []*Category
+	for _, name := range []string{"Sneakers", "Boots"} {
+		child, err := client.CreateCategory(ctx, &Category{Name: map[string]string{"en": name}, ParentID: shoes.ID})
+		if err != nil {
+			t.Fatalf("unexpected error creating subcategory %s: %s", name, err)
+		}
+		children = append(children, child)
+	}
+	if children[1].Position == nil || *children[1].Position != 1 {
+		t.Errorf("expected the second child to be appended at position 1, got %+v", children[1].Position)
+	}
+
+	boots := children[1]
+	boots.ParentID = ""
+	boots.Mixins = map[string]interface{}{"seo": map[string]interface{}{"keywords": "boots"}}
+	boots.Metadata = &Metadata{Mixins: map[string]string{"seo": "https://example.com/seo.json"}}
+	moved, err := client.UpdateCategory(ctx, boots.ID, boots)
+	if err != nil {
+		t.Fatalf("unexpected error moving category: %s", err)
+	}
+	if moved.ParentID != "" || moved.Metadata == nil || moved.Metadata.Version != 2 || moved.Metadata.Mixins["seo"] != "https://example.com/seo.json" {
+		t.Errorf("expected a root category with its mixin at version 2, got %+v", moved)
+	}
+
+	if _, err := client.UpdateCategory(ctx, shoes.ID, &Category{Name: shoes.Name, ParentID: children[0].ID}); err == nil {
+		t.Error("expected an error moving a category below its own child")
+	}
+
+	assignment, err := client.CreateCategoryAssignment(ctx, shoes.ID, "product-1")
+	if err != nil {
+		t.Fatalf("unexpected error assigning product: %s", err)
+	}
+	if assignment.ID == "" || assignment.Ref.ID != "product-1" || assignment.Ref.Type != "PRODUCT" {
+		t.Errorf("expected the assignment to be read back, got %+v", assignment)
+	}
+	if _, err := client.CreateCategoryAssignment(ctx, shoes.ID, "product-1"); err == nil {
+		t.Error("expected an error assigning a product twice")
+	}
+	if err := client.DeleteCategoryAssignment(ctx, shoes.ID, "product-1"); err != nil {
+		t.Fatalf("unexpected error removing assignment: %s", err)
+	}
+	if _, err := client.GetCategoryAssignment(ctx, shoes.ID, "product-1"); !IsNotFound(err) {
+		t.Errorf("expected NotFoundError after removing the assignment, got %v", err)
+	}
+
+	// Subcategories outlive their deleted parent
+	if err := client.DeleteCategory(ctx, shoes.ID); err != nil {
+		t.Fatalf("unexpected error deleting category: %s", err)
+	}
+	if _, err := client.GetCategoryAssignment(ctx, shoes.ID, "product-1"); !IsNotFound(err) {
+		t.Errorf("expected NotFoundError for an assignment of a deleted category, got %v", err)
+	}
+	sneakers, err := client.GetCategory(ctx, children[0].ID)
+	if err != nil {
+		t.Fatalf("expected the subcategory to be kept, got %v", err)
+	}
+	if sneakers.ParentID != "" {
+		t.Errorf("expected the subcategory to become a root category, got parent %q", sneakers.ParentID)
+	}
+
+	categories, err := client.ListCategories(ctx, nil)
+	if err != nil {
+		t.Fatalf("unexpected error listing categories: %s", err)
+	}
+	if len(categories) != 2 {
+		t.Errorf("expected 2 remaining categories, got %d", len(categories))
+	}
+}
+
+func TestFakeAPI_PriceLists(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newFakeAPIClient(t)
+
+	priceList := &PriceList{
+		Name:           map[string]string{"en": "B2B"},
+		Currency:       "EUR",
+		SiteCode:       "main",
+		CustomerGroups: []string{"b2b"},
+		Validity:       &Validity{From: "2024-01-01T00:00:00.000Z"},
+	}
+	created, err := client.CreatePriceList(ctx, priceList)
+	if err != nil {
+		t.Fatalf("unexpected error creating price list: %s", err)
+	}
+	if created.ID == "" || created.Validity == nil || created.Validity.From != "2024-01-01T00:00:00.000Z" {
+		t.Errorf("expected the created price list to be read back, got %+v", created)
+	}
+
+	priceList.Validity = &Validity{From: "2024-01-01T00:00:00.000Z", To: "2023-01-01T00:00:00.000Z"}
+	_, err = client.UpdatePriceList(ctx, created.ID, priceList)
+	if apiErr, ok := AsAPIError(err); !ok || apiErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected a 400 APIError for a validity ending before it starts, got %v", err)
+	}
+
+	priceList.Validity = nil
+	priceList.CustomerGroups = nil
+	updated, err := client.UpdatePriceList(ctx, created.ID, priceList)
+	if err != nil {
+		t.Fatalf("unexpected error updating price list: %s", err)
+	}
+	if updated.Validity != nil || len(updated.CustomerGroups) != 0 || updated.Metadata == nil || updated.Metadata.Version != 2 {
+		t.Errorf("expected an unrestricted price list at version 2, got %+v", updated)
+	}
+
+	if err := client.DeletePriceList(ctx, created.ID); err != nil {
+		t.Fatalf("unexpected error deleting price list: %s", err)
+	}
+	if _, err := client.GetPriceList(ctx, created.ID); !IsNotFound(err) {
+		t.Fatalf("expected NotFoundError after delete, got %v", err)
+	}
+}
+
+func TestFakeAPI_IAMGroups(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newFakeAPIClient(t)
+
+	group := &IAMGroup{
+		Name:      map[string]string{"en": "Order Managers"},
+		UserType:  "EMPLOYEE",
+		Templates: []IAMReference{{ID: "order-manager-template"}},
+	}
+	created, err := client.CreateIAMGroup(ctx, group)
+	if err != nil {
+		t.Fatalf("unexpected error creating IAM group: %s", err)
+	}
+
+	group.AccessControls = []IAMReference{{ID: "orders-read"}}
+	updated, err := client.UpdateIAMGroup(ctx, created.ID, group)
+	if err != nil {
+		t.Fatalf("unexpected error updating IAM group: %s", err)
+	}
+	if len(updated.AccessControls) != 1 || updated.Metadata == nil || updated.Metadata.Version != 2 {
+		t.Errorf("expected the access control to be bound at version 2, got %+v", updated)
+	}
+
+	if _, err := client.CreateIAMGroupMember(ctx, created.ID, "customer-1", "CUSTOMER"); err == nil {
+		t.Error("expected a user of another type to be rejected")
+	}
+	member, err := client.CreateIAMGroupMember(ctx, created.ID, "employee-1", created.UserType)
+	if err != nil {
+		t.Fatalf("unexpected error adding user to IAM group: %s", err)
+	}
+	if member.ID != "employee-1" {
+		t.Errorf("expected member employee-1, got %+v", member)
+	}
+
+	if err := client.DeleteIAMGroupMember(ctx, created.ID, "employee-1"); err != nil {
+		t.Fatalf("unexpected error removing user from IAM group: %s", err)
+	}
+	if _, err := client.GetIAMGroupMember(ctx, created.ID, "employee-1"); !IsNotFound(err) {
+		t.Errorf("expected NotFoundError for a removed member, got %v", err)
+	}
+
+	// Deleting the group, e.g. in the UI, surfaces as drift on the group and its memberships
+	if _, err := client.CreateIAMGroupMember(ctx, created.ID, "employee-2", created.UserType); err != nil {
+		t.Fatalf("unexpected error adding user to IAM group: %s", err)
+	}
+	if err := client.DeleteIAMGroup(ctx, created.ID); err != nil {
+		t.Fatalf("unexpected error deleting IAM group: %s", err)
+	}
+	if _, err := client.GetIAMGroup(ctx, created.ID); !IsNotFound(err) {
+		t.Errorf("expected NotFoundError after delete, got %v", err)
+	}
+	if _, err := client.GetIAMGroupMember(ctx, created.ID, "employee-2"); !IsNotFound(err) {
+		t.Errorf("expected NotFoundError for a member of a deleted group, got %v", err)
+	}
+}
+
+func TestFakeAPI_APIClients(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newFakeAPIClient(t)
+
+	apiClient := &APIClient{Name: "storefront", Scopes: []string{"site.site_read"}}
+	created, err := client.CreateAPIClient(ctx, apiClient)
+	if err != nil {
+		t.Fatalf("unexpected error creating API client: %s", err)
+	}
+	if created.ClientID == "" || created.ClientSecret == "" {
+		t.Fatalf("expected credentials on create, got %+v", created)
+	}
+
+	read, err := client.GetAPIClient(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("unexpected error reading API client: %s", err)
+	}
+	if read.ClientSecret != "" {
+		t.Errorf("expected the secret to be returned on create only, got %q", read.ClientSecret)
+	}
+
+	apiClient.Scopes = []string{"site.site_read", "category.category_read"}
+	updated, err := client.UpdateAPIClient(ctx, created.ID, apiClient)
+	if err != nil {
+		t.Fatalf("unexpected error updating API client: %s", err)
+	}
+	if len(updated.Scopes) != 2 || updated.ClientID != created.ClientID {
+		t.Errorf("expected new scopes with the same client ID, got %+v", updated)
+	}
+
+	if err := client.DeleteAPIClient(ctx, created.ID); err != nil {
+		t.Fatalf("unexpected error deleting API client: %s", err)
+	}
+	if _, err := client.GetAPIClient(ctx, created.ID); !IsNotFound(err) {
+		t.Fatalf("expected NotFoundError after delete, got %v", err)
+	}
+}
+
+func TestFakeAPI_CustomerSegments(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newFakeAPIClient(t)
+
+	segment := &CustomerSegment{
+		Name:     map[string]string{"en": "VIP", "de": "VIP"},
+		SiteCode: "main",
+		Validity: &Validity{From: "2024-01-01T00:00:00.000Z"},
+	}
+	created, err := client.CreateCustomerSegment(ctx, segment)
+	if err != nil {
+		t.Fatalf("unexpected error creating customer segment: %s", err)
+	}
+
+	segment.Description = map[string]string{"en": "Best customers"}
+	updated, err := client.UpdateCustomerSegment(ctx, created.ID, segment)
+	if err != nil {
+		t.Fatalf("unexpected error updating customer segment: %s", err)
+	}
+	if updated.Description["en"] != "Best customers" || updated.Metadata == nil || updated.Metadata.Version != 2 {
+		t.Errorf("expected the description at version 2, got %+v", updated)
+	}
+
+	for _, customerID := range []string{"customer-1", "customer-1", "customer-2"} {
+		if err := client.AddCustomerSegmentMember(ctx, created.ID, customerID); err != nil {
+			t.Fatalf("unexpected error adding customer %s: %s", customerID, err)
+		}
+	}
+	if err := client.RemoveCustomerSegmentMember(ctx, created.ID, "customer-2"); err != nil {
+		t.Fatalf("unexpected error removing customer: %s", err)
+	}
+	members, err := client.ListCustomerSegmentMembers(ctx, created.ID, nil)
+	if err != nil {
+		t.Fatalf("unexpected error listing members: %s", err)
+	}
+	if len(members) != 1 || members[0].ID != "customer-1" {
+		t.Errorf("expected customer-1 as the only member, got %+v", members)
+	}
+
+	item, err := client.CreateCustomerSegmentItem(ctx, created.ID, "CATEGORY", "category-1")
+	if err != nil {
+		t.Fatalf("unexpected error attaching category: %s", err)
+	}
+	if _, err := client.GetCustomerSegmentItem(ctx, created.ID, "PRODUCT", "category-1"); !IsNotFound(err) {
+		t.Errorf("expected items to be matched by type, got %v", err)
+	}
+	if err := client.DeleteCustomerSegmentItem(ctx, created.ID, item.ID); err != nil {
+		t.Fatalf("unexpected error detaching category: %s", err)
+	}
+	if _, err := client.GetCustomerSegmentItem(ctx, created.ID, "CATEGORY", "category-1"); !IsNotFound(err) {
+		t.Errorf("expected NotFoundError for a detached item, got %v", err)
+	}
+
+	if err := client.DeleteCustomerSegment(ctx, created.ID); err != nil {
+		t.Fatalf("unexpected error deleting customer segment: %s", err)
+	}
+	if _, err := client.GetCustomerSegmentItem(ctx, created.ID, "CATEGORY", "category-1"); !IsNotFound(err) {
+		t.Errorf("expected NotFoundError for an item of a deleted segment, got %v", err)
+	}
+}
+
+func TestFakeAPI_Locations(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newFakeAPIClient(t)
+
+	location := &PhysicalLocation{
+		Name:    "Berlin Warehouse",
+		Type:    "WAREHOUSE",
+		Active:  true,
+		Address: &Address{ZipCode: "10115", City: "Berlin", Country: "DE"},
+		OpeningHours: []OpeningHours{
+			{Weekday: "MONDAY", TimeRanges: []TimeRange{{TimeFrom: "08:00", TimeTo: "12:00"}, {TimeFrom: "13:00", TimeTo: "17:00"}}},
+		},
+	}
+	created, err := client.CreateLocation(ctx, location)
+	if err != nil {
+		t.Fatalf("unexpected error creating location: %s", err)
+	}
+	if len(created.OpeningHours) != 1 || len(created.OpeningHours[0].TimeRanges) != 2 {
+		t.Errorf("expected Monday with two time ranges, got %+v", created.OpeningHours)
+	}
+
+	location.Location = &Location{Latitude: 52.53, Longitude: 13.38}
+	updated, err := client.UpdateLocation(ctx, created.ID, location)
+	if err != nil {
+		t.Fatalf("unexpected error updating location: %s", err)
+	}
+	if updated.Location == nil || updated.Location.Latitude != 52.53 || updated.Metadata == nil || updated.Metadata.Version != 2 {
+		t.Errorf("expected the coordinates at version 2, got %+v", updated)
+	}
+
+	location.Address = nil
+	if _, err := client.UpdateLocation(ctx, created.ID, location); err == nil {
+		t.Error("expected a location without address to be rejected")
+	}
+
+	locations, err := client.ListLocations(ctx, nil)
+	if err != nil {
+		t.Fatalf("unexpected error listing locations: %s", err)
+	}
+	if len(locations) != 1 {
+		t.Errorf("expected 1 location, got %d", len(locations))
+	}
+
+	if err := client.DeleteLocation(ctx, created.ID); err != nil {
+		t.Fatalf("unexpected error deleting location: %s", err)
+	}
+	if _, err := client.GetLocation(ctx, created.ID); !IsNotFound(err) {
+		t.Fatalf("expected NotFoundError after delete, got %v", err)
+	}
+}
+
+func TestFakeAPI_SequentialIDSchemas(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newFakeAPIClient(t)
+
+	first, err := client.CreateSequentialIDSchema(ctx, &SequentialIDSchema{Name: "Orders 2024", SchemaType: "order", PreText: "ORD-", CounterStart: 1000, MaxValue: 99999})
+	if err != nil {
+		t.Fatalf("unexpected error creating schema: %s", err)
+	}
+	second, err := client.CreateSequentialIDSchema(ctx, &SequentialIDSchema{Name: "Orders 2025", SchemaType: "order", PreText: "ORD25-", CounterStart: 1, MaxValue: 99999})
+	if err != nil {
+		t.Fatalf("unexpected error creating schema: %s", err)
+	}
+	if first.Active || second.Active {
+		t.Errorf("expected new schemas to be inactive, got %v and %v", first.Active, second.Active)
+	}
+
+	if _, err := client.CreateSequentialIDSchema(ctx, &SequentialIDSchema{Name: "Broken", SchemaType: "order", CounterStart: 100, MaxValue: 100}); err == nil {
+		t.Error("expected a counter starting at its maximum to be rejected")
+	}
+
+	// Activating a schema deactivates the previous schema of its type
+	for _, id := range []string{first.ID, second.ID} {
+		if err := client.SetActiveSequentialIDSchema(ctx, id); err != nil {
+			t.Fatalf("unexpected error activating schema %s: %s", id, err)
+		}
+	}
+	schemas, err := client.ListSequentialIDSchemas(ctx, nil)
+	if err != nil {
+		t.Fatalf("unexpected error listing schemas: %s", err)
+	}
+	for _, schema := range schemas {
+		if schema.Active != (schema.ID == second.ID) {
+			t.Errorf("expected only %s to be active, got %s active=%v", second.ID, schema.ID, schema.Active)
+		}
+	}
+
+	second.PostText = "-DE"
+	updated, err := client.UpdateSequentialIDSchema(ctx, second.ID, second)
+	if err != nil {
+		t.Fatalf("unexpected error updating schema: %s", err)
+	}
+	if updated.PostText != "-DE" || !updated.Active || updated.Metadata == nil || updated.Metadata.Version != 2 {
+		t.Errorf("expected the suffix at version 2 on the still active schema, got %+v", updated)
+	}
+
+	if err := client.DeleteSequentialIDSchema(ctx, first.ID); err != nil {
+		t.Fatalf("unexpected error deleting schema: %s", err)
+	}
+	if _, err := client.GetSequentialIDSchema(ctx, first.ID); !IsNotFound(err) {
+		t.Fatalf("expected NotFoundError after delete, got %v", err)
+	}
+}
+
+func TestFakeAPI_Coupons(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newFakeAPIClient(t)
+
+	coupon := &Coupon{
+		Code:             "WELCOME10",
+		Name:             "Welcome",
+		DiscountType:     "ABSOLUTE",
+		DiscountAbsolute: &MonetaryAmount{Amount: 10, Currency: "EUR"},
+		MaxRedemptions:   100,
+		SegmentIDs:       []string{"vip"},
+	}
+	created, err := client.CreateCoupon(ctx, coupon)
+	if err != nil {
+		t.Fatalf("unexpected error creating coupon: %s", err)
+	}
+	if created.DiscountAbsolute == nil || created.DiscountAbsolute.Currency != "EUR" || len(created.SegmentIDs) != 1 {
+		t.Errorf("expected the absolute discount and segment, got %+v", created)
+	}
+	if _, err := client.CreateCoupon(ctx, coupon); err == nil {
+		t.Error("expected an error creating a coupon code twice")
+	}
+
+	coupon.DiscountType = "PERCENT"
+	if _, err := client.UpdateCoupon(ctx, coupon.Code, coupon); err == nil {
+		t.Error("expected a PERCENT coupon with an absolute discount to be rejected")
+	}
+
+	coupon.DiscountAbsolute = nil
+	coupon.DiscountPercentage = 15
+	updated, err := client.UpdateCoupon(ctx, coupon.Code, coupon)
+	if err != nil {
+		t.Fatalf("unexpected error updating coupon: %s", err)
+	}
+	if updated.DiscountPercentage != 15 || updated.DiscountAbsolute != nil || updated.Metadata == nil || updated.Metadata.Version != 2 {
+		t.Errorf("expected a 15%% discount at version 2, got %+v", updated)
+	}
+
+	coupons, err := client.ListCoupons(ctx, nil)
+	if err != nil {
+		t.Fatalf("unexpected error listing coupons: %s", err)
+	}
+	if len(coupons) != 1 {
+		t.Errorf("expected 1 coupon, got %d", len(coupons))
+	}
+
+	if err := client.DeleteCoupon(ctx, coupon.Code); err != nil {
+		t.Fatalf("unexpected error deleting coupon: %s", err)
+	}
+	if _, err := client.GetCoupon(ctx, coupon.Code); !IsNotFound(err) {
+		t.Fatalf("expected NotFoundError after delete, got %v", err)
+	}
+}
+
+func TestFakeAPI_RewardPointsConfig(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newFakeAPIClient(t)
+
+	// A fresh tenant has the defaults the resource restores on destroy
+	config, err := client.GetRewardPointsConfig(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error reading reward points config: %s", err)
+	}
+	defaults := defaultRewardPointsConfig()
+	if config.Active != defaults.Active || config.EarnRate != defaults.EarnRate || config.RedemptionRate != defaults.RedemptionRate {
+		t.Errorf("expected the seeded config to match the provider defaults, got %+v", config)
+	}
+
+	updated, err := client.UpdateRewardPointsConfig(ctx, &RewardPointsConfig{Active: true, EarnRate: 2, RedemptionRate: 50, PointsExpiryDays: 365})
+	if err != nil {
+		t.Fatalf("unexpected error updating reward points config: %s", err)
+	}
+	if !updated.Active || updated.EarnRate != 2 || updated.PointsExpiryDays != 365 || updated.Metadata == nil || updated.Metadata.Version != 2 {
+		t.Errorf("expected the update at version 2, got %+v", updated)
+	}
+
+	if _, err := client.UpdateRewardPointsConfig(ctx, &RewardPointsConfig{EarnRate: 1}); err == nil {
+		t.Error("expected a config without redemption rate to be rejected")
+	}
+}
+
+func TestFakeAPI_ShippingZonesAndMethods(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newFakeAPIClient(t)
+
+	for _, id := range []string{"eu", "us"} {
+		zone := &ShippingZone{ID: id, Name: map[string]string{"en": id}, ShipTo: []ShippingDestination{{Country: "DE"}}}
+		if _, err := client.CreateShippingZone(ctx, "main", zone); err != nil {
+			t.Fatalf("unexpected error creating zone %s: %s", id, err)
+		}
+	}
+
+	zones, err := client.ListShippingZones(ctx, "main", &ListOptions{PageSize: 1})
+	if err != nil {
+		t.Fatalf("unexpected error listing zones: %s", err)
+	}
+	if len(zones) != 2 {
+		t.Fatalf("expected 2 zones across pages, got %d", len(zones))
+	}
+
+	method := &ShippingMethod{ID: "standard", Name: map[string]string{"en": "Standard"}, Active: true, Fees: []ShippingFee{}}
+	if _, err := client.CreateShippingMethod(ctx, "main", "eu", method); err != nil {
+		t.Fatalf("unexpected error creating method: %s", err)
+	}
+	if _, err := client.CreateShippingMethod(ctx, "main", "missing", method); err == nil {
+		t.Fatal("expected an error creating a method in a missing zone")
+	}
+
+	if err := client.DeleteShippingZone(ctx, "main", "eu"); err != nil {
+		t.Fatalf("unexpected error deleting zone: %s", err)
+	}
+	if _, err := client.GetShippingMethod(ctx, "main", "eu", "standard"); !IsNotFound(err) {
+		t.Fatalf("expected methods to be removed with their zone, got %v", err)
+	}
+}
+
+func TestFakeAPI_Languages(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newFakeAPIClient(t)
+
+	// A tenant without project_lang has no languages
+	if languages, err := client.ListLanguages(ctx); err != nil || len(languages) != 0 {
+		t.Fatalf("expected no languages, got %v, %v", languages, err)
+	}
+
+	// project_lang as the Management Dashboard writes it: a JSON encoded string
+	if _, err := client.CreateTenantConfiguration(ctx, &TenantConfigurationCreate{
+		Key:   "project_lang",
+		Value: `[{"id":"en","label":"English","default":true,"required":true}]`,
+	}); err != nil {
+		t.Fatalf("unexpected error creating project_lang: %s", err)
+	}
+
+	german := &Language{Code: "de", Label: "Deutsch", Name: map[string]string{"de": "Deutsch", "en": "German"}, Default: true}
+	if _, err := client.CreateLanguage(ctx, german); err != nil {
+		t.Fatalf("unexpected error creating language: %s", err)
+	}
+	if _, err := client.CreateLanguage(ctx, german); err == nil {
+		t.Fatal("expected an error creating a language twice")
+	}
+
+	english, err := client.GetLanguage(ctx, "en")
+	if err != nil {
+		t.Fatalf("unexpected error reading language: %s", err)
+	}
+	if english.Default || !english.Required || english.Label != "English" {
+		t.Errorf("expected en to keep its fields but lose the default, got %+v", english)
+	}
+
+	config, err := client.GetTenantConfiguration(ctx, "project_lang")
+	if err != nil {
+		t.Fatalf("unexpected error reading project_lang: %s", err)
+	}
+	if _, ok := config.Value.(string); !ok {
+		t.Errorf("expected project_lang to stay a JSON encoded string, got %T", config.Value)
+	}
+
+	if _, err := client.UpdateLanguage(ctx, "fr", &Language{Label: "Français"}); !IsNotFound(err) {
+		t.Errorf("expected NotFoundError updating a missing language, got %v", err)
+	}
+
+	if err := client.DeleteLanguage(ctx, "de"); err != nil {
+		t.Fatalf("unexpected error deleting language: %s", err)
+	}
+	if _, err := client.GetLanguage(ctx, "de"); !IsNotFound(err) {
+		t.Fatalf("expected NotFoundError after delete, got %v", err)
+	}
+}
+
+func TestFakeAPI_WebhookSecretsAreNotReturned(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newFakeAPIClient(t)
+
+	created, err := client.CreateWebhook(ctx, &webhookCreateRequest{
+		Code:     "http",
+		Active:   true,
+		Provider: "HTTP",
+		Configuration: &NestedConfigCreate{
+			DestinationUrl: "https://example.com/hook",
+			SecretKey:      "s3cr3t",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating webhook: %s", err)
+	}
+	if created.Configuration == nil || created.Configuration.SecretKey != "" {
+		t.Fatalf("expected the secret to be withheld, got %+v", created.Configuration)
+	}
+	if created.Configuration.SecretKeyExists == nil || !*created.Configuration.SecretKeyExists {
+		t.Errorf("expected secretKeyExists to be reported")
+	}
+
+	updated, err := client.UpdateWebhook(ctx, "http", []WebhookConfigPartialUpdates{
+		{Op: "UPSERT", Path: "/active", Value: false},
+		{Op: "UPSERT", Path: "/configuration/http/destinationUrl", Value: "https://example.com/other"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error updating webhook: %s", err)
+	}
+	if updated.Active || updated.Configuration.DestinationUrl != "https://example.com/other" || updated.Version != 2 {
+		t.Errorf("expected patch to be applied with version 2, got %+v", updated)
+	}
+}
+
+func TestFakeAPI_RefreshesRevokedToken(t *testing.T) {
+	ctx := context.Background()
+	client, server := newFakeAPIClient(t)
+
+	if _, err := client.GetCountry(ctx, "DE"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	server.RevokeTokens()
+
+	country, err := client.GetCountry(ctx, "DE")
+	if err != nil {
+		t.Fatalf("expected the client to fetch a new token after a 401, got %s", err)
+	}
+	if country.Code != "DE" {
+		t.Errorf("expected country DE, got %q", country.Code)
+	}
+}
+
+func TestFakeAPI_RejectsOtherTenants(t *testing.T) {
+	client, server := newFakeAPIClient(t)
+	client.Tenant = "othertenant"
+
+	_, err := client.GetCountry(context.Background(), "DE")
+	if apiErr, ok := AsAPIError(err); !ok || apiErr.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected a 403 APIError for tenant %q (fake serves %q), got %v", client.Tenant, server.Tenant, err)
+	}
+}
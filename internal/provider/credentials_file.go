@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+// credentialsProfile holds the settings of one named profile of the credentials file.
+// Empty fields are not set in the profile.
+type credentialsProfile struct {
+	Tenant       string `yaml:"tenant"`
+	ClientId     string `yaml:"client_id"`
+	ClientSecret string `yaml:"client_secret"`
+	Scope        string `yaml:"scope"`
+	ApiUrl       string `yaml:"api_url"`
+	// CredentialProcess is the command line of the credential process, see splitCommandLine
+	CredentialProcess string `yaml:"credential_process"`
+}
+
+// credentialSource is where a setting came from. Settings from more specific sources
+// (higher values) take precedence: the provider configuration, then environment variables,
+// then the credentials profile.
+type credentialSource int
+
+const (
+	credentialSourceNone credentialSource = iota
+	credentialSourceProfile
+	credentialSourceEnv
+	credentialSourceConfig
+)
+
+func (s credentialSource) String() string {
+	switch s {
+	case credentialSourceProfile:
+		return "credentials profile"
+	case credentialSourceEnv:
+		return "environment variables"
+	case credentialSourceConfig:
+		return "provider configuration"
+	}
+	return "none"
+}
+
+// credentialsFilePath returns the credentials file named by EMPORIX_CREDENTIALS_FILE,
+// or ~/.emporix/credentials
+func credentialsFilePath() (string, error) {
+	if filename := os.Getenv("EMPORIX_CREDENTIALS_FILE"); filename != "" {
+		return filename, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("unable to find the home directory for the credentials file: %w", err)
+	}
+	return filepath.Join(home, ".emporix", "credentials"), nil
+}
+
+// loadCredentialsProfile reads a named profile from a credentials file. Files ending in .yaml or .yml
+// are read as YAML, see decodeYAMLCredentials. Other files, like the default ~/.emporix/credentials,
+// are read as INI if they start with a [profile] header, see loadINICredentialsProfile, and as YAML otherwise.
+func loadCredentialsProfile(filename, name string) (*credentialsProfile, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read credentials file: %w", err)
+	}
+
+	var profiles map[string]*credentialsProfile
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".yaml", ".yml":
+		if profiles, err = decodeYAMLCredentials(data); err != nil {
+			return nil, fmt.Errorf("%s: %w", filename, err)
+		}
+	default:
+		if isINICredentialsFile(data) {
+			return loadINICredentialsProfile(filename, data, name)
+		}
+		if profiles, err = decodeYAMLCredentials(data); err != nil {
+			return nil, fmt.Errorf("%s doesn't start with an INI [profile] header and isn't valid YAML: %w", filename, err)
+		}
+	}
+
+	profile, ok := profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("profile %q not found in %s", name, filename)
+	}
+	if profile == nil {
+		// A profile without keys
+		profile = &credentialsProfile{}
+	}
+	return profile, nil
+}
+
+// isINICredentialsFile reports whether the first line that isn't empty or a comment is an INI [profile] header
+func isINICredentialsFile(data []byte) bool {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		return strings.HasPrefix(line, "[")
+	}
+	return false
+}
+
+// loadINICredentialsProfile reads a named profile from an INI-style credentials file:
+//
+//	[dev]
+//	tenant        = mytenant-dev
+//	client_id     = abc123
+//	client_secret = xyz789
+//	scope         = tenant=mytenant-dev site.site_read
+//	api_url       = https://api.emporix.io
+//
+//	[ci]
+//	tenant             = mytenant
+//	credential_process = vault-emporix-token --role ci
+//
+// Lines starting with # or ; are comments.
+func loadINICredentialsProfile(filename string, data []byte, name string) (*credentialsProfile, error) {
+	var profile *credentialsProfile
+	section := ""
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("%s:%d: invalid profile header %q", filename, lineNumber, line)
+			}
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			if section == name && profile == nil {
+				profile = &credentialsProfile{}
+			}
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("%s:%d: expected key = value", filename, lineNumber)
+		}
+		if section == "" {
+			return nil, fmt.Errorf("%s:%d: %s is outside of a profile", filename, lineNumber, strings.TrimSpace(key))
+		}
+		if section != name {
+			continue
+		}
+
+		value = strings.TrimSpace(value)
+		switch key = strings.TrimSpace(key); key {
+		case "tenant":
+			profile.Tenant = value
+		case "client_id":
+			profile.ClientId = value
+		case "client_secret":
+			profile.ClientSecret = value
+		case "scope":
+			profile.Scope = value
+		case "api_url":
+			profile.ApiUrl = value
+		case "credential_process":
+			profile.CredentialProcess = value
+		default:
+			return nil, fmt.Errorf("%s:%d: unknown key %q in profile %q", filename, lineNumber, key, name)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read credentials file: %w", err)
+	}
+
+	if profile == nil {
+		return nil, fmt.Errorf("profile %q not found in %s", name, filename)
+	}
+	return profile, nil
+}
+
+// decodeYAMLCredentials decodes the profiles of a YAML credentials file, with the keys of the INI format:
+//
+//	dev:
+//	  tenant: mytenant-dev
+//	  client_id: abc123
+//	  client_secret: xyz789
+//
+//	ci:
+//	  tenant: mytenant
+//	  credential_process: vault-emporix-token --role ci
+//
+// Unknown keys are rejected.
+func decodeYAMLCredentials(data []byte) (map[string]*credentialsProfile, error) {
+	var profiles map[string]*credentialsProfile
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&profiles); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+// stringOrProfileValue returns value unless it is null or empty, in which case the profile's value is used
+func stringOrProfileValue(value types.String, profileValue string) types.String {
+	if value.IsNull() || value.ValueString() == "" {
+		return types.StringValue(profileValue)
+	}
+	return value
+}
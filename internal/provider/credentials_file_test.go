@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"terraform-provider-emporix/internal/emporixfake"
+)
+
+func writeCredentialsFile(t *testing.T, content string) string {
+	t.Helper()
+
+	filename := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(filename, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("EMPORIX_CREDENTIALS_FILE", filename)
+	return filename
+}
+
+func TestLoadCredentialsProfile(t *testing.T) {
+	filename := writeCredentialsFile(t, `
+# Emporix tenants
+[dev]
+tenant        = mytenant-dev
+client_id     = dev-id
+client_secret = dev-secret
+
+[prod]
+tenant  = mytenant
+; the prod key is restricted
+scope   = tenant=mytenant site.site_read
+api_url = https://api.example.com
+`)
+
+	profile, err := loadCredentialsProfile(filename, "prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := credentialsProfile{Tenant: "mytenant", Scope: "tenant=mytenant site.site_read", ApiUrl: "https://api.example.com"}
+	if *profile != expected {
+		t.Errorf("expected %+v, got %+v", expected, *profile)
+	}
+
+	if _, err := loadCredentialsProfile(filename, "stage"); err == nil || !strings.Contains(err.Error(), `profile "stage" not found`) {
+		t.Errorf("expected a missing profile error, got %v", err)
+	}
+}
+
+func TestLoadCredentialsProfile_YAML(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "credentials.yaml")
+	content := `
+# Emporix tenants
+dev:
+  tenant: mytenant-dev
+  client_id: dev-id
+  client_secret: dev-secret
+
+ci:
+  tenant: mytenant
+  credential_process: "'/opt/emporix tools/token' --role ci"
+`
+	if err := os.WriteFile(filename, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	profile, err := loadCredentialsProfile(filename, "ci")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := credentialsProfile{Tenant: "mytenant", CredentialProcess: "'/opt/emporix tools/token' --role ci"}
+	if *profile != expected {
+		t.Errorf("expected %+v, got %+v", expected, *profile)
+	}
+
+	if _, err := loadCredentialsProfile(filename, "stage"); err == nil || !strings.Contains(err.Error(), `profile "stage" not found`) {
+		t.Errorf("expected a missing profile error, got %v", err)
+	}
+
+	for name, content := range map[string]string{
+		"unknown key": "dev:\n  client_key: abc\n",
+		"not a map":   "- dev\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			if err := os.WriteFile(filename, []byte(content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := loadCredentialsProfile(filename, "dev"); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+// The default ~/.emporix/credentials has no extension, so the format is detected from the content
+func TestLoadCredentialsProfile_YAMLWithoutExtension(t *testing.T) {
+	filename := writeCredentialsFile(t, `
+# Emporix tenants
+dev:
+  tenant: mytenant-dev
+  client_id: dev-id
+`)
+
+	profile, err := loadCredentialsProfile(filename, "dev")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := credentialsProfile{Tenant: "mytenant-dev", ClientId: "dev-id"}
+	if *profile != expected {
+		t.Errorf("expected %+v, got %+v", expected, *profile)
+	}
+
+	// Neither format: the error says why the file wasn't read as INI
+	filename = writeCredentialsFile(t, "tenant = mytenant\n[dev]\n")
+	if _, err := loadCredentialsProfile(filename, "dev"); err == nil || !strings.Contains(err.Error(), "INI [profile] header") {
+		t.Errorf("expected an error mentioning the INI header, got %v", err)
+	}
+}
+
+func TestLoadCredentialsProfile_Invalid(t *testing.T) {
+	for name, content := range map[string]string{
+		"unknown key":        "[dev]\nclient_key = abc\n",
+		"missing value":      "[dev]\ntenant\n",
+		"key before profile": "tenant = mytenant\n[dev]\n",
+		"unclosed header":    "[dev\ntenant = mytenant\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			filename := writeCredentialsFile(t, content)
+			if _, err := loadCredentialsProfile(filename, "dev"); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+// configureProvider runs EmporixProvider.Configure with the given provider block attributes
+func configureProvider(t *testing.T, attributes map[string]tftypes.Value) provider.ConfigureResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	p := New("test")()
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range attributes {
+		values[name] = value
+	}
+
+	var resp provider.ConfigureResponse
+	p.Configure(ctx, provider.ConfigureRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}, &resp)
+	return resp
+}
+
+// clearProviderEnv unsets the provider environment variables for the test
+func clearProviderEnv(t *testing.T) {
+	for _, name := range []string{"EMPORIX_TENANT", "EMPORIX_ACCESS_TOKEN", "EMPORIX_CLIENT_ID", "EMPORIX_CLIENT_SECRET", "EMPORIX_SCOPE", "EMPORIX_API_URL", "EMPORIX_PROFILE", "EMPORIX_CREDENTIAL_PROCESS"} {
+		t.Setenv(name, "")
+	}
+}
+
+func TestProviderConfigure_Profile(t *testing.T) {
+	clearProviderEnv(t)
+	server := emporixfake.New()
+	t.Cleanup(server.Close)
+
+	writeCredentialsFile(t, `
+[dev]
+tenant        = `+server.Tenant+`
+client_id     = `+server.ClientID+`
+client_secret = `+server.ClientSecret+`
+api_url       = `+server.URL+`
+`)
+	t.Setenv("EMPORIX_PROFILE", "dev")
+
+	resp := configureProvider(t, nil)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("configure returned errors: %v", resp.Diagnostics)
+	}
+	client := resp.ResourceData.(*EmporixClient)
+	if client.Tenant != server.Tenant || client.ApiUrl != server.URL {
+		t.Errorf("expected tenant %s at %s, got %s at %s", server.Tenant, server.URL, client.Tenant, client.ApiUrl)
+	}
+}
+
+func TestProviderConfigure_ProfilePrecedence(t *testing.T) {
+	clearProviderEnv(t)
+	server := emporixfake.New()
+	t.Cleanup(server.Close)
+
+	// Only api_url comes from the profile; its tenant and credentials are overridden
+	writeCredentialsFile(t, `
+[dev]
+tenant        = profile-tenant
+client_id     = profile-id
+client_secret = profile-secret
+api_url       = `+server.URL+`
+`)
+	t.Setenv("EMPORIX_CLIENT_ID", server.ClientID)
+	t.Setenv("EMPORIX_CLIENT_SECRET", server.ClientSecret)
+	t.Setenv("EMPORIX_TENANT", "env-tenant")
+
+	resp := configureProvider(t, map[string]tftypes.Value{
+		"profile": tftypes.NewValue(tftypes.String, "dev"),
+		"tenant":  tftypes.NewValue(tftypes.String, server.Tenant),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("configure returned errors: %v", resp.Diagnostics)
+	}
+	if tenant := resp.ResourceData.(*EmporixClient).Tenant; tenant != server.Tenant {
+		t.Errorf("expected the configured tenant %s, got %s", server.Tenant, tenant)
+	}
+}
+
+func TestProviderConfigure_ProfileErrors(t *testing.T) {
+	clearProviderEnv(t)
+	writeCredentialsFile(t, "[dev]\nclient_id = dev-id\n")
+
+	resp := configureProvider(t, map[string]tftypes.Value{
+		"profile": tftypes.NewValue(tftypes.String, "stage"),
+	})
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Invalid Credentials Profile" {
+		t.Errorf("expected an invalid profile error, got %v", resp.Diagnostics)
+	}
+
+	resp = configureProvider(t, map[string]tftypes.Value{
+		"profile": tftypes.NewValue(tftypes.String, "dev"),
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected a missing tenant error")
+	}
+	if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, `Profile "dev"`) {
+		t.Errorf("expected the error to name the profile, got: %s", detail)
+	}
+}
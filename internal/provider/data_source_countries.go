@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CountriesDataSource{}
+var _ datasource.DataSourceWithConfigure = &CountriesDataSource{}
+
+func NewCountriesDataSource() datasource.DataSource {
+	return &CountriesDataSource{}
+}
+
+// CountriesDataSource lists countries, optionally filtered by region and active flag.
+type CountriesDataSource struct {
+	client *EmporixClient
+}
+
+// CountriesDataSourceModel describes the data source data model.
+type CountriesDataSourceModel struct {
+	Region    types.String           `tfsdk:"region"`
+	Active    types.Bool             `tfsdk:"active"`
+	Codes     types.List             `tfsdk:"codes"`
+	Countries []CountryResourceModel `tfsdk:"countries"`
+}
+
+func (d *CountriesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_countries"
+}
+
+func (d *CountriesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the countries of the tenant, optionally filtered by region and active status.",
+
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Only return countries belonging to this region (e.g., `EU`).",
+				Optional:            true,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Only return countries with this active status.",
+				Optional:            true,
+			},
+			"codes": schema.ListAttribute{
+				MarkdownDescription: "Codes of the matching countries.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"countries": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching countries.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"code": schema.StringAttribute{
+							MarkdownDescription: "Country code (ISO 3166-1 alpha-2, 2-letter code).",
+							Computed:            true,
+						},
+						"name": schema.MapAttribute{
+							MarkdownDescription: "Localized country names.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"regions": schema.ListAttribute{
+							MarkdownDescription: "Regions the country belongs to.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"active": schema.BoolAttribute{
+							MarkdownDescription: "Whether the country is active for the tenant.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CountriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CountriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CountriesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var activeCondition, regionCondition string
+	if !data.Active.IsNull() {
+		activeCondition = fmt.Sprintf("active:%t", data.Active.ValueBool())
+	}
+	if !data.Region.IsNull() && data.Region.ValueString() != "" {
+		regionCondition = fmt.Sprintf("regions:%s", data.Region.ValueString())
+	}
+	opts := &ListOptions{Query: buildListQuery(activeCondition, regionCondition)}
+
+	tflog.Debug(ctx, "Listing countries", map[string]interface{}{
+		"q": opts.Query,
+	})
+
+	countries, err := d.client.ListCountries(ctx, opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list countries, got error: %s", err))
+		return
+	}
+
+	codes := make([]string, 0, len(countries))
+	data.Countries = make([]CountryResourceModel, 0, len(countries))
+	for i := range countries {
+		// name is only set by the mapping when the API returns it
+		country := CountryResourceModel{Name: types.MapNull(types.StringType)}
+		mapCountryToModel(ctx, &countries[i], &country, &resp.Diagnostics)
+		data.Countries = append(data.Countries, country)
+		codes = append(codes, countries[i].Code)
+	}
+
+	codeList, diags := types.ListValueFrom(ctx, types.StringType, codes)
+	resp.Diagnostics.Append(diags...)
+	data.Codes = codeList
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
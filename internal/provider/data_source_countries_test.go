@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCountriesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCountryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCountryResourceConfig("BE", true) + `
+data "emporix_countries" "eu" {
+  region = "EU"
+}
+
+data "emporix_countries" "active" {
+  active = true
+
+  depends_on = [emporix_country.test]
+}
+
+data "emporix_countries" "none" {
+  region = "TF_MISSING_REGION"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.emporix_countries.eu", "codes.*", "DE"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.emporix_countries.eu", "countries.*", map[string]string{
+						"code":    "DE",
+						"name.en": "Germany",
+					}),
+					resource.TestCheckTypeSetElemAttr("data.emporix_countries.active", "codes.*", "BE"),
+					resource.TestCheckResourceAttr("data.emporix_countries.none", "codes.#", "0"),
+					resource.TestCheckResourceAttr("data.emporix_countries.none", "countries.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CountryDataSource{}
+var _ datasource.DataSourceWithConfigure = &CountryDataSource{}
+
+func NewCountryDataSource() datasource.DataSource {
+	return &CountryDataSource{}
+}
+
+// CountryDataSource defines the data source implementation.
+// It shares CountryResourceModel with the resource so both produce the same shape.
+type CountryDataSource struct {
+	client *EmporixClient
+}
+
+func (d *CountryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_country"
+}
+
+func (d *CountryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a country from Emporix by its code.",
+
+		Attributes: map[string]schema.Attribute{
+			"code": schema.StringAttribute{
+				MarkdownDescription: "Country code (ISO 3166-1 alpha-2, 2-letter code).",
+				Required:            true,
+			},
+			"name": schema.MapAttribute{
+				MarkdownDescription: "Localized country names.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"regions": schema.ListAttribute{
+				MarkdownDescription: "Regions the country belongs to.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the country is active for the tenant.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CountryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CountryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CountryResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading country data source", map[string]interface{}{
+		"code": data.Code.ValueString(),
+	})
+
+	country, err := d.client.GetCountry(ctx, data.Code.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			resp.Diagnostics.AddError("Country Not Found", fmt.Sprintf("No country with code %q exists in Emporix.", data.Code.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read country, got error: %s", err))
+		return
+	}
+
+	mapCountryToModel(ctx, country, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
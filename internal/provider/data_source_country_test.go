@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCountryDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCountryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCountryResourceConfig("AT", true) + `
+data "emporix_country" "test" {
+  code = emporix_country.test.code
+
+  depends_on = [emporix_country.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.emporix_country.test", "code", "AT"),
+					resource.TestCheckResourceAttr("data.emporix_country.test", "name.en", "Austria"),
+					resource.TestCheckTypeSetElemAttr("data.emporix_country.test", "regions.*", "EUROPE"),
+					resource.TestCheckResourceAttr("data.emporix_country.test", "active", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCountryDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "emporix_country" "test" {
+  code = "XX"
+}
+`,
+				ExpectError: regexp.MustCompile(`Country Not Found`),
+			},
+		},
+	})
+}
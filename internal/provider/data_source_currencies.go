@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CurrenciesDataSource{}
+var _ datasource.DataSourceWithConfigure = &CurrenciesDataSource{}
+
+func NewCurrenciesDataSource() datasource.DataSource {
+	return &CurrenciesDataSource{}
+}
+
+// CurrenciesDataSource lists the currencies of the tenant.
+type CurrenciesDataSource struct {
+	client *EmporixClient
+}
+
+// CurrenciesDataSourceModel describes the data source data model.
+type CurrenciesDataSourceModel struct {
+	Query      types.String            `tfsdk:"q"`
+	Codes      types.List              `tfsdk:"codes"`
+	Currencies []CurrencyResourceModel `tfsdk:"currencies"`
+}
+
+func (d *CurrenciesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_currencies"
+}
+
+func (d *CurrenciesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the currencies of the tenant.",
+
+		Attributes: map[string]schema.Attribute{
+			"q": schema.StringAttribute{
+				MarkdownDescription: "Optional Emporix query expression to filter currencies (e.g., `code:EUR`).",
+				Optional:            true,
+			},
+			"codes": schema.ListAttribute{
+				MarkdownDescription: "Codes of the matching currencies.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"currencies": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching currencies.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"code": schema.StringAttribute{
+							MarkdownDescription: "Currency code (3-letter uppercase ISO-4217 code, e.g., USD, EUR, GBP).",
+							Computed:            true,
+						},
+						"name": schema.MapAttribute{
+							MarkdownDescription: "Currency name as a map of language code to name.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CurrenciesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CurrenciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CurrenciesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := &ListOptions{Query: data.Query.ValueString()}
+
+	tflog.Debug(ctx, "Listing currencies", map[string]interface{}{
+		"q": opts.Query,
+	})
+
+	currencies, err := d.client.ListCurrencies(ctx, opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list currencies, got error: %s", err))
+		return
+	}
+
+	codes := make([]string, 0, len(currencies))
+	data.Currencies = make([]CurrencyResourceModel, 0, len(currencies))
+	for i := range currencies {
+		var currency CurrencyResourceModel
+		mapCurrencyToModel(ctx, &currencies[i], &currency, &resp.Diagnostics)
+		data.Currencies = append(data.Currencies, currency)
+		codes = append(codes, currencies[i].Code)
+	}
+
+	codeList, diags := types.ListValueFrom(ctx, types.StringType, codes)
+	resp.Diagnostics.Append(diags...)
+	data.Codes = codeList
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
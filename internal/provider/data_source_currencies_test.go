@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCurrenciesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCurrencyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCurrencyResourceConfig("ISK") + `
+data "emporix_currencies" "test" {
+  q = "code:${emporix_currency.test.code}"
+
+  depends_on = [emporix_currency.test]
+}
+
+# No currency has the ISO 4217 testing code
+data "emporix_currencies" "none" {
+  q = "code:XTS"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.emporix_currencies.test", "codes.#", "1"),
+					resource.TestCheckResourceAttr("data.emporix_currencies.test", "codes.0", "ISK"),
+					resource.TestCheckResourceAttr("data.emporix_currencies.test", "currencies.0.code", "ISK"),
+					resource.TestCheckResourceAttr("data.emporix_currencies.test", "currencies.0.name.en", "ISK"),
+					resource.TestCheckResourceAttr("data.emporix_currencies.none", "codes.#", "0"),
+					resource.TestCheckResourceAttr("data.emporix_currencies.none", "currencies.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CurrencyDataSource{}
+var _ datasource.DataSourceWithConfigure = &CurrencyDataSource{}
+
+func NewCurrencyDataSource() datasource.DataSource {
+	return &CurrencyDataSource{}
+}
+
+// CurrencyDataSource defines the data source implementation.
+type CurrencyDataSource struct {
+	client *EmporixClient
+}
+
+func (d *CurrencyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_currency"
+}
+
+func (d *CurrencyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a currency from Emporix by its ISO-4217 code.",
+
+		Attributes: map[string]schema.Attribute{
+			"code": schema.StringAttribute{
+				MarkdownDescription: "Currency code (3-letter uppercase ISO-4217 code, e.g., USD, EUR, GBP).",
+				Required:            true,
+			},
+			"name": schema.MapAttribute{
+				MarkdownDescription: "Currency name as a map of language code to name.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CurrencyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CurrencyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CurrencyResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading currency data source", map[string]interface{}{
+		"code": data.Code.ValueString(),
+	})
+
+	currency, err := d.client.GetCurrency(ctx, data.Code.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			resp.Diagnostics.AddError("Currency Not Found", fmt.Sprintf("No currency with code %q exists in Emporix.", data.Code.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read currency, got error: %s", err))
+		return
+	}
+
+	mapCurrencyToModel(ctx, currency, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
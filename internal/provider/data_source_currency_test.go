@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCurrencyDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCurrencyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCurrencyResourceConfig("DKK") + `
+data "emporix_currency" "test" {
+  code = emporix_currency.test.code
+
+  depends_on = [emporix_currency.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.emporix_currency.test", "code", "DKK"),
+					resource.TestCheckResourceAttr("data.emporix_currency.test", "name.en", "DKK"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCurrencyDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "emporix_currency" "test" {
+  code = "XTS"
+}
+`,
+				ExpectError: regexp.MustCompile(`Currency Not Found`),
+			},
+		},
+	})
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CustomEntityTypeDataSource{}
+var _ datasource.DataSourceWithConfigure = &CustomEntityTypeDataSource{}
+
+func NewCustomEntityTypeDataSource() datasource.DataSource {
+	return &CustomEntityTypeDataSource{}
+}
+
+// CustomEntityTypeDataSource defines the data source implementation.
+type CustomEntityTypeDataSource struct {
+	client *EmporixClient
+}
+
+func (d *CustomEntityTypeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_entity_type"
+}
+
+func (d *CustomEntityTypeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a custom schema type from Emporix by its code.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique code of the custom type (e.g. \"DOCUMENT\").",
+				Required:            true,
+			},
+			"name": schema.MapAttribute{
+				MarkdownDescription: "Localized custom type name as a map of language code to name.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the custom type was created.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CustomEntityTypeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CustomEntityTypeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CustomEntityTypeResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading custom entity type data source", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	entityType, err := d.client.GetCustomEntityType(ctx, data.ID.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			resp.Diagnostics.AddError("Custom Entity Type Not Found", fmt.Sprintf("No custom entity type with ID %q exists in Emporix.", data.ID.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom entity type, got error: %s", err))
+		return
+	}
+
+	mapCustomEntityTypeToModel(ctx, entityType, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
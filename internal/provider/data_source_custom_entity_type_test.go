@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCustomEntityTypeDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCustomEntityTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomEntityTypeResourceConfig("TEST_DOCUMENT_DATA_SOURCE") + `
+data "emporix_custom_entity_type" "test" {
+  id = emporix_custom_entity_type.test.id
+
+  depends_on = [emporix_custom_entity_type.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.emporix_custom_entity_type.test", "id", "TEST_DOCUMENT_DATA_SOURCE"),
+					resource.TestCheckResourceAttr("data.emporix_custom_entity_type.test", "name.en", "Document Type"),
+					resource.TestCheckResourceAttrPair("data.emporix_custom_entity_type.test", "created_at", "emporix_custom_entity_type.test", "created_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCustomEntityTypeDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "emporix_custom_entity_type" "test" {
+  id = "TF_MISSING_TYPE"
+}
+`,
+				ExpectError: regexp.MustCompile(`Custom Entity Type Not Found`),
+			},
+		},
+	})
+}
@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &DeliveryTimeDataSource{}
+	_ datasource.DataSourceWithConfigure = &DeliveryTimeDataSource{}
+)
+
+func NewDeliveryTimeDataSource() datasource.DataSource {
+	return &DeliveryTimeDataSource{}
+}
+
+type DeliveryTimeDataSource struct {
+	client *EmporixClient
+}
+
+func (d *DeliveryTimeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_delivery_time"
+}
+
+func (d *DeliveryTimeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a delivery time slot configuration by its ID.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the delivery time.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Unique name of the delivery time configuration.",
+				Computed:            true,
+			},
+			"site_code": schema.StringAttribute{
+				MarkdownDescription: "Site code.",
+				Computed:            true,
+			},
+			"is_delivery_day": schema.BoolAttribute{
+				MarkdownDescription: "Whether this is an active delivery day.",
+				Computed:            true,
+			},
+			"zone_id": schema.StringAttribute{
+				MarkdownDescription: "Shipping zone ID this delivery time applies to.",
+				Computed:            true,
+			},
+			"is_for_all_zones": schema.BoolAttribute{
+				MarkdownDescription: "Whether this delivery time applies to all zones.",
+				Computed:            true,
+			},
+			"time_zone_id": schema.StringAttribute{
+				MarkdownDescription: "Timezone identifier (e.g., 'Europe/Warsaw').",
+				Computed:            true,
+			},
+			"delivery_day_shift": schema.Int64Attribute{
+				MarkdownDescription: "Number of days delivery is shifted from the order date.",
+				Computed:            true,
+			},
+			"day": schema.SingleNestedAttribute{
+				MarkdownDescription: "Day configuration: weekday (recurring), specific date, or date range.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"weekday": schema.StringAttribute{
+						MarkdownDescription: "Day of the week for recurring delivery.",
+						Computed:            true,
+					},
+					"date": schema.StringAttribute{
+						MarkdownDescription: "Specific date for one-time delivery in ISO 8601 format.",
+						Computed:            true,
+					},
+					"date_from": schema.StringAttribute{
+						MarkdownDescription: "Start date of the delivery date range in ISO 8601 format.",
+						Computed:            true,
+					},
+					"date_to": schema.StringAttribute{
+						MarkdownDescription: "End date of the delivery date range in ISO 8601 format.",
+						Computed:            true,
+					},
+				},
+			},
+			"slots": schema.ListNestedAttribute{
+				MarkdownDescription: "Delivery time slots with shipping methods and capacity.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"shipping_method": schema.StringAttribute{
+							MarkdownDescription: "Shipping method identifier.",
+							Computed:            true,
+						},
+						"capacity": schema.Int64Attribute{
+							MarkdownDescription: "Maximum number of deliveries for this slot.",
+							Computed:            true,
+						},
+						"delivery_time_range": schema.SingleNestedAttribute{
+							MarkdownDescription: "Time range for delivery.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"time_from": schema.StringAttribute{
+									MarkdownDescription: "Start time in HH:MM format.",
+									Computed:            true,
+								},
+								"time_to": schema.StringAttribute{
+									MarkdownDescription: "End time in HH:MM format.",
+									Computed:            true,
+								},
+							},
+						},
+						"cut_off_time": schema.SingleNestedAttribute{
+							MarkdownDescription: "Order cutoff time for this slot.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"time": schema.StringAttribute{
+									MarkdownDescription: "Cutoff timestamp in ISO 8601 format.",
+									Computed:            true,
+								},
+								"delivery_cycle_name": schema.StringAttribute{
+									MarkdownDescription: "Delivery cycle identifier.",
+									Computed:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DeliveryTimeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DeliveryTimeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DeliveryTimeResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading delivery time data source", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	deliveryTime, err := d.client.GetDeliveryTime(ctx, data.ID.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			resp.Diagnostics.AddError("Delivery Time Not Found", fmt.Sprintf("No delivery time with ID %q exists in Emporix.", data.ID.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read delivery time, got error: %s", err))
+		return
+	}
+
+	mapDeliveryTimeToModel(ctx, deliveryTime, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
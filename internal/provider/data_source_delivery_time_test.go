@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeliveryTimeDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDeliveryTimeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeliveryTimeResourceConfig_basic() + `
+data "emporix_delivery_time" "test" {
+  id = emporix_delivery_time.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.emporix_delivery_time.test", "id", "emporix_delivery_time.test", "id"),
+					resource.TestCheckResourceAttr("data.emporix_delivery_time.test", "name", "friday-slots"),
+					resource.TestCheckResourceAttr("data.emporix_delivery_time.test", "site_code", "main"),
+					resource.TestCheckResourceAttr("data.emporix_delivery_time.test", "zone_id", "zone-test"),
+					resource.TestCheckResourceAttr("data.emporix_delivery_time.test", "time_zone_id", "Europe/Warsaw"),
+					resource.TestCheckResourceAttr("data.emporix_delivery_time.test", "day.weekday", "FRIDAY"),
+					resource.TestCheckResourceAttr("data.emporix_delivery_time.test", "slots.#", "1"),
+					resource.TestCheckResourceAttr("data.emporix_delivery_time.test", "slots.0.capacity", "100"),
+					resource.TestCheckResourceAttr("data.emporix_delivery_time.test", "slots.0.delivery_time_range.time_from", "10:00"),
+					resource.TestCheckResourceAttr("data.emporix_delivery_time.test", "slots.0.cut_off_time.delivery_cycle_name", "morning"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDeliveryTimeDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "emporix_delivery_time" "test" {
+  id = "tf-missing-delivery-time"
+}
+`,
+				ExpectError: regexp.MustCompile(`Delivery Time Not Found`),
+			},
+		},
+	})
+}
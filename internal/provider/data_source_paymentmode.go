@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PaymentModeDataSource{}
+var _ datasource.DataSourceWithConfigure = &PaymentModeDataSource{}
+
+func NewPaymentModeDataSource() datasource.DataSource {
+	return &PaymentModeDataSource{}
+}
+
+// PaymentModeDataSource defines the data source implementation.
+type PaymentModeDataSource struct {
+	client *EmporixClient
+}
+
+func (d *PaymentModeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_payment_mode"
+}
+
+func (d *PaymentModeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a payment mode from Emporix by its ID.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the payment mode (UUID)",
+				Required:            true,
+			},
+			"code": schema.StringAttribute{
+				MarkdownDescription: "Code of the payment mode",
+				Computed:            true,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Indicates whether the payment mode is active",
+				Computed:            true,
+			},
+			"payment_provider": schema.StringAttribute{
+				MarkdownDescription: "Payment provider type (INVOICE, CASH_ON_DELIVERY, SPREEDLY, SPREEDLY_SAFERPAY, UNZER)",
+				Computed:            true,
+			},
+			"configuration": schema.MapAttribute{
+				MarkdownDescription: "Map of configuration values for the payment gateway",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *PaymentModeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PaymentModeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PaymentModeResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading payment mode data source", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	paymentMode, err := d.client.GetPaymentMode(ctx, data.ID.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			resp.Diagnostics.AddError("Payment Mode Not Found", fmt.Sprintf("No payment mode with ID %q exists in Emporix.", data.ID.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read payment mode, got error: %s", err))
+		return
+	}
+
+	mapPaymentModeToModel(ctx, paymentMode, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
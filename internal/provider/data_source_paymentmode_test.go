@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPaymentModeDataSource_basic(t *testing.T) {
+	code := fmt.Sprintf("test-ds-pm-%d", testAccTimestamp(t))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckPaymentModeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPaymentModeResourceConfig(code, true, "INVOICE") + `
+data "emporix_paymentmode" "test" {
+  id = emporix_paymentmode.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.emporix_paymentmode.test", "id", "emporix_paymentmode.test", "id"),
+					resource.TestCheckResourceAttr("data.emporix_paymentmode.test", "code", code),
+					resource.TestCheckResourceAttr("data.emporix_paymentmode.test", "active", "true"),
+					resource.TestCheckResourceAttr("data.emporix_paymentmode.test", "payment_provider", "INVOICE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPaymentModeDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "emporix_paymentmode" "test" {
+  id = "00000000-0000-0000-0000-000000000000"
+}
+`,
+				ExpectError: regexp.MustCompile(`Payment Mode Not Found`),
+			},
+		},
+	})
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SchemaDataSource{}
+var _ datasource.DataSourceWithConfigure = &SchemaDataSource{}
+
+func NewSchemaDataSource() datasource.DataSource {
+	return &SchemaDataSource{}
+}
+
+// SchemaDataSource defines the data source implementation.
+type SchemaDataSource struct {
+	client *EmporixClient
+}
+
+func (d *SchemaDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema"
+}
+
+func (d *SchemaDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a schema from Emporix by its ID. Useful to look up the `schema_url` of a schema managed elsewhere, e.g. for site mixins.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Schema identifier.",
+				Required:            true,
+			},
+			"name": schema.MapAttribute{
+				MarkdownDescription: "Schema name as a map of language code to name.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"types": schema.ListAttribute{
+				MarkdownDescription: "List of schema types (e.g., PRODUCT, SITE, CUSTOM_ENTITY).",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"schema_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the schema, as returned by the API in the metadata.url field.",
+				Computed:            true,
+			},
+			"attributes": schema.DynamicAttribute{
+				MarkdownDescription: "List of schema attributes, in the same shape as the `attributes` argument of the `emporix_schema` resource.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *SchemaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SchemaResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading schema data source", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	schema, err := d.client.GetSchema(ctx, data.ID.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			resp.Diagnostics.AddError("Schema Not Found", fmt.Sprintf("No schema with ID %q exists in Emporix.", data.ID.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schema, got error: %s", err))
+		return
+	}
+
+	mapSchemaToModel(ctx, schema, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSchemaDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaResourceConfig("test-schema-data-source-1") + `
+data "emporix_schema" "test" {
+  id = emporix_schema.test.id
+
+  depends_on = [emporix_schema.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.emporix_schema.test", "id", "test-schema-data-source-1"),
+					resource.TestCheckResourceAttr("data.emporix_schema.test", "name.en", "Product Schema"),
+					resource.TestCheckResourceAttr("data.emporix_schema.test", "types.#", "1"),
+					resource.TestCheckResourceAttr("data.emporix_schema.test", "types.0", "PRODUCT"),
+					resource.TestCheckResourceAttrPair("data.emporix_schema.test", "schema_url", "emporix_schema.test", "schema_url"),
+					resource.TestCheckResourceAttrSet("data.emporix_schema.test", "schema_url"),
+					resource.TestCheckResourceAttr("data.emporix_schema.test", "attributes.#", "1"),
+					resource.TestCheckResourceAttr("data.emporix_schema.test", "attributes.0.key", "customField"),
+					resource.TestCheckResourceAttr("data.emporix_schema.test", "attributes.0.type", "TEXT"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSchemaDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "emporix_schema" "test" {
+  id = "tf-missing-schema"
+}
+`,
+				ExpectError: regexp.MustCompile(`Schema Not Found`),
+			},
+		},
+	})
+}
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &ShippingMethodDataSource{}
+	_ datasource.DataSourceWithConfigure = &ShippingMethodDataSource{}
+)
+
+func NewShippingMethodDataSource() datasource.DataSource {
+	return &ShippingMethodDataSource{}
+}
+
+type ShippingMethodDataSource struct {
+	client *EmporixClient
+}
+
+func (d *ShippingMethodDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_shipping_method"
+}
+
+// monetaryAmountDataSourceAttributes returns the computed amount/currency attributes of a MonetaryAmountModel
+func monetaryAmountDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"amount": schema.Float64Attribute{
+			MarkdownDescription: "Amount value.",
+			Computed:            true,
+		},
+		"currency": schema.StringAttribute{
+			MarkdownDescription: "Currency code (e.g., 'USD', 'EUR').",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *ShippingMethodDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a shipping method of a shipping zone.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Shipping method identifier.",
+				Required:            true,
+			},
+			"site": schema.StringAttribute{
+				MarkdownDescription: "Site code (typically 'main' for single-shop tenants).",
+				Required:            true,
+			},
+			"zone_id": schema.StringAttribute{
+				MarkdownDescription: "Shipping zone ID the method belongs to.",
+				Required:            true,
+			},
+			"name": schema.MapAttribute{
+				MarkdownDescription: "Localized names for the shipping method.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the shipping method is active.",
+				Computed:            true,
+			},
+			"max_order_value": schema.SingleNestedAttribute{
+				MarkdownDescription: "Maximum order value for this shipping method.",
+				Computed:            true,
+				Attributes:          monetaryAmountDataSourceAttributes(),
+			},
+			"fees": schema.ListNestedAttribute{
+				MarkdownDescription: "Shipping fee tiers based on order value.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"min_order_value": schema.SingleNestedAttribute{
+							MarkdownDescription: "Minimum order value for this fee tier.",
+							Computed:            true,
+							Attributes:          monetaryAmountDataSourceAttributes(),
+						},
+						"cost": schema.SingleNestedAttribute{
+							MarkdownDescription: "Shipping cost for this tier.",
+							Computed:            true,
+							Attributes:          monetaryAmountDataSourceAttributes(),
+						},
+						"shipping_group_id": schema.StringAttribute{
+							MarkdownDescription: "Shipping group ID of this fee tier.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"shipping_tax_code": schema.StringAttribute{
+				MarkdownDescription: "Tax code for shipping fees.",
+				Computed:            true,
+			},
+			"shipping_group_id": schema.StringAttribute{
+				MarkdownDescription: "Shipping group ID associated with this method.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ShippingMethodDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ShippingMethodDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ShippingMethodResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading shipping method data source", map[string]interface{}{
+		"id":      data.ID.ValueString(),
+		"site":    data.Site.ValueString(),
+		"zone_id": data.ZoneID.ValueString(),
+	})
+
+	method, err := d.client.GetShippingMethod(ctx, data.Site.ValueString(), data.ZoneID.ValueString(), data.ID.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			resp.Diagnostics.AddError("Shipping Method Not Found",
+				fmt.Sprintf("No shipping method %q exists in zone %q of site %q.", data.ID.ValueString(), data.ZoneID.ValueString(), data.Site.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read shipping method, got error: %s", err))
+		return
+	}
+
+	mapShippingMethodToModel(ctx, method, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccShippingMethodDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckShippingMethodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShippingMethodResourceConfig_basic() + `
+data "emporix_shipping_method" "test" {
+  id      = emporix_shipping_method.test.id
+  site    = emporix_shipping_method.test.site
+  zone_id = emporix_shipping_method.test.zone_id
+
+  depends_on = [emporix_shipping_method.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.emporix_shipping_method.test", "id", "standard-shipping"),
+					resource.TestCheckResourceAttr("data.emporix_shipping_method.test", "site", "main"),
+					resource.TestCheckResourceAttr("data.emporix_shipping_method.test", "zone_id", "zone-test"),
+					resource.TestCheckResourceAttr("data.emporix_shipping_method.test", "name.en", "Standard Shipping"),
+					resource.TestCheckResourceAttr("data.emporix_shipping_method.test", "active", "true"),
+					resource.TestCheckResourceAttr("data.emporix_shipping_method.test", "fees.#", "1"),
+					resource.TestCheckResourceAttr("data.emporix_shipping_method.test", "fees.0.cost.amount", "5.99"),
+					resource.TestCheckResourceAttr("data.emporix_shipping_method.test", "fees.0.cost.currency", "USD"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccShippingMethodDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "emporix_shipping_method" "test" {
+  id      = "tf-missing-method"
+  site    = "main"
+  zone_id = "tf-missing-zone"
+}
+`,
+				ExpectError: regexp.MustCompile(`Shipping Method Not Found`),
+			},
+		},
+	})
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &ShippingZoneDataSource{}
+	_ datasource.DataSourceWithConfigure = &ShippingZoneDataSource{}
+)
+
+type ShippingZoneDataSource struct {
+	client *EmporixClient
+}
+
+func NewShippingZoneDataSource() datasource.DataSource {
+	return &ShippingZoneDataSource{}
+}
+
+func (d *ShippingZoneDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_shipping_zone"
+}
+
+func (d *ShippingZoneDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a shipping zone of a site.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Shipping zone's unique identifier.",
+				Required:            true,
+			},
+			"site": schema.StringAttribute{
+				MarkdownDescription: "Site identifier. Typically 'main' for single-shop tenants.",
+				Required:            true,
+			},
+			"name": schema.MapAttribute{
+				MarkdownDescription: "Zone name as a map of language codes to translated names.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"default": schema.BoolAttribute{
+				MarkdownDescription: "Whether the zone is the default delivery zone for the site.",
+				Computed:            true,
+			},
+			"ship_to": schema.SetNestedAttribute{
+				MarkdownDescription: "Collection of shipping destinations.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"country": schema.StringAttribute{
+							MarkdownDescription: "Country code (e.g., 'DE', 'US').",
+							Computed:            true,
+						},
+						"postal_code": schema.StringAttribute{
+							MarkdownDescription: "Postal code or postal code pattern.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ShippingZoneDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ShippingZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ShippingZoneResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading shipping zone data source", map[string]interface{}{
+		"id":   data.ID.ValueString(),
+		"site": data.Site.ValueString(),
+	})
+
+	zone, err := d.client.GetShippingZone(ctx, data.Site.ValueString(), data.ID.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			resp.Diagnostics.AddError("Shipping Zone Not Found", fmt.Sprintf("No shipping zone %q exists for site %q.", data.ID.ValueString(), data.Site.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read shipping zone, got error: %s", err))
+		return
+	}
+
+	mapShippingZoneToModel(ctx, zone, nil, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
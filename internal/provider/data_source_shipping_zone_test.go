@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccShippingZoneDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckShippingZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShippingZoneResourceConfig_basic() + `
+data "emporix_shipping_zone" "test" {
+  id   = emporix_shipping_zone.test.id
+  site = emporix_shipping_zone.test.site
+
+  depends_on = [emporix_shipping_zone.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.emporix_shipping_zone.test", "id", "test-zone-1"),
+					resource.TestCheckResourceAttr("data.emporix_shipping_zone.test", "site", "main"),
+					resource.TestCheckResourceAttr("data.emporix_shipping_zone.test", "name.en", "Test Zone 1"),
+					resource.TestCheckResourceAttr("data.emporix_shipping_zone.test", "ship_to.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.emporix_shipping_zone.test", "ship_to.*", map[string]string{
+						"country":     "DE",
+						"postal_code": "70190",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccShippingZoneDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "emporix_shipping_zone" "test" {
+  id   = "tf-missing-zone"
+  site = "main"
+}
+`,
+				ExpectError: regexp.MustCompile(`Shipping Zone Not Found`),
+			},
+		},
+	})
+}
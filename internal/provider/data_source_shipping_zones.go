@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &ShippingZonesDataSource{}
+	_ datasource.DataSourceWithConfigure = &ShippingZonesDataSource{}
+)
+
+// ShippingZonesDataSource lists all shipping zones of a site.
+type ShippingZonesDataSource struct {
+	client *EmporixClient
+}
+
+// ShippingZonesDataSourceModel describes the data source data model.
+type ShippingZonesDataSourceModel struct {
+	Site  types.String                `tfsdk:"site"`
+	Query types.String                `tfsdk:"q"`
+	IDs   types.List                  `tfsdk:"ids"`
+	Zones []ShippingZoneResourceModel `tfsdk:"zones"`
+}
+
+func NewShippingZonesDataSource() datasource.DataSource {
+	return &ShippingZonesDataSource{}
+}
+
+func (d *ShippingZonesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_shipping_zones"
+}
+
+func (d *ShippingZonesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the shipping zones of a site.",
+		Attributes: map[string]schema.Attribute{
+			"site": schema.StringAttribute{
+				MarkdownDescription: "Site identifier. Typically 'main' for single-shop tenants.",
+				Required:            true,
+			},
+			"q": schema.StringAttribute{
+				MarkdownDescription: "Optional Emporix query expression to filter zones (e.g., `default:true`).",
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the matching shipping zones.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"zones": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching shipping zones.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Shipping zone's unique identifier.",
+							Computed:            true,
+						},
+						"site": schema.StringAttribute{
+							MarkdownDescription: "Site identifier.",
+							Computed:            true,
+						},
+						"name": schema.MapAttribute{
+							MarkdownDescription: "Zone name as a map of language codes to translated names.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"default": schema.BoolAttribute{
+							MarkdownDescription: "Whether the zone is the default delivery zone for the site.",
+							Computed:            true,
+						},
+						"ship_to": schema.SetNestedAttribute{
+							MarkdownDescription: "Collection of shipping destinations.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"country": schema.StringAttribute{
+										MarkdownDescription: "Country code (e.g., 'DE', 'US').",
+										Computed:            true,
+									},
+									"postal_code": schema.StringAttribute{
+										MarkdownDescription: "Postal code or postal code pattern.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ShippingZonesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ShippingZonesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ShippingZonesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := &ListOptions{Query: data.Query.ValueString()}
+
+	tflog.Debug(ctx, "Listing shipping zones", map[string]interface{}{
+		"site": data.Site.ValueString(),
+		"q":    opts.Query,
+	})
+
+	zones, err := d.client.ListShippingZones(ctx, data.Site.ValueString(), opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list shipping zones, got error: %s", err))
+		return
+	}
+
+	ids := make([]string, 0, len(zones))
+	data.Zones = make([]ShippingZoneResourceModel, 0, len(zones))
+	for i := range zones {
+		// name and ship_to are only set by the mapping when the API returns them
+		zone := ShippingZoneResourceModel{
+			Site: data.Site,
+			Name: types.MapNull(types.StringType),
+			ShipTo: types.SetNull(types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"country":     types.StringType,
+					"postal_code": types.StringType,
+				},
+			}),
+		}
+		mapShippingZoneToModel(ctx, &zones[i], nil, &zone, &resp.Diagnostics)
+		data.Zones = append(data.Zones, zone)
+		ids = append(ids, zones[i].ID)
+	}
+
+	idList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	data.IDs = idList
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccShippingZonesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckShippingZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShippingZoneResourceConfig_basic() + `
+data "emporix_shipping_zones" "test" {
+  site = emporix_shipping_zone.test.site
+  q    = "id:${emporix_shipping_zone.test.id}"
+
+  depends_on = [emporix_shipping_zone.test]
+}
+
+data "emporix_shipping_zones" "none" {
+  site = "main"
+  q    = "id:tf-missing-zone"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.emporix_shipping_zones.test", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.emporix_shipping_zones.test", "ids.0", "test-zone-1"),
+					resource.TestCheckResourceAttr("data.emporix_shipping_zones.test", "zones.0.site", "main"),
+					resource.TestCheckResourceAttr("data.emporix_shipping_zones.test", "zones.0.name.en", "Test Zone 1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.emporix_shipping_zones.test", "zones.0.ship_to.*", map[string]string{
+						"country":     "DE",
+						"postal_code": "70190",
+					}),
+					resource.TestCheckResourceAttr("data.emporix_shipping_zones.none", "ids.#", "0"),
+					resource.TestCheckResourceAttr("data.emporix_shipping_zones.none", "zones.#", "0"),
+				),
+			},
+		},
+	})
+}
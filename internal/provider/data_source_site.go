@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &SiteDataSource{}
+var _ datasource.DataSourceWithConfigure = &SiteDataSource{}
+
+func NewSiteDataSource() datasource.DataSource {
+	return &SiteDataSource{}
+}
+
+// SiteDataSource reads a site into the same model as the emporix_sitesettings resource
+type SiteDataSource struct {
+	client *EmporixClient
+}
+
+func (d *SiteDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site"
+}
+
+func (d *SiteDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the settings of an Emporix site by its code.",
+		Attributes: map[string]schema.Attribute{
+			"code": schema.StringAttribute{
+				Description: "Site unique identifier (code), e.g. 'main'.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Site name.",
+				Computed:    true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Flag indicating whether the site is active.",
+				Computed:    true,
+			},
+			"default": schema.BoolAttribute{
+				Description: "Flag indicating whether the site is the tenant default site.",
+				Computed:    true,
+			},
+			"includes_tax": schema.BoolAttribute{
+				Description: "Indicates whether prices for the site are returned in gross (true) or net (false).",
+				Computed:    true,
+			},
+			"default_language": schema.StringAttribute{
+				Description: "Site's default language (ISO 639-1).",
+				Computed:    true,
+			},
+			"languages": schema.ListAttribute{
+				Description: "Languages supported by the site.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"currency": schema.StringAttribute{
+				Description: "Currency used by the site (ISO 4217).",
+				Computed:    true,
+			},
+			"available_currencies": schema.ListAttribute{
+				Description: "List of the currencies supported by the site.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"ship_to_countries": schema.ListAttribute{
+				Description: "Codes of countries to which the site ships products.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"tax_calculation_address_type": schema.StringAttribute{
+				Description: "Whether tax calculation is based on the billing or the shipping address.",
+				Computed:    true,
+			},
+			"decimal_points": schema.Int64Attribute{
+				Description: "Number of decimal points used in the cart calculation.",
+				Computed:    true,
+			},
+			"cart_calculation_scale": schema.Int64Attribute{
+				Description: "Scale for cart calculations.",
+				Computed:    true,
+			},
+			"home_base": schema.SingleNestedAttribute{
+				Description: "Home base configuration of the site.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"address": schema.SingleNestedAttribute{
+						Computed: true,
+						Attributes: map[string]schema.Attribute{
+							"street": schema.StringAttribute{
+								Computed: true,
+							},
+							"street_number": schema.StringAttribute{
+								Computed: true,
+							},
+							"zip_code": schema.StringAttribute{
+								Computed: true,
+							},
+							"city": schema.StringAttribute{
+								Computed: true,
+							},
+							"country": schema.StringAttribute{
+								Computed: true,
+							},
+							"state": schema.StringAttribute{
+								Computed: true,
+							},
+						},
+					},
+					"location": schema.SingleNestedAttribute{
+						Computed: true,
+						Attributes: map[string]schema.Attribute{
+							"latitude": schema.Float64Attribute{
+								Computed: true,
+							},
+							"longitude": schema.Float64Attribute{
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+			"assisted_buying": schema.SingleNestedAttribute{
+				Description: "Assisted buying configuration of the site.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"storefront_url": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+			},
+			"mixins": schema.ListNestedAttribute{
+				Description: "Mixins of the site with their schema URLs and data.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the mixin.",
+							Computed:    true,
+						},
+						"schema_url": schema.StringAttribute{
+							Description: "URL to the JSON schema that defines this mixin's structure.",
+							Computed:    true,
+						},
+						"fields": schema.StringAttribute{
+							Description: "Mixin data as JSON string. Use jsondecode() to access individual fields.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SiteDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SiteDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SiteSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site, err := d.client.GetSite(ctx, data.Code.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Site Not Found",
+				fmt.Sprintf("No site with code %q exists in Emporix.", data.Code.ValueString()),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading site",
+			fmt.Sprintf("Could not read site %s: %s", data.Code.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Nothing is configured besides the code, so every field is taken from the API as-is
+	mapSiteToModel(ctx, site, &data, &data, &resp.Diagnostics, false)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
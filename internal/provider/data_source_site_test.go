@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSiteDataSource_basic(t *testing.T) {
+	code := fmt.Sprintf("test-ds-site-%d", testAccTimestamp(t))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSiteSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSiteSettingsResourceConfigBasic(code) + `
+data "emporix_site" "test" {
+  code = emporix_sitesettings.test.code
+
+  depends_on = [emporix_sitesettings.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.emporix_site.test", "code", code),
+					resource.TestCheckResourceAttrPair("data.emporix_site.test", "name", "emporix_sitesettings.test", "name"),
+					resource.TestCheckResourceAttr("data.emporix_site.test", "active", "true"),
+					resource.TestCheckResourceAttr("data.emporix_site.test", "default_language", "en"),
+					resource.TestCheckResourceAttr("data.emporix_site.test", "languages.#", "1"),
+					resource.TestCheckResourceAttr("data.emporix_site.test", "languages.0", "en"),
+					resource.TestCheckResourceAttr("data.emporix_site.test", "currency", "USD"),
+					resource.TestCheckResourceAttr("data.emporix_site.test", "ship_to_countries.0", "US"),
+					resource.TestCheckResourceAttr("data.emporix_site.test", "home_base.address.city", "New York"),
+					resource.TestCheckResourceAttr("data.emporix_site.test", "home_base.address.zip_code", "10001"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSiteDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "emporix_site" "test" {
+  code = "tf-missing-site"
+}
+`,
+				ExpectError: regexp.MustCompile(`Site Not Found`),
+			},
+		},
+	})
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &TaxDataSource{}
+var _ datasource.DataSourceWithConfigure = &TaxDataSource{}
+
+func NewTaxDataSource() datasource.DataSource {
+	return &TaxDataSource{}
+}
+
+// TaxDataSource defines the data source implementation
+type TaxDataSource struct {
+	client *EmporixClient
+}
+
+func (d *TaxDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tax"
+}
+
+func (d *TaxDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the tax configuration of a country, including all of its tax classes.",
+
+		Attributes: map[string]schema.Attribute{
+			"country_code": schema.StringAttribute{
+				MarkdownDescription: "Country code (e.g., 'US', 'DE', 'GB').",
+				Required:            true,
+			},
+			"tax_classes": schema.ListNestedAttribute{
+				MarkdownDescription: "Tax classes of this country, sorted by their order value.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"code": schema.StringAttribute{
+							MarkdownDescription: "Unique code of the tax class.",
+							Computed:            true,
+						},
+						"name": schema.MapAttribute{
+							MarkdownDescription: "Tax class name as a map of language codes to translated names.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"rate": schema.Float64Attribute{
+							MarkdownDescription: "Tax rate as a decimal (e.g., 0.19 for 19%).",
+							Computed:            true,
+						},
+						"description": schema.MapAttribute{
+							MarkdownDescription: "Description as a map of language codes to translated descriptions.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"order": schema.Int64Attribute{
+							MarkdownDescription: "Display order of the tax class.",
+							Computed:            true,
+						},
+						"is_default": schema.BoolAttribute{
+							MarkdownDescription: "Whether this is the default tax class for the country.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TaxDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TaxDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TaxResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading tax configuration data source", map[string]interface{}{
+		"country_code": data.CountryCode.ValueString(),
+	})
+
+	tax, err := d.client.GetTax(ctx, data.CountryCode.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			resp.Diagnostics.AddError("Tax Configuration Not Found", fmt.Sprintf("No tax configuration exists for country %q.", data.CountryCode.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read tax configuration, got error: %s", err))
+		return
+	}
+
+	mapTaxToModel(ctx, tax, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTaxDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckTaxDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaxResourceConfig_basic("AT") + `
+data "emporix_tax" "test" {
+  country_code = emporix_tax.test.country_code
+
+  depends_on = [emporix_tax.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.emporix_tax.test", "country_code", "AT"),
+					resource.TestCheckResourceAttr("data.emporix_tax.test", "tax_classes.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.emporix_tax.test", "tax_classes.*", map[string]string{
+						"code":       "TEST_STANDARD",
+						"name.en":    "Standard Rate",
+						"rate":       "0.23",
+						"is_default": "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.emporix_tax.test", "tax_classes.*", map[string]string{
+						"code":       "TEST_REDUCED",
+						"rate":       "0.08",
+						"is_default": "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTaxDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "emporix_tax" "test" {
+  country_code = "XX"
+}
+`,
+				ExpectError: regexp.MustCompile(`Tax Configuration Not Found`),
+			},
+		},
+	})
+}
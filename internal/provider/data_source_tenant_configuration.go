@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &TenantConfigurationDataSource{}
+var _ datasource.DataSourceWithConfigure = &TenantConfigurationDataSource{}
+
+func NewTenantConfigurationDataSource() datasource.DataSource {
+	return &TenantConfigurationDataSource{}
+}
+
+type TenantConfigurationDataSource struct {
+	client *EmporixClient
+}
+
+// TenantConfigurationDataSourceModel is TenantConfigurationResourceModel without the write-only value of the resource.
+type TenantConfigurationDataSourceModel struct {
+	Key     types.String `tfsdk:"key"`
+	Value   types.String `tfsdk:"value"`
+	Version types.Int64  `tfsdk:"version"`
+	Secured types.Bool   `tfsdk:"secured"`
+}
+
+func (d *TenantConfigurationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tenant_configuration"
+}
+
+func (d *TenantConfigurationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a tenant configuration from Emporix by its key. " +
+			"`project_lang` can be read, but manage the tenant's languages with `emporix_language`, not `emporix_tenant_configuration`.",
+
+		Attributes: map[string]schema.Attribute{
+			"key": schema.StringAttribute{
+				MarkdownDescription: "Configuration key (e.g., \"project_country\").",
+				Required:            true,
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "Configuration value as JSON string. Use jsondecode() to access its content.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"version": schema.Int64Attribute{
+				MarkdownDescription: "Configuration version.",
+				Computed:            true,
+			},
+			"secured": schema.BoolAttribute{
+				MarkdownDescription: "Whether the configuration is encrypted.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *TenantConfigurationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TenantConfigurationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TenantConfigurationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading tenant configuration data source", map[string]interface{}{
+		"key": data.Key.ValueString(),
+	})
+
+	config, err := d.client.GetTenantConfiguration(ctx, data.Key.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			resp.Diagnostics.AddError("Tenant Configuration Not Found", fmt.Sprintf("No tenant configuration with key %q exists in Emporix.", data.Key.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read tenant configuration, got error: %s", err))
+		return
+	}
+
+	var model TenantConfigurationResourceModel
+	mapTenantConfigurationToModel(config, &model, &resp.Diagnostics)
+	data.Value = model.Value
+	data.Version = model.Version
+	data.Secured = model.Secured
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTenantConfigurationDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckTenantConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTenantConfigurationResourceConfig("test_config_data_source", `{ enabled = true, limit = 5 }`) + `
+data "emporix_tenant_configuration" "test" {
+  key = emporix_tenant_configuration.test.key
+
+  depends_on = [emporix_tenant_configuration.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.emporix_tenant_configuration.test", "key", "test_config_data_source"),
+					resource.TestCheckResourceAttr("data.emporix_tenant_configuration.test", "value", `{"enabled":true,"limit":5}`),
+					resource.TestCheckResourceAttrPair("data.emporix_tenant_configuration.test", "version", "emporix_tenant_configuration.test", "version"),
+					resource.TestCheckResourceAttr("data.emporix_tenant_configuration.test", "secured", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTenantConfigurationDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "emporix_tenant_configuration" "test" {
+  key = "tf_missing_configuration"
+}
+`,
+				ExpectError: regexp.MustCompile(`Tenant Configuration Not Found`),
+			},
+		},
+	})
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// TestDataSources_SchemaMatchesModel ensures every data source schema declares exactly
+// the attributes of the model it reads into, so a drifting attribute fails here rather than at plan time.
+func TestDataSources_SchemaMatchesModel(t *testing.T) {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		dataSource func() datasource.DataSource
+		model      interface{}
+	}{
+		"site":                 {NewSiteDataSource, &SiteSettingsResourceModel{}},
+		"paymentmode":          {NewPaymentModeDataSource, &PaymentModeResourceModel{}},
+		"country":              {NewCountryDataSource, &CountryResourceModel{}},
+		"currency":             {NewCurrencyDataSource, &CurrencyResourceModel{}},
+		"tenant_configuration": {NewTenantConfigurationDataSource, &TenantConfigurationDataSourceModel{}},
+		"webhook":              {NewWebhookDataSource, &WebhookDataSourceModel{}},
+		"shipping_zone":        {NewShippingZoneDataSource, &ShippingZoneResourceModel{}},
+		"schema":               {NewSchemaDataSource, &SchemaResourceModel{}},
+		"custom_entity_type":   {NewCustomEntityTypeDataSource, &CustomEntityTypeResourceModel{}},
+		"delivery_time":        {NewDeliveryTimeDataSource, &DeliveryTimeResourceModel{}},
+		"shipping_method":      {NewShippingMethodDataSource, &ShippingMethodResourceModel{}},
+		"tax":                  {NewTaxDataSource, &TaxResourceModel{}},
+		"unit":                 {NewUnitDataSource, &UnitResourceModel{}},
+		"countries":            {NewCountriesDataSource, &CountriesDataSourceModel{}},
+		"currencies":           {NewCurrenciesDataSource, &CurrenciesDataSourceModel{}},
+		"shipping_zones":       {NewShippingZonesDataSource, &ShippingZonesDataSourceModel{}},
+		"webhooks":             {NewWebhooksDataSource, &WebhooksDataSourceModel{}},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var schemaResp datasource.SchemaResponse
+			tc.dataSource().Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+			if schemaResp.Diagnostics.HasError() {
+				t.Fatalf("schema returned errors: %v", schemaResp.Diagnostics)
+			}
+
+			var schemaAttrs []string
+			for name := range schemaResp.Schema.Attributes {
+				schemaAttrs = append(schemaAttrs, name)
+			}
+			sort.Strings(schemaAttrs)
+
+			var modelAttrs []string
+			modelType := reflect.TypeOf(tc.model).Elem()
+			for i := 0; i < modelType.NumField(); i++ {
+				if tag := modelType.Field(i).Tag.Get("tfsdk"); tag != "" && tag != "-" {
+					modelAttrs = append(modelAttrs, tag)
+				}
+			}
+			sort.Strings(modelAttrs)
+
+			if !reflect.DeepEqual(schemaAttrs, modelAttrs) {
+				t.Errorf("schema attributes %v do not match %s fields %v", schemaAttrs, modelType.Name(), modelAttrs)
+			}
+		})
+	}
+}
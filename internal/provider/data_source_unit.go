@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UnitDataSource{}
+var _ datasource.DataSourceWithConfigure = &UnitDataSource{}
+
+func NewUnitDataSource() datasource.DataSource {
+	return &UnitDataSource{}
+}
+
+// UnitDataSource defines the data source implementation.
+type UnitDataSource struct {
+	client *EmporixClient
+}
+
+func (d *UnitDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unit"
+}
+
+func (d *UnitDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a measurement unit from the Emporix unit handling service by its code.",
+
+		Attributes: map[string]schema.Attribute{
+			"code": schema.StringAttribute{
+				MarkdownDescription: "Unit code (e.g., kg, g, l).",
+				Required:            true,
+			},
+			"name": schema.MapAttribute{
+				MarkdownDescription: "Unit name as a map of language code to name.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Unit type, the quantity the unit measures (e.g., mass, length, volume).",
+				Computed:            true,
+			},
+			"base_unit": schema.BoolAttribute{
+				MarkdownDescription: "Whether the unit is the base unit of its type.",
+				Computed:            true,
+			},
+			"factor": schema.Float64Attribute{
+				MarkdownDescription: "Factor converting a quantity of this unit into the base unit of its type.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *UnitDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UnitDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UnitResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading unit data source", map[string]interface{}{
+		"code": data.Code.ValueString(),
+	})
+
+	unit, err := d.client.GetUnit(ctx, data.Code.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			resp.Diagnostics.AddError("Unit Not Found", fmt.Sprintf("No unit with code %q exists in Emporix.", data.Code.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read unit, got error: %s", err))
+		return
+	}
+
+	mapUnitToModel(ctx, unit, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUnitDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckUnitDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUnitResourceConfig("Gram", 0.001) + `
+data "emporix_unit" "test" {
+  code = emporix_unit.g.code
+
+  depends_on = [emporix_unit.g]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.emporix_unit.test", "code", "tf-g"),
+					resource.TestCheckResourceAttr("data.emporix_unit.test", "name.en", "Gram"),
+					resource.TestCheckResourceAttr("data.emporix_unit.test", "type", "mass"),
+					resource.TestCheckResourceAttr("data.emporix_unit.test", "base_unit", "false"),
+					resource.TestCheckResourceAttr("data.emporix_unit.test", "factor", "0.001"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUnitDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "emporix_unit" "test" {
+  code = "tf-missing-unit"
+}
+`,
+				ExpectError: regexp.MustCompile(`Unit Not Found`),
+			},
+		},
+	})
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &WebhookDataSource{}
+var _ datasource.DataSourceWithConfigure = &WebhookDataSource{}
+
+func NewWebhookDataSource() datasource.DataSource {
+	return &WebhookDataSource{}
+}
+
+type WebhookDataSource struct {
+	client *EmporixClient
+}
+
+// WebhookDataSourceModel is WebhookResourceModel without the write-only secrets of the resource.
+type WebhookDataSourceModel struct {
+	Code                types.String                 `tfsdk:"code"`
+	Active              types.Bool                   `tfsdk:"active"`
+	Provider            types.String                 `tfsdk:"provider_type"`
+	DestinationUrl      types.String                 `tfsdk:"destination_url"`
+	SecretKey           types.Bool                   `tfsdk:"secret_key_exists"`
+	SecretKeyString     types.String                 `tfsdk:"secret_key"`
+	Headers             map[string]types.String      `tfsdk:"headers"`
+	EventsConfiguration []EventConfigDataSourceModel `tfsdk:"events_configuration"`
+	Version             types.Int64                  `tfsdk:"version"`
+}
+
+// EventConfigDataSourceModel is EventConfigModel without the write-only secret of the resource.
+type EventConfigDataSourceModel struct {
+	EventType      types.String            `tfsdk:"event_type"`
+	DestinationUrl types.String            `tfsdk:"destination_url"`
+	SecretKey      types.String            `tfsdk:"secret_key"`
+	Headers        map[string]types.String `tfsdk:"headers"`
+	Subscribed     types.Bool              `tfsdk:"subscribed"`
+}
+
+// webhookDataSourceModelFrom converts a webhook resource model to the data source model
+func webhookDataSourceModelFrom(model *WebhookResourceModel) WebhookDataSourceModel {
+	data := WebhookDataSourceModel{
+		Code:            model.Code,
+		Active:          model.Active,
+		Provider:        model.Provider,
+		DestinationUrl:  model.DestinationUrl,
+		SecretKey:       model.SecretKey,
+		SecretKeyString: model.SecretKeyString,
+		Headers:         model.Headers,
+		Version:         model.Version,
+	}
+	for _, event := range model.EventsConfiguration {
+		data.EventsConfiguration = append(data.EventsConfiguration, EventConfigDataSourceModel{
+			EventType:      event.EventType,
+			DestinationUrl: event.DestinationUrl,
+			SecretKey:      event.SecretKey,
+			Headers:        event.Headers,
+			Subscribed:     event.Subscribed,
+		})
+	}
+	return data
+}
+
+func (d *WebhookDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook"
+}
+
+func (d *WebhookDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a webhook subscription configuration from Emporix by its code.",
+
+		Attributes: map[string]schema.Attribute{
+			"code": schema.StringAttribute{
+				MarkdownDescription: "Webhook code (unique identifier for this configuration).",
+				Required:            true,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether this webhook configuration is active.",
+				Computed:            true,
+			},
+			"provider_type": schema.StringAttribute{
+				MarkdownDescription: "Webhook provider type: `SVIX_SHARED`, `SVIX` or `HTTP`.",
+				Computed:            true,
+			},
+			"destination_url": schema.StringAttribute{
+				MarkdownDescription: "The URL where webhook events are sent.",
+				Computed:            true,
+			},
+			"secret_key": schema.StringAttribute{
+				MarkdownDescription: "Secret key, if returned by the API.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"secret_key_exists": schema.BoolAttribute{
+				MarkdownDescription: "Whether a secret key exists for this webhook.",
+				Computed:            true,
+			},
+			"headers": schema.MapAttribute{
+				MarkdownDescription: "HTTP headers included in webhook requests.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"version": schema.Int64Attribute{
+				MarkdownDescription: "Webhook configuration version.",
+				Computed:            true,
+			},
+			"events_configuration": schema.ListNestedAttribute{
+				MarkdownDescription: "Event-specific configuration.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"event_type": schema.StringAttribute{
+							MarkdownDescription: "The Emporix event type (e.g., 'order.created').",
+							Computed:            true,
+						},
+						"destination_url": schema.StringAttribute{
+							MarkdownDescription: "Destination URL override for this event type.",
+							Computed:            true,
+						},
+						"secret_key": schema.StringAttribute{
+							MarkdownDescription: "Secret key override for this event type, if returned by the API.",
+							Computed:            true,
+							Sensitive:           true,
+						},
+						"headers": schema.MapAttribute{
+							MarkdownDescription: "HTTP headers for this event type.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"subscribed": schema.BoolAttribute{
+							MarkdownDescription: "Whether the tenant is subscribed to this event type.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *WebhookDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *WebhookDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config WebhookDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhook, err := d.client.GetWebhook(ctx, config.Code.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			resp.Diagnostics.AddError("Webhook Not Found", fmt.Sprintf("No webhook configuration with code %q exists in Emporix.", config.Code.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read webhook configuration, got error: %s", err))
+		return
+	}
+
+	result := webhookToModel(webhook)
+	refreshEventSubscriptions(ctx, d.client, &result, &resp.Diagnostics)
+
+	data := webhookDataSourceModelFrom(&result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
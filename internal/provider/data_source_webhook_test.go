@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWebhookDataSource_basic(t *testing.T) {
+	os.Setenv("EMPORIX_WEBHOOK_FORCE_DELETE", "true")
+	t.Cleanup(func() {
+		os.Unsetenv("EMPORIX_WEBHOOK_FORCE_DELETE")
+	})
+
+	code := fmt.Sprintf("test_webhook_ds_%d", testAccTimestamp(t))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebhookResourceConfigWithEvents(code, `"HTTP"`, `"https://example.com/hooks/orders"`, true,
+					[]testEventConfig{
+						{EventType: "order.created"},
+					}) + `
+data "emporix_webhook" "test" {
+  code = emporix_webhook.test.code
+
+  depends_on = [emporix_webhook.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.emporix_webhook.test", "code", code),
+					resource.TestCheckResourceAttr("data.emporix_webhook.test", "provider_type", "HTTP"),
+					resource.TestCheckResourceAttr("data.emporix_webhook.test", "destination_url", "https://example.com/hooks/orders"),
+					resource.TestCheckResourceAttr("data.emporix_webhook.test", "active", "true"),
+					resource.TestCheckResourceAttrPair("data.emporix_webhook.test", "version", "emporix_webhook.test", "version"),
+					resource.TestCheckResourceAttr("data.emporix_webhook.test", "events_configuration.#", "1"),
+					resource.TestCheckResourceAttr("data.emporix_webhook.test", "events_configuration.0.event_type", "order.created"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWebhookDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "emporix_webhook" "test" {
+  code = "tf_missing_webhook"
+}
+`,
+				ExpectError: regexp.MustCompile(`Webhook Not Found`),
+			},
+		},
+	})
+}
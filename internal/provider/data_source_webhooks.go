@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &WebhooksDataSource{}
+var _ datasource.DataSourceWithConfigure = &WebhooksDataSource{}
+
+func NewWebhooksDataSource() datasource.DataSource {
+	return &WebhooksDataSource{}
+}
+
+// WebhooksDataSource lists the webhook configurations of the tenant.
+// The webhook config endpoint is not paginated, so filters are applied client-side.
+type WebhooksDataSource struct {
+	client *EmporixClient
+}
+
+// WebhooksDataSourceModel describes the data source data model.
+type WebhooksDataSourceModel struct {
+	Active       types.Bool            `tfsdk:"active"`
+	ProviderType types.String          `tfsdk:"provider_type"`
+	Codes        types.List            `tfsdk:"codes"`
+	Webhooks     []WebhookSummaryModel `tfsdk:"webhooks"`
+}
+
+// WebhookSummaryModel is the per-webhook shape of the list. Secrets, headers and
+// event subscriptions are left to the emporix_webhook data source.
+type WebhookSummaryModel struct {
+	Code           types.String `tfsdk:"code"`
+	Active         types.Bool   `tfsdk:"active"`
+	Provider       types.String `tfsdk:"provider_type"`
+	DestinationUrl types.String `tfsdk:"destination_url"`
+	Version        types.Int64  `tfsdk:"version"`
+}
+
+func (d *WebhooksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhooks"
+}
+
+func (d *WebhooksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the webhook configurations of the tenant, optionally filtered by active status and provider type.",
+
+		Attributes: map[string]schema.Attribute{
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Only return webhook configurations with this active status.",
+				Optional:            true,
+			},
+			"provider_type": schema.StringAttribute{
+				MarkdownDescription: "Only return webhook configurations of this provider type: `SVIX_SHARED`, `SVIX` or `HTTP`.",
+				Optional:            true,
+			},
+			"codes": schema.ListAttribute{
+				MarkdownDescription: "Codes of the matching webhook configurations.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"webhooks": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching webhook configurations.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"code": schema.StringAttribute{
+							MarkdownDescription: "Webhook code (unique identifier for this configuration).",
+							Computed:            true,
+						},
+						"active": schema.BoolAttribute{
+							MarkdownDescription: "Whether this webhook configuration is active.",
+							Computed:            true,
+						},
+						"provider_type": schema.StringAttribute{
+							MarkdownDescription: "Webhook provider type: `SVIX_SHARED`, `SVIX` or `HTTP`.",
+							Computed:            true,
+						},
+						"destination_url": schema.StringAttribute{
+							MarkdownDescription: "The URL where webhook events are sent.",
+							Computed:            true,
+						},
+						"version": schema.Int64Attribute{
+							MarkdownDescription: "Webhook configuration version.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *WebhooksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *WebhooksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WebhooksDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Listing webhook configurations")
+
+	webhooks, err := d.client.ListWebhooks(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list webhook configurations, got error: %s", err))
+		return
+	}
+
+	codes := []string{}
+	data.Webhooks = []WebhookSummaryModel{}
+	for i := range webhooks {
+		model := webhookToModel(&webhooks[i])
+
+		if !data.Active.IsNull() && model.Active.ValueBool() != data.Active.ValueBool() {
+			continue
+		}
+		if !data.ProviderType.IsNull() && !strings.EqualFold(model.Provider.ValueString(), data.ProviderType.ValueString()) {
+			continue
+		}
+
+		data.Webhooks = append(data.Webhooks, WebhookSummaryModel{
+			Code:           model.Code,
+			Active:         model.Active,
+			Provider:       model.Provider,
+			DestinationUrl: model.DestinationUrl,
+			Version:        model.Version,
+		})
+		codes = append(codes, model.Code.ValueString())
+	}
+
+	codeList, diags := types.ListValueFrom(ctx, types.StringType, codes)
+	resp.Diagnostics.Append(diags...)
+	data.Codes = codeList
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWebhooksDataSource_basic(t *testing.T) {
+	os.Setenv("EMPORIX_WEBHOOK_FORCE_DELETE", "true")
+	t.Cleanup(func() {
+		os.Unsetenv("EMPORIX_WEBHOOK_FORCE_DELETE")
+	})
+
+	code := fmt.Sprintf("test_webhooks_ds_%d", testAccTimestamp(t))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebhookResourceConfig(code, `"HTTP"`, `"https://example.com/hooks/all"`, true, nil, nil) + `
+data "emporix_webhooks" "active" {
+  active        = true
+  provider_type = "HTTP"
+
+  depends_on = [emporix_webhook.test]
+}
+
+data "emporix_webhooks" "none" {
+  provider_type = "TF_MISSING_PROVIDER"
+
+  depends_on = [emporix_webhook.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.emporix_webhooks.active", "codes.*", code),
+					resource.TestCheckTypeSetElemNestedAttrs("data.emporix_webhooks.active", "webhooks.*", map[string]string{
+						"code":            code,
+						"active":          "true",
+						"provider_type":   "HTTP",
+						"destination_url": "https://example.com/hooks/all",
+					}),
+					resource.TestCheckResourceAttr("data.emporix_webhooks.none", "codes.#", "0"),
+					resource.TestCheckResourceAttr("data.emporix_webhooks.none", "webhooks.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ ephemeral.EphemeralResource = &AccessTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &AccessTokenEphemeralResource{}
+
+func NewAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &AccessTokenEphemeralResource{}
+}
+
+// AccessTokenEphemeralResource issues a short-lived bearer token with the provider's client credentials.
+type AccessTokenEphemeralResource struct {
+	client *EmporixClient
+}
+
+// AccessTokenEphemeralResourceModel describes the ephemeral resource data model.
+type AccessTokenEphemeralResourceModel struct {
+	Scope       types.String `tfsdk:"scope"`
+	AccessToken types.String `tfsdk:"access_token"`
+	TokenType   types.String `tfsdk:"token_type"`
+	ExpiresIn   types.Int64  `tfsdk:"expires_in"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
+}
+
+func (r *AccessTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_token"
+}
+
+func (r *AccessTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Issues a short-lived Emporix bearer token with the provider's `client_id` and `client_secret`, " +
+			"e.g. for `http` data sources, smoke-test scripts or other providers in the same run. " +
+			"The token is never stored in plan or state.",
+
+		Attributes: map[string]schema.Attribute{
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "Space-separated scopes to request, e.g. to hand a script a token narrower than the provider's. " +
+					"Defaults to the provider's `scope`. Reports the scopes the token was granted.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "Bearer token for the `Authorization` header of Emporix API requests.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"token_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the token, usually `Bearer`.",
+				Computed:            true,
+			},
+			"expires_in": schema.Int64Attribute{
+				MarkdownDescription: "Lifetime of the token in seconds, as returned by the token endpoint. `0` if the endpoint didn't say.",
+				Computed:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "Time the token expires, in RFC 3339 format. Null if the token endpoint didn't return a lifetime.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *AccessTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AccessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data AccessTokenEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tokens supplied via access_token or credential_process can't be used to issue new ones
+	credentials, ok := r.client.tokens.(*clientCredentialsTokenSource)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Client Credentials Required",
+			"emporix_access_token issues tokens with the provider's client_id and client_secret, "+
+				"but the provider is configured with access_token or credential_process. Configure client credentials instead.",
+		)
+		return
+	}
+
+	scope := credentials.scope
+	if !data.Scope.IsNull() && !data.Scope.IsUnknown() {
+		scope = data.Scope.ValueString()
+	}
+
+	tflog.Debug(ctx, "Issuing ephemeral access token", map[string]interface{}{
+		"scope": scope,
+	})
+
+	issuedAt := time.Now()
+	tokenResponse, err := requestAccessToken(ctx, credentials.apiUrl, credentials.clientId, credentials.clientSecret, scope)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Generate Access Token", fmt.Sprintf("Could not generate OAuth access token: %s", err))
+		return
+	}
+
+	data.AccessToken = types.StringValue(tokenResponse.AccessToken)
+	data.TokenType = stringToNull(tokenResponse.TokenType)
+	data.ExpiresIn = types.Int64Value(int64(tokenResponse.ExpiresIn))
+	data.ExpiresAt = types.StringNull()
+	if tokenResponse.ExpiresIn > 0 {
+		data.ExpiresAt = types.StringValue(issuedAt.Add(time.Duration(tokenResponse.ExpiresIn) * time.Second).UTC().Format(time.RFC3339))
+	}
+
+	// Prefer the scopes the token endpoint actually granted over the requested ones
+	data.Scope = stringToNull(scope)
+	if tokenResponse.Scope != "" {
+		data.Scope = types.StringValue(tokenResponse.Scope)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// openAccessToken opens emporix_access_token the way Terraform does and returns its result
+func openAccessToken(t *testing.T, client *EmporixClient, scope *string) (AccessTokenEphemeralResourceModel, ephemeral.OpenResponse) {
+	t.Helper()
+	ctx := context.Background()
+
+	r := NewAccessTokenEphemeralResource()
+	var configureResp ephemeral.ConfigureResponse
+	r.(ephemeral.EphemeralResourceWithConfigure).Configure(ctx, ephemeral.ConfigureRequest{ProviderData: client}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("configure returned errors: %v", configureResp.Diagnostics)
+	}
+
+	var schemaResp ephemeral.SchemaResponse
+	r.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	if scope != nil {
+		values["scope"] = tftypes.NewValue(tftypes.String, *scope)
+	}
+
+	resp := ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	r.Open(ctx, ephemeral.OpenRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}, &resp)
+
+	var data AccessTokenEphemeralResourceModel
+	if !resp.Diagnostics.HasError() {
+		if diags := resp.Result.Get(ctx, &data); diags.HasError() {
+			t.Fatalf("unable to read result: %v", diags)
+		}
+	}
+	return data, resp
+}
+
+func TestAccessTokenEphemeralResource_Open(t *testing.T) {
+	client, _ := newFakeAPIClient(t)
+
+	before := time.Now()
+	data, resp := openAccessToken(t, client, nil)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("open returned errors: %v", resp.Diagnostics)
+	}
+
+	if data.AccessToken.ValueString() == "" {
+		t.Error("expected an access token")
+	}
+	if data.ExpiresIn.ValueInt64() <= 0 {
+		t.Errorf("expected a positive expires_in, got %d", data.ExpiresIn.ValueInt64())
+	}
+	expiresAt, err := time.Parse(time.RFC3339, data.ExpiresAt.ValueString())
+	if err != nil {
+		t.Fatalf("expires_at is not RFC 3339: %s", err)
+	}
+	if expected := before.Add(time.Duration(data.ExpiresIn.ValueInt64()) * time.Second); expiresAt.Before(expected.Add(-time.Second)) {
+		t.Errorf("expected expires_at around %s, got %s", expected, expiresAt)
+	}
+	if !data.Scope.IsNull() {
+		t.Errorf("expected no scope without a provider scope, got %s", data.Scope)
+	}
+}
+
+func TestAccessTokenEphemeralResource_NarrowerScope(t *testing.T) {
+	client, _ := newFakeAPIClient(t)
+
+	scope := "tenant=test webhook.subscription_read"
+	data, resp := openAccessToken(t, client, &scope)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("open returned errors: %v", resp.Diagnostics)
+	}
+
+	if data.Scope.ValueString() != scope {
+		t.Errorf("expected scope %q, got %s", scope, data.Scope)
+	}
+
+	// The provider keeps using its own token
+	providerToken, err := client.tokens.Token(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if providerToken == data.AccessToken.ValueString() {
+		t.Error("expected the ephemeral token to be separate from the provider's token")
+	}
+}
+
+func TestAccessTokenEphemeralResource_StaticTokenIsAnError(t *testing.T) {
+	_, server := newFakeAPIClient(t)
+	client := newEmporixClientWithTokenSource(server.Tenant, &staticTokenSource{token: "fixed"}, server.URL)
+
+	_, resp := openAccessToken(t, client, nil)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when the provider uses a fixed access_token")
+	}
+	if summary := resp.Diagnostics.Errors()[0].Summary(); summary != "Client Credentials Required" {
+		t.Errorf("unexpected error: %s", summary)
+	}
+}
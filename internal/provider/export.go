@@ -0,0 +1,1081 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const defaultApiUrl = "https://api.emporix.io"
+
+// ExportOptions configures Export. Credentials follow the provider configuration:
+// an access token is used as-is, otherwise one is requested with the client credentials.
+type ExportOptions struct {
+	Tenant       string
+	AccessToken  string
+	ClientId     string
+	ClientSecret string
+	Scope        string
+	ApiUrl       string
+	// CredentialProcess is the command line of a command printing an access token, used instead
+	// of the client credentials; see splitCommandLine for the quoting rules
+	CredentialProcess string
+	// Profile names a profile of the credentials file that fills in the settings left empty
+	Profile string
+
+	// OutputDir receives the generated .tf files; it is created if missing
+	OutputDir string
+	// Log receives progress and warnings, defaults to io.Discard
+	Log io.Writer
+}
+
+// Export reads the configuration of an existing tenant and writes Terraform configuration
+// for it, together with import blocks that bring every object under management.
+func Export(ctx context.Context, opts ExportOptions) error {
+	if opts.Profile != "" {
+		filename, err := credentialsFilePath()
+		if err != nil {
+			return err
+		}
+		profile, err := loadCredentialsProfile(filename, opts.Profile)
+		if err != nil {
+			return err
+		}
+		// The profile's credentials only apply if none were given, like in the provider
+		if opts.CredentialProcess == "" && opts.ClientId == "" && opts.ClientSecret == "" {
+			if profile.CredentialProcess != "" && (profile.ClientId != "" || profile.ClientSecret != "") {
+				return fmt.Errorf("profile %q sets both client credentials and a credential process, set only one of them", opts.Profile)
+			}
+			opts.CredentialProcess = profile.CredentialProcess
+		}
+		if opts.CredentialProcess == "" {
+			opts.ClientId = cmp.Or(opts.ClientId, profile.ClientId)
+			opts.ClientSecret = cmp.Or(opts.ClientSecret, profile.ClientSecret)
+		}
+		opts.Tenant = cmp.Or(opts.Tenant, profile.Tenant)
+		opts.Scope = cmp.Or(opts.Scope, profile.Scope)
+		opts.ApiUrl = cmp.Or(opts.ApiUrl, profile.ApiUrl)
+	}
+
+	if opts.Tenant == "" {
+		return fmt.Errorf("a tenant is required")
+	}
+	if opts.ApiUrl == "" {
+		opts.ApiUrl = defaultApiUrl
+	}
+
+	var tokens tokenSource = &staticTokenSource{token: opts.AccessToken}
+	if opts.AccessToken == "" && opts.CredentialProcess != "" {
+		if opts.ClientId != "" || opts.ClientSecret != "" {
+			return fmt.Errorf("both client credentials and a credential process are given, set only one of them")
+		}
+		command, err := splitCommandLine(opts.CredentialProcess)
+		if err != nil {
+			return fmt.Errorf("invalid credential process: %w", err)
+		}
+		process := newProcessTokenSource(command)
+		if _, err := process.Token(ctx); err != nil {
+			return fmt.Errorf("could not obtain an access token from the credential process: %w", err)
+		}
+		tokens = process
+	} else if opts.AccessToken == "" {
+		if opts.ClientId == "" || opts.ClientSecret == "" {
+			return fmt.Errorf("either an access token, a credential process or a client ID and secret are required")
+		}
+		credentials := newClientCredentialsTokenSource(opts.ApiUrl, opts.ClientId, opts.ClientSecret, opts.Scope)
+		if _, err := credentials.Token(ctx); err != nil {
+			return fmt.Errorf("could not generate OAuth access token: %w", err)
+		}
+		tokens = credentials
+	}
+
+	client := newEmporixClientWithTokenSource(opts.Tenant, tokens, opts.ApiUrl)
+	return exportTenant(ctx, client, opts)
+}
+
+func exportTenant(ctx context.Context, client *EmporixClient, opts ExportOptions) error {
+	if opts.Log == nil {
+		opts.Log = io.Discard
+	}
+
+	e := &exporter{
+		ctx:    ctx,
+		client: client,
+		log:    opts.Log,
+		files:  map[string]*hclwrite.File{},
+		names:  map[string]map[string]bool{},
+	}
+
+	steps := []struct {
+		name string
+		run  func() error
+	}{
+		{"languages", e.exportLanguages},
+		{"sites", e.exportSites},
+		{"countries", e.exportCountries},
+		{"currencies", e.exportCurrencies},
+		{"taxes", e.exportTaxes},
+		{"units", e.exportUnits},
+		{"categories", e.exportCategories},
+		{"price lists", e.exportPriceLists},
+		{"IAM groups", e.exportIAMGroups},
+		{"customer segments", e.exportCustomerSegments},
+		{"locations", e.exportLocations},
+		{"sequential ID schemas", e.exportSequentialIDSchemas},
+		{"coupons", e.exportCoupons},
+		{"reward points", e.exportRewardPointsConfig},
+		{"payment modes", e.exportPaymentModes},
+		{"shipping zones and methods", e.exportShipping},
+		{"delivery times", e.exportDeliveryTimes},
+		{"schemas", e.exportSchemas},
+		{"custom entities", e.exportCustomEntities},
+		{"tenant configurations", e.exportTenantConfigurations},
+		{"webhooks", e.exportWebhooks},
+	}
+
+	// A missing scope for one service shouldn't stop the rest of the export
+	for _, step := range steps {
+		if err := step.run(); err != nil {
+			if _, ok := AsAPIError(err); !ok {
+				return fmt.Errorf("exporting %s: %w", step.name, err)
+			}
+			fmt.Fprintf(e.log, "Warning: skipping %s: %s\n", step.name, err)
+		}
+	}
+
+	return e.write(opts)
+}
+
+// exporter collects generated blocks per output file
+type exporter struct {
+	ctx    context.Context
+	client *EmporixClient
+	log    io.Writer
+
+	fileNames []string
+	files     map[string]*hclwrite.File
+	// names holds the resource names used so far, per resource type
+	names map[string]map[string]bool
+	count int
+}
+
+// exportedType is a resource type together with the file its blocks are written to
+type exportedType struct {
+	name   string
+	schema schema.Schema
+	file   string
+}
+
+func (e *exporter) resourceType(r resource.Resource, file string) (*exportedType, error) {
+	name, s, diags := resourceSchema(e.ctx, r)
+	if err := e.report(diags); err != nil {
+		return nil, err
+	}
+	return &exportedType{name: name, schema: s, file: file}, nil
+}
+
+// newModel prepares a model for one of the mapping functions
+func (e *exporter) newModel(t *exportedType, model interface{}) error {
+	return e.report(nullModel(e.ctx, t.schema, model))
+}
+
+// add writes a resource block and its import block, returning the block body
+func (e *exporter) add(t *exportedType, nameHint, importID string, model interface{}) (string, *hclwrite.Body, error) {
+	name := e.resourceName(t.name, nameHint)
+
+	block := hclwrite.NewBlock("resource", []string{t.name, name})
+	if err := e.report(writeResourceBody(e.ctx, block.Body(), t.schema, model)); err != nil {
+		return "", nil, fmt.Errorf("%s.%s: %w", t.name, name, err)
+	}
+
+	e.appendBlock(t.file, block)
+
+	importBlock := hclwrite.NewBlock("import", nil)
+	importBlock.Body().SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: t.name},
+		hcl.TraverseAttr{Name: name},
+	})
+	importBlock.Body().SetAttributeValue("id", cty.StringVal(importID))
+	e.appendBlock("imports.tf", importBlock)
+
+	e.count++
+	return name, block.Body(), nil
+}
+
+func (e *exporter) appendBlock(fileName string, block *hclwrite.Block) {
+	file, ok := e.files[fileName]
+	if !ok {
+		file = hclwrite.NewEmptyFile()
+		e.files[fileName] = file
+		e.fileNames = append(e.fileNames, fileName)
+	} else {
+		file.Body().AppendNewline()
+	}
+	file.Body().AppendBlock(block)
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// resourceName turns an ID into a unique Terraform resource name
+func (e *exporter) resourceName(resourceType, hint string) string {
+	name := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(hint), "_"), "_")
+	if name == "" {
+		name = "this"
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+
+	used, ok := e.names[resourceType]
+	if !ok {
+		used = map[string]bool{}
+		e.names[resourceType] = used
+	}
+
+	unique := name
+	for i := 2; used[unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	used[unique] = true
+	return unique
+}
+
+// report prints warnings and turns errors into a Go error
+func (e *exporter) report(diags diag.Diagnostics) error {
+	for _, d := range diags.Warnings() {
+		fmt.Fprintf(e.log, "Warning: %s: %s\n", d.Summary(), d.Detail())
+	}
+	if !diags.HasError() {
+		return nil
+	}
+
+	var messages []string
+	for _, d := range diags.Errors() {
+		messages = append(messages, fmt.Sprintf("%s: %s", d.Summary(), d.Detail()))
+	}
+	return fmt.Errorf("%s", strings.Join(messages, "; "))
+}
+
+func (e *exporter) write(opts ExportOptions) error {
+	if err := os.MkdirAll(opts.OutputDir, 0o755); err != nil {
+		return err
+	}
+
+	provider := hclwrite.NewEmptyFile()
+	terraform := provider.Body().AppendNewBlock("terraform", nil).Body()
+	terraform.SetAttributeValue("required_version", cty.StringVal(">= 1.5"))
+	terraform.AppendNewBlock("required_providers", nil).Body().SetAttributeValue("emporix", cty.ObjectVal(map[string]cty.Value{
+		"source": cty.StringVal("emporix/emporix"),
+	}))
+	provider.Body().AppendNewline()
+	providerBody := provider.Body().AppendNewBlock("provider", []string{"emporix"}).Body()
+	providerBody.SetAttributeValue("tenant", cty.StringVal(e.client.Tenant))
+	if e.client.ApiUrl != defaultApiUrl {
+		providerBody.SetAttributeValue("api_url", cty.StringVal(e.client.ApiUrl))
+	}
+
+	files := map[string]*hclwrite.File{"provider.tf": provider}
+	fileNames := append([]string{"provider.tf"}, e.fileNames...)
+	for name, file := range e.files {
+		files[name] = file
+	}
+
+	for _, name := range fileNames {
+		path := filepath.Join(opts.OutputDir, name)
+		if err := os.WriteFile(path, hclwrite.Format(files[name].Bytes()), 0o644); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(e.log, "Exported %d resources to %s\n", e.count, opts.OutputDir)
+	return nil
+}
+
+func (e *exporter) exportSites() error {
+	t, err := e.resourceType(NewSiteSettingsResource(), "sites.tf")
+	if err != nil {
+		return err
+	}
+
+	sites, err := e.client.ListSites(e.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for i := range sites {
+		// Listed sites don't include their mixins
+		site, err := e.client.GetSite(e.ctx, sites[i].Code)
+		if err != nil {
+			return err
+		}
+
+		var model SiteSettingsResourceModel
+		if err := e.newModel(t, &model); err != nil {
+			return err
+		}
+		var diags diag.Diagnostics
+		mapSiteToModel(e.ctx, site, &model, &model, &diags, false)
+		if err := e.report(diags); err != nil {
+			return err
+		}
+
+		if _, _, err := e.add(t, site.Code, site.Code, &model); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *exporter) exportLanguages() error {
+	t, err := e.resourceType(NewLanguageResource(), "languages.tf")
+	if err != nil {
+		return err
+	}
+
+	languages, err := e.client.ListLanguages(e.ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range languages {
+		var model LanguageResourceModel
+		if err := e.newModel(t, &model); err != nil {
+			return err
+		}
+		var diags diag.Diagnostics
+		mapLanguageToModel(e.ctx, &languages[i], &model, &diags)
+		if err := e.report(diags); err != nil {
+			return err
+		}
+
+		if _, _, err := e.add(t, languages[i].Code, languages[i].Code, &model); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *exporter) exportCountries() error {
+	t, err := e.resourceType(NewCountryResource(), "countries.tf")
+	if err != nil {
+		return err
+	}
+
+	// Every country exists on every tenant; only the active ones are configuration
+	countries, err := e.client.ListCountries(e.ctx, &ListOptions{Query: "active:true"})
+	if err != nil {
+		return err
+	}
+
+	for i := range countries {
+		var model CountryResourceModel
+		if err := e.newModel(t, &model); err != nil {
+			return err
+		}
+		var diags diag.Diagnostics
+		mapCountryToModel(e.ctx, &countries[i], &model, &diags)
+		if err := e.report(diags); err != nil {
+			return err
+		}
+
+		if _, _, err := e.add(t, countries[i].Code, countries[i].Code, &model); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *exporter) exportCurrencies() error {
+	t, err := e.resourceType(NewCurrencyResource(), "currencies.tf")
+	if err != nil {
+		return err
+	}
+
+	currencies, err := e.client.ListCurrencies(e.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for i := range currencies {
+		var model CurrencyResourceModel
+		if err := e.newModel(t, &model); err != nil {
+			return err
+		}
+		var diags diag.Diagnostics
+		mapCurrencyToModel(e.ctx, &currencies[i], &model, &diags)
+		if err := e.report(diags); err != nil {
+			return err
+		}
+
+		if _, _, err := e.add(t, currencies[i].Code, currencies[i].Code, &model); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *exporter) exportUnits() error {
+	t, err := e.resourceType(NewUnitResource(), "units.tf")
+	if err != nil {
+		return err
+	}
+
+	units, err := e.client.ListUnits(e.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for i := range units {
+		var model UnitResourceModel
+		if err := e.newModel(t, &model); err != nil {
+			return err
+		}
+		var diags diag.Diagnostics
+		mapUnitToModel(e.ctx, &units[i], &model, &diags)
+		if err := e.report(diags); err != nil {
+			return err
+		}
+
+		if _, _, err := e.add(t, units[i].Code, units[i].Code, &model); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *exporter) exportCategories() error {
+	t, err := e.resourceType(NewCategoryResource(), "categories.tf")
+	if err != nil {
+		return err
+	}
+
+	categories, err := e.client.ListCategories(e.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	byID := make(map[string]*Category, len(categories))
+	for i := range categories {
+		byID[categories[i].ID] = &categories[i]
+	}
+
+	// Parents are written before their children, which refer to them by resource address
+	names := make(map[string]string, len(categories))
+	var add func(category *Category) error
+	add = func(category *Category) error {
+		if _, done := names[category.ID]; done {
+			return nil
+		}
+		names[category.ID] = ""
+
+		parent, hasParent := byID[category.ParentID]
+		if hasParent {
+			if err := add(parent); err != nil {
+				return err
+			}
+		}
+
+		var model CategoryResourceModel
+		if err := e.newModel(t, &model); err != nil {
+			return err
+		}
+		var diags diag.Diagnostics
+		mapCategoryToModel(e.ctx, category, &model, &diags)
+		if err := e.report(diags); err != nil {
+			return err
+		}
+
+		nameHint := category.ID
+		if name := category.Name["en"]; name != "" {
+			nameHint = name
+		}
+		name, body, err := e.add(t, nameHint, category.ID, &model)
+		if err != nil {
+			return err
+		}
+		names[category.ID] = name
+
+		if hasParent && names[parent.ID] != "" {
+			body.SetAttributeTraversal("parent_id", hcl.Traversal{
+				hcl.TraverseRoot{Name: t.name},
+				hcl.TraverseAttr{Name: names[parent.ID]},
+				hcl.TraverseAttr{Name: "id"},
+			})
+		}
+		return nil
+	}
+
+	for i := range categories {
+		if err := add(&categories[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *exporter) exportPriceLists() error {
+	t, err := e.resourceType(NewPriceListResource(), "price_lists.tf")
+	if err != nil {
+		return err
+	}
+
+	priceLists, err := e.client.ListPriceLists(e.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for i := range priceLists {
+		var model PriceListResourceModel
+		if err := e.newModel(t, &model); err != nil {
+			return err
+		}
+		var diags diag.Diagnostics
+		mapPriceListToModel(e.ctx, &priceLists[i], &model, &diags)
+		if err := e.report(diags); err != nil {
+			return err
+		}
+
+		nameHint := priceLists[i].ID
+		if name := priceLists[i].Name["en"]; name != "" {
+			nameHint = name
+		}
+		if _, _, err := e.add(t, nameHint, priceLists[i].ID, &model); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *exporter) exportIAMGroups() error {
+	t, err := e.resourceType(NewIAMGroupResource(), "iam_groups.tf")
+	if err != nil {
+		return err
+	}
+
+	groups, err := e.client.ListIAMGroups(e.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for i := range groups {
+		var model IAMGroupResourceModel
+		if err := e.newModel(t, &model); err != nil {
+			return err
+		}
+		var diags diag.Diagnostics
+		mapIAMGroupToModel(e.ctx, &groups[i], &model, &diags)
+		if err := e.report(diags); err != nil {
+			return err
+		}
+
+		nameHint := groups[i].ID
+		if name := groups[i].Name["en"]; name != "" {
+			nameHint = name
+		}
+		if _, _, err := e.add(t, nameHint, groups[i].ID, &model); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *exporter) exportCustomerSegments() error {
+	t, err := e.resourceType(NewCustomerSegmentResource(), "customer_segments.tf")
+	if err != nil {
+		return err
+	}
+
+	segments, err := e.client.ListCustomerSegments(e.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for i := range segments {
+		var model CustomerSegmentResourceModel
+		if err := e.newModel(t, &model); err != nil {
+			return err
+		}
+		var diags diag.Diagnostics
+		mapCustomerSegmentToModel(e.ctx, &segments[i], &model, &diags)
+		if err := e.report(diags); err != nil {
+			return err
+		}
+
+		nameHint := segments[i].ID
+		if name := segments[i].Name["en"]; name != "" {
+			nameHint = name
+		}
+		if _, _, err := e.add(t, nameHint, segments[i].ID, &model); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *exporter) exportLocations() error {
+	t, err := e.resourceType(NewLocationResource(), "locations.tf")
+	if err != nil {
+		return err
+	}
+
+	locations, err := e.client.ListLocations(e.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for i := range locations {
+		var model LocationResourceModel
+		if err := e.newModel(t, &model); err != nil {
+			return err
+		}
+		var diags diag.Diagnostics
+		mapLocationToModel(e.ctx, &locations[i], &model, &diags)
+		if err := e.report(diags); err != nil {
+			return err
+		}
+
+		if _, _, err := e.add(t, locations[i].Name, locations[i].ID, &model); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *exporter) exportSequentialIDSchemas() error {
+	t, err := e.resourceType(NewSequentialIDSchemaResource(), "sequential_ids.tf")
+	if err != nil {
+		return err
+	}
+
+	schemas, err := e.client.ListSequentialIDSchemas(e.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for i := range schemas {
+		var model SequentialIDSchemaResourceModel
+		if err := e.newModel(t, &model); err != nil {
+			return err
+		}
+		mapSequentialIDSchemaToModel(&schemas[i], &model)
+
+		if _, _, err := e.add(t, schemas[i].Name, schemas[i].ID, &model); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *exporter) exportCoupons() error {
+	t, err := e.resourceType(NewCouponResource(), "coupons.tf")
+	if err != nil {
+		return err
+	}
+
+	coupons, err := e.client.ListCoupons(e.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for i := range coupons {
+		var model CouponResourceModel
+		if err := e.newModel(t, &model); err != nil {
+			return err
+		}
+		var diags diag.Diagnostics
+		mapCouponToModel(e.ctx, &coupons[i], &model, &diags)
+		if err := e.report(diags); err != nil {
+			return err
+		}
+
+		if _, _, err := e.add(t, coupons[i].Code, coupons[i].Code, &model); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *exporter) exportRewardPointsConfig() error {
+	t, err := e.resourceType(NewRewardPointsConfigResource(), "reward_points.tf")
+	if err != nil {
+		return err
+	}
+
+	config, err := e.client.GetRewardPointsConfig(e.ctx)
+	if err != nil {
+		return err
+	}
+
+	// The configuration exists on every tenant; it is only configuration once it differs from the defaults
+	config.Metadata = nil
+	if *config == *defaultRewardPointsConfig() {
+		return nil
+	}
+
+	var model RewardPointsConfigResourceModel
+	if err := e.newModel(t, &model); err != nil {
+		return err
+	}
+	mapRewardPointsConfigToModel(e.client.Tenant, config, &model)
+
+	_, _, err = e.add(t, "reward_points", model.ID.ValueString(), &model)
+	return err
+}
+
+func (e *exporter) exportTaxes() error {
+	t, err := e.resourceType(NewTaxResource(), "taxes.tf")
+	if err != nil {
+		return err
+	}
+
+	taxes, err := e.client.ListTaxes(e.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for i := range taxes {
+		var model TaxResourceModel
+		if err := e.newModel(t, &model); err != nil {
+			return err
+		}
+		var diags diag.Diagnostics
+		mapTaxToModel(e.ctx, &taxes[i], &model, &diags)
+		if err := e.report(diags); err != nil {
+			return err
+		}
+
+		if _, _, err := e.add(t, taxes[i].LocationCode, taxes[i].LocationCode, &model); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *exporter) exportPaymentModes() error {
+	t, err := e.resourceType(NewPaymentModeResource(), "payment_modes.tf")
+	if err != nil {
+		return err
+	}
+
+	paymentModes, err := e.client.ListPaymentModes(e.ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range paymentModes {
+		var model PaymentModeResourceModel
+		if err := e.newModel(t, &model); err != nil {
+			return err
+		}
+		var diags diag.Diagnostics
+		mapPaymentModeToModel(e.ctx, &paymentModes[i], &model, &diags)
+		if err := e.report(diags); err != nil {
+			return err
+		}
+
+		if _, _, err := e.add(t, paymentModes[i].Code, paymentModes[i].ID, &model); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *exporter) exportShipping() error {
+	zoneType, err := e.resourceType(NewShippingZoneResource(), "shipping.tf")
+	if err != nil {
+		return err
+	}
+	methodType, err := e.resourceType(NewShippingMethodResource(), "shipping.tf")
+	if err != nil {
+		return err
+	}
+
+	sites, err := e.client.ListSites(e.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, site := range sites {
+		zones, err := e.client.ListShippingZones(e.ctx, site.Code, nil)
+		if err != nil {
+			return err
+		}
+
+		for i := range zones {
+			zone := &zones[i]
+
+			var model ShippingZoneResourceModel
+			if err := e.newModel(zoneType, &model); err != nil {
+				return err
+			}
+			var diags diag.Diagnostics
+			mapShippingZoneToModel(e.ctx, zone, nil, &model, &diags)
+			if err := e.report(diags); err != nil {
+				return err
+			}
+			model.Site = stringToNull(site.Code)
+
+			importID := fmt.Sprintf("%s:%s", site.Code, zone.ID)
+			if _, _, err := e.add(zoneType, site.Code+"_"+zone.ID, importID, &model); err != nil {
+				return err
+			}
+
+			if err := e.exportShippingMethods(methodType, site.Code, zone.ID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (e *exporter) exportShippingMethods(t *exportedType, site, zoneID string) error {
+	methods, err := e.client.ListShippingMethods(e.ctx, site, zoneID, nil)
+	if err != nil {
+		return err
+	}
+
+	for i := range methods {
+		var model ShippingMethodResourceModel
+		if err := e.newModel(t, &model); err != nil {
+			return err
+		}
+		var diags diag.Diagnostics
+		mapShippingMethodToModel(e.ctx, &methods[i], &model, &diags)
+		if err := e.report(diags); err != nil {
+			return err
+		}
+		model.Site = stringToNull(site)
+		model.ZoneID = stringToNull(zoneID)
+
+		importID := fmt.Sprintf("%s:%s:%s", site, zoneID, methods[i].ID)
+		if _, _, err := e.add(t, site+"_"+zoneID+"_"+methods[i].ID, importID, &model); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *exporter) exportDeliveryTimes() error {
+	t, err := e.resourceType(NewDeliveryTimeResource(), "delivery_times.tf")
+	if err != nil {
+		return err
+	}
+
+	deliveryTimes, err := e.client.ListDeliveryTimes(e.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for i := range deliveryTimes {
+		var model DeliveryTimeResourceModel
+		if err := e.newModel(t, &model); err != nil {
+			return err
+		}
+		var diags diag.Diagnostics
+		mapDeliveryTimeToModel(e.ctx, &deliveryTimes[i], &model, &diags)
+		if err := e.report(diags); err != nil {
+			return err
+		}
+
+		if _, _, err := e.add(t, deliveryTimes[i].Name, deliveryTimes[i].ID, &model); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *exporter) exportSchemas() error {
+	t, err := e.resourceType(NewSchemaResource(), "schemas.tf")
+	if err != nil {
+		return err
+	}
+
+	schemas, err := e.client.ListSchemas(e.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for i := range schemas {
+		var model SchemaResourceModel
+		if err := e.newModel(t, &model); err != nil {
+			return err
+		}
+		var diags diag.Diagnostics
+		mapSchemaToModel(e.ctx, &schemas[i], &model, &diags)
+		if err := e.report(diags); err != nil {
+			return err
+		}
+
+		if _, _, err := e.add(t, schemas[i].ID, schemas[i].ID, &model); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *exporter) exportCustomEntities() error {
+	typeType, err := e.resourceType(NewCustomEntityTypeResource(), "custom_entities.tf")
+	if err != nil {
+		return err
+	}
+	instanceType, err := e.resourceType(NewCustomEntityInstanceResource(), "custom_entities.tf")
+	if err != nil {
+		return err
+	}
+
+	entityTypes, err := e.client.ListCustomEntityTypes(e.ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for i := range entityTypes {
+		entityType := &entityTypes[i]
+
+		var model CustomEntityTypeResourceModel
+		if err := e.newModel(typeType, &model); err != nil {
+			return err
+		}
+		var diags diag.Diagnostics
+		mapCustomEntityTypeToModel(e.ctx, entityType, &model, &diags)
+		if err := e.report(diags); err != nil {
+			return err
+		}
+
+		if _, _, err := e.add(typeType, entityType.ID, entityType.ID, &model); err != nil {
+			return err
+		}
+
+		instances, err := e.client.ListCustomEntityInstances(e.ctx, entityType.ID, nil)
+		if err != nil {
+			return err
+		}
+
+		for j := range instances {
+			var instance CustomEntityInstanceResourceModel
+			if err := e.newModel(instanceType, &instance); err != nil {
+				return err
+			}
+			var instanceDiags diag.Diagnostics
+			mapCustomEntityInstanceToModel(e.ctx, &instances[j], entityType.ID, &instance, &instanceDiags)
+			if err := e.report(instanceDiags); err != nil {
+				return err
+			}
+
+			importID := fmt.Sprintf("%s:%s", entityType.ID, instances[j].ID)
+			if _, _, err := e.add(instanceType, entityType.ID+"_"+instances[j].ID, importID, &instance); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (e *exporter) exportTenantConfigurations() error {
+	t, err := e.resourceType(NewTenantConfigurationResource(), "tenant_configurations.tf")
+	if err != nil {
+		return err
+	}
+
+	configurations, err := e.client.ListTenantConfigurations(e.ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range configurations {
+		// The languages are exported as emporix_language resources
+		if configurations[i].Key == languagesConfigurationKey {
+			continue
+		}
+
+		var model TenantConfigurationResourceModel
+		if err := e.newModel(t, &model); err != nil {
+			return err
+		}
+		var diags diag.Diagnostics
+		mapTenantConfigurationToModel(&configurations[i], &model, &diags)
+		if err := e.report(diags); err != nil {
+			return err
+		}
+
+		// Secured values are never written in plaintext: they become variables like webhook secrets
+		secured := configurations[i].Secured
+		if secured {
+			model.Value = types.StringNull()
+		}
+
+		name, body, err := e.add(t, configurations[i].Key, configurations[i].Key, &model)
+		if err != nil {
+			return err
+		}
+
+		if secured {
+			variable := name + "_value"
+			body.SetAttributeTraversal("value", hcl.Traversal{
+				hcl.TraverseRoot{Name: "var"},
+				hcl.TraverseAttr{Name: variable},
+			})
+
+			block := hclwrite.NewBlock("variable", []string{variable})
+			block.Body().SetAttributeTraversal("type", hcl.Traversal{hcl.TraverseRoot{Name: "string"}})
+			block.Body().SetAttributeValue("description", cty.StringVal(fmt.Sprintf("JSON value of secured tenant configuration %q", configurations[i].Key)))
+			block.Body().SetAttributeValue("sensitive", cty.True)
+			e.appendBlock("variables.tf", block)
+		}
+	}
+	return nil
+}
+
+func (e *exporter) exportWebhooks() error {
+	t, err := e.resourceType(NewWebhookResource(), "webhooks.tf")
+	if err != nil {
+		return err
+	}
+
+	webhooks, err := e.client.ListWebhooks(e.ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range webhooks {
+		model := webhookToModel(&webhooks[i])
+		var diags diag.Diagnostics
+		refreshEventSubscriptions(e.ctx, e.client, &model, &diags)
+		if err := e.report(diags); err != nil {
+			return err
+		}
+
+		name, body, err := e.add(t, webhooks[i].Code, webhooks[i].Code, &model)
+		if err != nil {
+			return err
+		}
+
+		// The API never returns secrets, so they become variables for the user to fill in
+		if model.SecretKey.ValueBool() {
+			variable := name + "_secret_key"
+			body.SetAttributeTraversal("secret_key", hcl.Traversal{
+				hcl.TraverseRoot{Name: "var"},
+				hcl.TraverseAttr{Name: variable},
+			})
+
+			block := hclwrite.NewBlock("variable", []string{variable})
+			block.Body().SetAttributeTraversal("type", hcl.Traversal{hcl.TraverseRoot{Name: "string"}})
+			block.Body().SetAttributeValue("description", cty.StringVal(fmt.Sprintf("Secret key of webhook %q", webhooks[i].Code)))
+			block.Body().SetAttributeValue("sensitive", cty.True)
+			e.appendBlock("variables.tf", block)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// resourceSchema returns the type name and schema of a resource as registered by the provider
+func resourceSchema(ctx context.Context, r resource.Resource) (string, schema.Schema, diag.Diagnostics) {
+	var metadata resource.MetadataResponse
+	r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "emporix"}, &metadata)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	return metadata.TypeName, schemaResp.Schema, schemaResp.Diagnostics
+}
+
+// nullModel sets every attribute of target, a resource model, to a null value of the
+// right type, like a config that sets nothing. The mapping functions leave attributes
+// the API doesn't return untouched, and zero values can't be written to state.
+func nullModel(ctx context.Context, s schema.Schema, target interface{}) diag.Diagnostics {
+	state := tfsdk.State{Schema: s, Raw: nullObjectValue(ctx, s.Type())}
+	return state.Get(ctx, target)
+}
+
+// nullObjectValue returns a value of a schema's object type with every attribute null
+func nullObjectValue(ctx context.Context, schemaType attr.Type) tftypes.Value {
+	objectType := schemaType.TerraformType(ctx).(tftypes.Object)
+
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	return tftypes.NewValue(objectType, attributes)
+}
+
+// writeResourceBody writes the configurable attributes of model into body. Computed-only
+// and null attributes are left out, so the result is what a user would have written.
+func writeResourceBody(ctx context.Context, body *hclwrite.Body, s schema.Schema, model interface{}) diag.Diagnostics {
+	state := tfsdk.State{Schema: s}
+	diags := state.Set(ctx, model)
+	if diags.HasError() {
+		return diags
+	}
+
+	values := map[string]tftypes.Value{}
+	if err := state.Raw.As(&values); err != nil {
+		diags.AddError("Export Error", fmt.Sprintf("Unable to read resource state: %s", err))
+		return diags
+	}
+
+	for _, name := range sortedAttributeNames(s.Attributes) {
+		value := values[name]
+		if !isConfigurable(s.Attributes[name]) || value.IsNull() || !value.IsKnown() {
+			continue
+		}
+
+		tokens, err := attributeTokens(s.Attributes[name], value)
+		if err != nil {
+			diags.AddAttributeError(path.Root(name), "Export Error", err.Error())
+			continue
+		}
+		body.SetAttributeRaw(name, tokens)
+	}
+
+	return diags
+}
+
+func isConfigurable(attribute schema.Attribute) bool {
+	return attribute.IsRequired() || attribute.IsOptional()
+}
+
+func sortedAttributeNames(attributes map[string]schema.Attribute) []string {
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// attributeTokens renders a value, recursing into nested attributes so their
+// computed-only attributes are left out as well
+func attributeTokens(attribute schema.Attribute, value tftypes.Value) (hclwrite.Tokens, error) {
+	switch a := attribute.(type) {
+	case schema.SingleNestedAttribute:
+		return nestedObjectTokens(a.Attributes, value)
+	case schema.ListNestedAttribute:
+		return nestedCollectionTokens(a.NestedObject.Attributes, value)
+	case schema.SetNestedAttribute:
+		return nestedCollectionTokens(a.NestedObject.Attributes, value)
+	case schema.MapNestedAttribute:
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+		var items []hclwrite.ObjectAttrTokens
+		for _, key := range sortedValueKeys(elements) {
+			tokens, err := nestedObjectTokens(a.NestedObject.Attributes, elements[key])
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, hclwrite.ObjectAttrTokens{
+				Name:  hclwrite.TokensForValue(cty.StringVal(key)),
+				Value: tokens,
+			})
+		}
+		return hclwrite.TokensForObject(items), nil
+	}
+	return valueTokens(value)
+}
+
+func nestedCollectionTokens(attributes map[string]schema.Attribute, value tftypes.Value) (hclwrite.Tokens, error) {
+	var elements []tftypes.Value
+	if err := value.As(&elements); err != nil {
+		return nil, err
+	}
+
+	items := make([]hclwrite.Tokens, 0, len(elements))
+	for _, element := range elements {
+		tokens, err := nestedObjectTokens(attributes, element)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, tokens)
+	}
+	return hclwrite.TokensForTuple(items), nil
+}
+
+func nestedObjectTokens(attributes map[string]schema.Attribute, value tftypes.Value) (hclwrite.Tokens, error) {
+	if value.IsNull() {
+		return hclwrite.TokensForValue(cty.NullVal(cty.DynamicPseudoType)), nil
+	}
+
+	var values map[string]tftypes.Value
+	if err := value.As(&values); err != nil {
+		return nil, err
+	}
+
+	var items []hclwrite.ObjectAttrTokens
+	for _, name := range sortedAttributeNames(attributes) {
+		attributeValue := values[name]
+		if !isConfigurable(attributes[name]) || attributeValue.IsNull() || !attributeValue.IsKnown() {
+			continue
+		}
+
+		tokens, err := attributeTokens(attributes[name], attributeValue)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		items = append(items, hclwrite.ObjectAttrTokens{
+			Name:  hclwrite.TokensForIdentifier(name),
+			Value: tokens,
+		})
+	}
+	return hclwrite.TokensForObject(items), nil
+}
+
+// valueTokens renders a primitive, collection or object value as an HCL expression
+func valueTokens(value tftypes.Value) (hclwrite.Tokens, error) {
+	if value.IsNull() {
+		return hclwrite.TokensForValue(cty.NullVal(cty.DynamicPseudoType)), nil
+	}
+
+	valueType := value.Type()
+	switch {
+	case valueType.Is(tftypes.String):
+		var s string
+		if err := value.As(&s); err != nil {
+			return nil, err
+		}
+		return hclwrite.TokensForValue(cty.StringVal(s)), nil
+	case valueType.Is(tftypes.Bool):
+		var b bool
+		if err := value.As(&b); err != nil {
+			return nil, err
+		}
+		return hclwrite.TokensForValue(cty.BoolVal(b)), nil
+	case valueType.Is(tftypes.Number):
+		n := new(big.Float)
+		if err := value.As(&n); err != nil {
+			return nil, err
+		}
+		return hclwrite.TokensForValue(cty.NumberVal(n)), nil
+	case valueType.Is(tftypes.List{}), valueType.Is(tftypes.Set{}), valueType.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+		items := make([]hclwrite.Tokens, 0, len(elements))
+		for _, element := range elements {
+			tokens, err := valueTokens(element)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, tokens)
+		}
+		return hclwrite.TokensForTuple(items), nil
+	case valueType.Is(tftypes.Map{}), valueType.Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+		var items []hclwrite.ObjectAttrTokens
+		for _, key := range sortedValueKeys(elements) {
+			if elements[key].IsNull() {
+				continue
+			}
+			tokens, err := valueTokens(elements[key])
+			if err != nil {
+				return nil, err
+			}
+			name := hclwrite.TokensForValue(cty.StringVal(key))
+			if valueType.Is(tftypes.Object{}) {
+				name = hclwrite.TokensForIdentifier(key)
+			}
+			items = append(items, hclwrite.ObjectAttrTokens{Name: name, Value: tokens})
+		}
+		return hclwrite.TokensForObject(items), nil
+	}
+
+	return nil, fmt.Errorf("unsupported value type %s", valueType)
+}
+
+func sortedValueKeys(values map[string]tftypes.Value) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestExport_FakeTenant(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newFakeAPIClient(t)
+
+	if _, err := client.CreateLanguage(ctx, &Language{Code: "en", Label: "English", Default: true, Required: true}); err != nil {
+		t.Fatalf("unexpected error creating language: %s", err)
+	}
+	site := &SiteSettings{
+		Code:            "main",
+		Name:            "Main Site",
+		Active:          true,
+		DefaultLanguage: "en",
+		Languages:       []string{"en"},
+		Currency:        "EUR",
+	}
+	if err := client.CreateSite(ctx, site); err != nil {
+		t.Fatalf("unexpected error creating site: %s", err)
+	}
+	active := true
+	if _, err := client.UpdateCountry(ctx, "DE", &CountryUpdate{Active: &active}); err != nil {
+		t.Fatalf("unexpected error activating country: %s", err)
+	}
+	zone := &ShippingZone{ID: "eu-zone", Name: map[string]string{"en": "EU"}, ShipTo: []ShippingDestination{{Country: "DE"}}}
+	if _, err := client.CreateShippingZone(ctx, "main", zone); err != nil {
+		t.Fatalf("unexpected error creating zone: %s", err)
+	}
+	method := &ShippingMethod{ID: "standard", Name: map[string]string{"en": "Standard"}, Active: true, Fees: []ShippingFee{}}
+	if _, err := client.CreateShippingMethod(ctx, "main", "eu-zone", method); err != nil {
+		t.Fatalf("unexpected error creating method: %s", err)
+	}
+	if _, err := client.CreateCustomEntityType(ctx, &CustomEntityTypeCreate{ID: "BRAND", Name: map[string]string{"en": "Brand"}}); err != nil {
+		t.Fatalf("unexpected error creating custom entity type: %s", err)
+	}
+	if _, err := client.CreateCustomEntityInstance(ctx, "BRAND", &CustomEntityInstanceCreate{ID: "acme", Name: map[string]string{"en": "Acme"}}); err != nil {
+		t.Fatalf("unexpected error creating custom entity: %s", err)
+	}
+	shoes, err := client.CreateCategory(ctx, &Category{Name: map[string]string{"en": "Shoes"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating category: %s", err)
+	}
+	sneakers, err := client.CreateCategory(ctx, &Category{Name: map[string]string{"en": "Sneakers"}, ParentID: shoes.ID})
+	if err != nil {
+		t.Fatalf("unexpected error creating subcategory: %s", err)
+	}
+	b2b, err := client.CreatePriceList(ctx, &PriceList{Name: map[string]string{"en": "B2B"}, Currency: "EUR", SiteCode: "main"})
+	if err != nil {
+		t.Fatalf("unexpected error creating price list: %s", err)
+	}
+	orderManagers, err := client.CreateIAMGroup(ctx, &IAMGroup{Name: map[string]string{"en": "Order Managers"}, UserType: "EMPLOYEE"})
+	if err != nil {
+		t.Fatalf("unexpected error creating IAM group: %s", err)
+	}
+	vip, err := client.CreateCustomerSegment(ctx, &CustomerSegment{Name: map[string]string{"en": "VIP"}, SiteCode: "main"})
+	if err != nil {
+		t.Fatalf("unexpected error creating customer segment: %s", err)
+	}
+	warehouse, err := client.CreateLocation(ctx, &PhysicalLocation{
+		Name:         "Warehouse",
+		Type:         "WAREHOUSE",
+		Active:       true,
+		Address:      &Address{ZipCode: "10115", City: "Berlin", Country: "DE"},
+		OpeningHours: []OpeningHours{{Weekday: "MONDAY", TimeRanges: []TimeRange{{TimeFrom: "08:00", TimeTo: "16:00"}}}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating location: %s", err)
+	}
+	orderNumbers, err := client.CreateSequentialIDSchema(ctx, &SequentialIDSchema{Name: "Order Numbers", SchemaType: "order", PreText: "ORD-", CounterStart: 1, MaxValue: 99999})
+	if err != nil {
+		t.Fatalf("unexpected error creating sequential ID schema: %s", err)
+	}
+	if _, err := client.CreateCoupon(ctx, &Coupon{Code: "WELCOME10", Name: "Welcome", DiscountType: "PERCENT", DiscountPercentage: 10}); err != nil {
+		t.Fatalf("unexpected error creating coupon: %s", err)
+	}
+	if _, err := client.UpdateRewardPointsConfig(ctx, &RewardPointsConfig{Active: true, EarnRate: 1, RedemptionRate: 100}); err != nil {
+		t.Fatalf("unexpected error updating reward points config: %s", err)
+	}
+	if _, err := client.CreateTenantConfiguration(ctx, &TenantConfigurationCreate{Key: "project_country", Value: "DE"}); err != nil {
+		t.Fatalf("unexpected error creating configuration: %s", err)
+	}
+	if _, err := client.CreateTenantConfiguration(ctx, &TenantConfigurationCreate{Key: "payment_api_key", Value: "s3cr3t-api-key", Secured: true}); err != nil {
+		t.Fatalf("unexpected error creating secured configuration: %s", err)
+	}
+	if _, err := client.CreateWebhook(ctx, &webhookCreateRequest{
+		Code:     "orders-hook",
+		Active:   true,
+		Provider: "HTTP",
+		Configuration: &NestedConfigCreate{
+			DestinationUrl: "https://example.com/hook",
+			SecretKey:      "s3cr3t",
+		},
+	}); err != nil {
+		t.Fatalf("unexpected error creating webhook: %s", err)
+	}
+
+	dir := t.TempDir()
+	var log bytes.Buffer
+	if err := exportTenant(ctx, client, ExportOptions{OutputDir: dir, Log: &log}); err != nil {
+		t.Fatalf("unexpected error exporting: %s", err)
+	}
+
+	parser := hclparse.NewParser()
+	files := map[string]*hcl.File{}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, entry := range entries {
+		file, diags := parser.ParseHCLFile(filepath.Join(dir, entry.Name()))
+		if diags.HasErrors() {
+			t.Fatalf("generated %s is not valid HCL: %s", entry.Name(), diags)
+		}
+		files[entry.Name()] = file
+	}
+
+	// Every resource block has a matching import block
+	imports := map[string]string{}
+	importBlocks := blocksOfType(t, files["imports.tf"], "import")
+	for _, block := range importBlocks {
+		attributes, _ := block.Body.JustAttributes()
+		traversal, diags := hcl.AbsTraversalForExpr(attributes["to"].Expr)
+		if diags.HasErrors() {
+			t.Fatalf("import target is not a reference: %s", diags)
+		}
+		to := traversal.RootName() + "." + traversal[1].(hcl.TraverseAttr).Name
+		id, _ := attributes["id"].Expr.Value(nil)
+		imports[to] = id.AsString()
+	}
+
+	expected := map[string]string{
+		"emporix_language.en":                           "en",
+		"emporix_sitesettings.main":                     "main",
+		"emporix_country.de":                            "DE",
+		"emporix_shipping_zone.main_eu_zone":            "main:eu-zone",
+		"emporix_shipping_method.main_eu_zone_standard": "main:eu-zone:standard",
+		"emporix_custom_entity_type.brand":              "BRAND",
+		"emporix_custom_entity_instance.brand_acme":     "BRAND:acme",
+		"emporix_tenant_configuration.project_country":  "project_country",
+		"emporix_tenant_configuration.payment_api_key":  "payment_api_key",
+		"emporix_webhook.orders_hook":                   "orders-hook",
+		"emporix_category.shoes":                        shoes.ID,
+		"emporix_category.sneakers":                     sneakers.ID,
+		"emporix_price_list.b2b":                        b2b.ID,
+		"emporix_iam_group.order_managers":              orderManagers.ID,
+		"emporix_customer_segment.vip":                  vip.ID,
+		"emporix_location.warehouse":                    warehouse.ID,
+		"emporix_sequential_id_schema.order_numbers":    orderNumbers.ID,
+		"emporix_coupon.welcome10":                      "WELCOME10",
+		"emporix_reward_points_config.reward_points":    strings.ToLower(client.Tenant),
+	}
+	for to, id := range expected {
+		if imports[to] != id {
+			t.Errorf("expected import of %s with ID %q, got %q", to, id, imports[to])
+		}
+	}
+
+	resources := 0
+	for name, file := range files {
+		for _, block := range blocksOfType(t, file, "resource") {
+			resources++
+			to := block.Labels[0] + "." + block.Labels[1]
+			if _, ok := imports[to]; !ok {
+				t.Errorf("resource %s in %s has no import block", to, name)
+			}
+		}
+	}
+	if resources != len(importBlocks) {
+		t.Errorf("expected %d resources for %d import blocks", resources, len(importBlocks))
+	}
+
+	// Computed-only attributes are left out and secrets become variables
+	webhooks := blocksOfType(t, files["webhooks.tf"], "resource")
+	if len(webhooks) != 1 {
+		t.Fatalf("expected one webhook, got %d", len(webhooks))
+	}
+	attributes, _ := webhooks[0].Body.JustAttributes()
+	if _, ok := attributes["version"]; ok {
+		t.Error("expected the computed version to be left out")
+	}
+	if traversal, diags := hcl.AbsTraversalForExpr(attributes["secret_key"].Expr); diags.HasErrors() || traversal.RootName() != "var" {
+		t.Errorf("expected secret_key to reference a variable")
+	}
+	if len(blocksOfType(t, files["variables.tf"], "variable")) != 2 {
+		t.Error("expected variables for the webhook secret and the secured configuration")
+	}
+
+	// Secured configuration values are never written in plaintext
+	for name, file := range files {
+		if bytes.Contains(file.Bytes, []byte("s3cr3t-api-key")) {
+			t.Errorf("expected the secured configuration value to be left out of %s", name)
+		}
+	}
+	for _, block := range blocksOfType(t, files["tenant_configurations.tf"], "resource") {
+		attributes, _ := block.Body.JustAttributes()
+		traversal, diags := hcl.AbsTraversalForExpr(attributes["value"].Expr)
+		isVariable := !diags.HasErrors() && traversal.RootName() == "var"
+		if expected := block.Labels[1] == "payment_api_key"; isVariable != expected {
+			t.Errorf("expected value of %s to reference a variable: %t", block.Labels[1], expected)
+		}
+	}
+
+	zones := blocksOfType(t, files["shipping.tf"], "resource")
+	attributes, _ = zones[0].Body.JustAttributes()
+	name, _ := attributes["name"].Expr.Value(nil)
+	if !name.Type().IsObjectType() || !name.GetAttr("en").RawEquals(cty.StringVal("EU")) {
+		t.Errorf("expected the zone name map to be exported, got %#v", name)
+	}
+
+	// Subcategories refer to their exported parent
+	for _, block := range blocksOfType(t, files["categories.tf"], "resource") {
+		if block.Labels[1] != "sneakers" {
+			continue
+		}
+		attributes, _ := block.Body.JustAttributes()
+		traversal, diags := hcl.AbsTraversalForExpr(attributes["parent_id"].Expr)
+		if diags.HasErrors() || traversal.RootName() != "emporix_category" || traversal[1].(hcl.TraverseAttr).Name != "shoes" {
+			t.Errorf("expected parent_id to reference emporix_category.shoes")
+		}
+	}
+
+	if !strings.Contains(log.String(), "Exported 19 resources") {
+		t.Errorf("expected a summary of 19 resources, got %q", log.String())
+	}
+}
+
+func TestExportResourceName(t *testing.T) {
+	e := &exporter{names: map[string]map[string]bool{}}
+
+	cases := []struct {
+		hint     string
+		expected string
+	}{
+		{"Main", "main"},
+		{"main", "main_2"},
+		{"eu-zone:standard", "eu_zone_standard"},
+		{"2day", "_2day"},
+		{"--", "this"},
+	}
+	for _, c := range cases {
+		if got := e.resourceName("emporix_sitesettings", c.hint); got != c.expected {
+			t.Errorf("resourceName(%q) = %q, expected %q", c.hint, got, c.expected)
+		}
+	}
+
+	if got := e.resourceName("emporix_country", "main"); got != "main" {
+		t.Errorf("expected names to be unique per resource type, got %q", got)
+	}
+}
+
+func blocksOfType(t *testing.T, file *hcl.File, blockType string) []*hcl.Block {
+	t.Helper()
+
+	if file == nil {
+		return nil
+	}
+	content, _, diags := file.Body.PartialContent(&hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{{Type: blockType, LabelNames: labelNames(blockType)}},
+	})
+	if diags.HasErrors() {
+		t.Fatalf("unable to read %s blocks: %s", blockType, diags)
+	}
+	return content.Blocks
+}
+
+func labelNames(blockType string) []string {
+	switch blockType {
+	case "resource":
+		return []string{"type", "name"}
+	case "variable":
+		return []string{"name"}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &LocalizedFunction{}
+
+func NewLocalizedFunction() function.Function {
+	return &LocalizedFunction{}
+}
+
+// LocalizedFunction expands a string to a localized map, e.g. for `name` attributes.
+//
+// Functions don't receive the provider configuration, so the tenant's languages are passed as arguments.
+type LocalizedFunction struct{}
+
+func (f *LocalizedFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "localized"
+}
+
+func (f *LocalizedFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Expands a string to a localized map",
+		MarkdownDescription: "Returns a localized map (e.g. `{ en = \"Shoes\", de = \"Schuhe\" }`) with an entry for every language, " +
+			"taking the text from `overrides` where present and `default` otherwise. " +
+			"The languages are the `languages` arguments plus the keys of `overrides`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "default",
+				MarkdownDescription: "Text for every language without an override.",
+			},
+			function.MapParameter{
+				Name:                "overrides",
+				MarkdownDescription: "Text per language code for languages that differ from `default`. Use `{}` for none.",
+				ElementType:         types.StringType,
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:                "languages",
+			MarkdownDescription: "Language codes the map must contain, e.g. `\"en\", \"de\"` or `local.languages...`.",
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *LocalizedFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var defaultText string
+	var overrides map[string]string
+	var languages []string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &defaultText, &overrides, &languages))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := expandLocalized(defaultText, overrides, languages)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, err)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// expandLocalized builds the localized map returned by provider::emporix::localized
+func expandLocalized(defaultText string, overrides map[string]string, languages []string) (map[string]string, *function.FuncError) {
+	result := make(map[string]string, len(languages)+len(overrides))
+	for i, language := range languages {
+		if language == "" {
+			// The variadic languages share the argument position after overrides
+			return nil, function.NewArgumentFuncError(2, fmt.Sprintf("language %d is empty", i+1))
+		}
+		result[language] = defaultText
+	}
+	for language, text := range overrides {
+		if language == "" {
+			return nil, function.NewArgumentFuncError(1, "overrides contains an empty language code")
+		}
+		result[language] = text
+	}
+
+	if len(result) == 0 {
+		return nil, function.NewFuncError("no languages given: pass at least one language or override")
+	}
+	return result, nil
+}
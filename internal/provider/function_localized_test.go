@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// runFunction calls a provider function the way Terraform does and returns its result
+func runFunction(t *testing.T, f function.Function, arguments ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+	ctx := context.Background()
+
+	var definitionResp function.DefinitionResponse
+	f.Definition(ctx, function.DefinitionRequest{}, &definitionResp)
+	if definitionResp.Diagnostics.HasError() {
+		t.Fatalf("definition returned errors: %v", definitionResp.Diagnostics)
+	}
+
+	result, err := definitionResp.Definition.Return.NewResultData(ctx)
+	if err != nil {
+		t.Fatalf("unable to create result data: %s", err)
+	}
+	resp := function.RunResponse{Result: result}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(arguments)}, &resp)
+	return resp.Result.Value(), resp.Error
+}
+
+func stringTuple(values ...string) types.Tuple {
+	elementTypes := make([]attr.Type, len(values))
+	elements := make([]attr.Value, len(values))
+	for i, value := range values {
+		elementTypes[i] = types.StringType
+		elements[i] = types.StringValue(value)
+	}
+	return types.TupleValueMust(elementTypes, elements)
+}
+
+func TestLocalizedFunction(t *testing.T) {
+	overrides := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"de": types.StringValue("Schuhe"),
+	})
+
+	result, err := runFunction(t, NewLocalizedFunction(), types.StringValue("Shoes"), overrides, stringTuple("en", "de", "fr"))
+	if err != nil {
+		t.Fatalf("function returned an error: %s", err)
+	}
+
+	expected := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"en": types.StringValue("Shoes"),
+		"de": types.StringValue("Schuhe"),
+		"fr": types.StringValue("Shoes"),
+	})
+	if !result.Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+}
+
+func TestLocalizedFunction_OverridesAddLanguages(t *testing.T) {
+	overrides := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"de": types.StringValue("Schuhe"),
+	})
+
+	result, err := runFunction(t, NewLocalizedFunction(), types.StringValue("Shoes"), overrides, stringTuple("en"))
+	if err != nil {
+		t.Fatalf("function returned an error: %s", err)
+	}
+
+	expected := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"en": types.StringValue("Shoes"),
+		"de": types.StringValue("Schuhe"),
+	})
+	if !result.Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+}
+
+func TestLocalizedFunction_NoLanguages(t *testing.T) {
+	overrides := types.MapValueMust(types.StringType, map[string]attr.Value{})
+
+	if _, err := runFunction(t, NewLocalizedFunction(), types.StringValue("Shoes"), overrides, stringTuple()); err == nil {
+		t.Fatal("expected an error without languages")
+	}
+}
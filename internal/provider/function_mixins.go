@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ function.Function = &MixinsFunction{}
+
+func NewMixinsFunction() function.Function {
+	return &MixinsFunction{}
+}
+
+// MixinsFunction builds the JSON of emporix_custom_entity_instance.mixins.
+type MixinsFunction struct{}
+
+func (f *MixinsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "mixins"
+}
+
+func (f *MixinsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds mixins JSON nested under a schema ID",
+		MarkdownDescription: "Returns `object` as JSON nested under the top-level key `schema_id`, " +
+			"the format `emporix_custom_entity_instance.mixins` expects, e.g. `{\"invoice-fields\":{\"amount\":199.9}}`. " +
+			"Combine several schemas with `jsonencode(merge(jsondecode(...), ...))`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "schema_id",
+				MarkdownDescription: "`id` of the `emporix_schema` that declares the fields.",
+			},
+			function.DynamicParameter{
+				Name:                "object",
+				MarkdownDescription: "Field values, keyed by the schema's attribute keys.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *MixinsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var schemaID string
+	var object types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &schemaID, &object))
+	if resp.Error != nil {
+		return
+	}
+
+	if schemaID == "" {
+		resp.Error = function.NewArgumentFuncError(0, "schema_id must not be empty")
+		return
+	}
+	if object.IsNull() || object.IsUnderlyingValueNull() {
+		resp.Error = function.NewArgumentFuncError(1, "object must not be null")
+		return
+	}
+
+	value, err := object.UnderlyingValue().ToTerraformValue(ctx)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("unable to read object: %s", err))
+		return
+	}
+	if !value.Type().Is(tftypes.Object{}) && !value.Type().Is(tftypes.Map{}) {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("object must be an object or map, got %s", value.Type()))
+		return
+	}
+
+	fields, err := terraformValueToJSON(value)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	mixinsJSON, err := json.Marshal(map[string]interface{}{schemaID: fields})
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("unable to marshal mixins to JSON: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, string(mixinsJSON)))
+}
+
+// terraformValueToJSON converts a Terraform value to the Go value jsonencode would produce for it
+func terraformValueToJSON(value tftypes.Value) (interface{}, error) {
+	if !value.IsKnown() {
+		return nil, fmt.Errorf("value is not known yet")
+	}
+	if value.IsNull() {
+		return nil, nil
+	}
+
+	switch {
+	case value.Type().Is(tftypes.String):
+		var s string
+		err := value.As(&s)
+		return s, err
+	case value.Type().Is(tftypes.Bool):
+		var b bool
+		err := value.As(&b)
+		return b, err
+	case value.Type().Is(tftypes.Number):
+		var n big.Float
+		if err := value.As(&n); err != nil {
+			return nil, err
+		}
+		return json.Number(n.Text('g', -1)), nil
+	case value.Type().Is(tftypes.List{}), value.Type().Is(tftypes.Set{}), value.Type().Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+		result := make([]interface{}, len(elements))
+		for i, element := range elements {
+			converted, err := terraformValueToJSON(element)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = converted
+		}
+		return result, nil
+	case value.Type().Is(tftypes.Map{}), value.Type().Is(tftypes.Object{}):
+		var attributes map[string]tftypes.Value
+		if err := value.As(&attributes); err != nil {
+			return nil, err
+		}
+		result := make(map[string]interface{}, len(attributes))
+		for key, attribute := range attributes {
+			converted, err := terraformValueToJSON(attribute)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+			result[key] = converted
+		}
+		return result, nil
+	}
+
+	return nil, fmt.Errorf("unsupported value type %s", value.Type())
+}
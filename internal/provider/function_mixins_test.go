@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMixinsFunction(t *testing.T) {
+	fields := types.ObjectValueMust(
+		map[string]attr.Type{
+			"invoiceNumber": types.StringType,
+			"amount":        types.NumberType,
+			"paid":          types.BoolType,
+			"tags":          types.TupleType{ElemTypes: []attr.Type{types.StringType}},
+			"note":          types.StringType,
+		},
+		map[string]attr.Value{
+			"invoiceNumber": types.StringValue("1042"),
+			"amount":        types.NumberValue(big.NewFloat(199.9)),
+			"paid":          types.BoolValue(true),
+			"tags":          types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("b2b")}),
+			"note":          types.StringNull(),
+		},
+	)
+
+	result, err := runFunction(t, NewMixinsFunction(), types.StringValue("invoice-fields"), types.DynamicValue(fields))
+	if err != nil {
+		t.Fatalf("function returned an error: %s", err)
+	}
+
+	expected := `{"invoice-fields":{"amount":199.9,"invoiceNumber":"1042","note":null,"paid":true,"tags":["b2b"]}}`
+	if got := result.(types.String).ValueString(); got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestMixinsFunction_RequiresObject(t *testing.T) {
+	if _, err := runFunction(t, NewMixinsFunction(), types.StringValue("invoice-fields"), types.DynamicValue(types.StringValue("1042"))); err == nil {
+		t.Fatal("expected an error for a string object")
+	}
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Resource identities mirror the attributes an object is imported by, using the same
+// attribute names as the resource, so they can be copied from and into state by name.
+
+// attributeGetter is implemented by tfsdk.State and tfsdk.Resource
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+}
+
+// setIdentityFromState copies the identity attributes of a resource from its state
+func setIdentityFromState(ctx context.Context, state attributeGetter, identity *tfsdk.ResourceIdentity) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if identity == nil {
+		return diags
+	}
+
+	for name := range identity.Schema.GetAttributes() {
+		var value types.String
+		diags.Append(state.GetAttribute(ctx, path.Root(name), &value)...)
+		diags.Append(identity.SetAttribute(ctx, path.Root(name), value)...)
+	}
+	return diags
+}
+
+// importState sets the given attributes of an imported resource, either from an import ID
+// joining their values with ":" or from the `identity` of an import block. Multi-part IDs
+// must have exactly one part per attribute; single-attribute IDs may contain ":".
+func importState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, attributes ...string) {
+	parts := []string{req.ID}
+	if len(attributes) > 1 {
+		parts = strings.Split(req.ID, ":")
+	}
+	importStateFromParts(ctx, req, resp, parts, attributes)
+}
+
+// importStateLastTakesRest is importState for resources whose last attribute may contain ":",
+// e.g. custom entity instance IDs. The last attribute takes the rest of the ID.
+func importStateLastTakesRest(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, attributes ...string) {
+	importStateFromParts(ctx, req, resp, strings.SplitN(req.ID, ":", len(attributes)), attributes)
+}
+
+// importStateFromParts sets the attributes from the parts of the import ID, or from the identity without an ID
+func importStateFromParts(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, parts []string, attributes []string) {
+	if req.ID == "" && req.Identity != nil {
+		for _, name := range attributes {
+			var value types.String
+			resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(name), &value)...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), value)...)
+		}
+		return
+	}
+
+	valid := len(parts) == len(attributes)
+	for _, part := range parts {
+		valid = valid && part != ""
+	}
+	if !valid {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in format '%s', got: %s", strings.Join(attributes, ":"), req.ID),
+		)
+		return
+	}
+
+	for i, name := range attributes {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), parts[i])...)
+	}
+}
+
+// listResultsFrom streams a list result per API object, stopping at the limit Terraform asked for.
+// toModel maps an object onto a model whose attributes start out null and returns its display name.
+func listResultsFrom[T any, M any](ctx context.Context, req list.ListRequest, items []T, toModel func(item *T, model *M, diags *diag.Diagnostics) string) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for i := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+
+			var model M
+			result.Resource.Raw = nullObjectValue(ctx, result.Resource.Schema.Type())
+			result.Diagnostics.Append(result.Resource.Get(ctx, &model)...)
+			if !result.Diagnostics.HasError() {
+				result.DisplayName = toModel(&items[i], &model, &result.Diagnostics)
+			}
+			if !result.Diagnostics.HasError() {
+				result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+				result.Diagnostics.Append(setIdentityFromState(ctx, result.Resource, result.Identity)...)
+			}
+			if !req.IncludeResource {
+				result.Resource = nil
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// listResourceClient returns the client passed to a list resource by the provider
+func listResourceClient(req resource.ConfigureRequest, resp *resource.ConfigureResponse) *EmporixClient {
+	if req.ProviderData == nil {
+		return nil
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T", req.ProviderData),
+		)
+		return nil
+	}
+	return client
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ list.ListResource = &CustomEntityInstanceListResource{}
+var _ list.ListResourceWithConfigure = &CustomEntityInstanceListResource{}
+
+func NewCustomEntityInstanceListResource() list.ListResource {
+	return &CustomEntityInstanceListResource{}
+}
+
+// CustomEntityInstanceListResource lists the instances of a custom entity type for `terraform query`.
+type CustomEntityInstanceListResource struct {
+	client *EmporixClient
+}
+
+// CustomEntityInstanceListResourceModel describes the list block arguments.
+type CustomEntityInstanceListResourceModel struct {
+	Type  types.String `tfsdk:"type"`
+	Query types.String `tfsdk:"q"`
+}
+
+func (l *CustomEntityInstanceListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_entity_instance"
+}
+
+func (l *CustomEntityInstanceListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the instances of a custom entity type.",
+
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "ID of the custom entity type (e.g., `DOCUMENT`).",
+				Required:            true,
+			},
+			"q": schema.StringAttribute{
+				MarkdownDescription: "Optional Emporix query expression to filter instances.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (l *CustomEntityInstanceListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	l.client = listResourceClient(req, resp)
+}
+
+func (l *CustomEntityInstanceListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data CustomEntityInstanceListResourceModel
+	var diags diag.Diagnostics
+
+	diags.Append(req.Config.Get(ctx, &data)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	entityType := data.Type.ValueString()
+	opts := &ListOptions{Query: data.Query.ValueString()}
+
+	tflog.Debug(ctx, "Listing custom entity instances", map[string]interface{}{
+		"type": entityType,
+		"q":    opts.Query,
+	})
+
+	instances, err := l.client.ListCustomEntityInstances(ctx, entityType, opts)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list custom entity instances, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = listResultsFrom(ctx, req, instances, func(instance *CustomEntityInstance, model *CustomEntityInstanceResourceModel, diags *diag.Diagnostics) string {
+		mapCustomEntityInstanceToModel(ctx, instance, entityType, model, diags)
+		return fmt.Sprintf("%s:%s", entityType, instance.ID)
+	})
+}
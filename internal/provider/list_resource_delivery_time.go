@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ list.ListResource = &DeliveryTimeListResource{}
+var _ list.ListResourceWithConfigure = &DeliveryTimeListResource{}
+
+func NewDeliveryTimeListResource() list.ListResource {
+	return &DeliveryTimeListResource{}
+}
+
+// DeliveryTimeListResource lists delivery times for `terraform query`.
+type DeliveryTimeListResource struct {
+	client *EmporixClient
+}
+
+// DeliveryTimeListResourceModel describes the list block arguments.
+type DeliveryTimeListResourceModel struct {
+	Site types.String `tfsdk:"site"`
+}
+
+func (l *DeliveryTimeListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_delivery_time"
+}
+
+func (l *DeliveryTimeListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the delivery times of the tenant, optionally only those of one site.",
+
+		Attributes: map[string]schema.Attribute{
+			"site": schema.StringAttribute{
+				MarkdownDescription: "Only return delivery times of this site.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (l *DeliveryTimeListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	l.client = listResourceClient(req, resp)
+}
+
+func (l *DeliveryTimeListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data DeliveryTimeListResourceModel
+	var diags diag.Diagnostics
+
+	diags.Append(req.Config.Get(ctx, &data)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "Listing delivery times", map[string]interface{}{
+		"site": data.Site.ValueString(),
+	})
+
+	deliveryTimes, err := l.client.ListDeliveryTimes(ctx, nil)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list delivery times, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var matching []DeliveryTime
+	for _, deliveryTime := range deliveryTimes {
+		if !data.Site.IsNull() && deliveryTime.SiteCode != data.Site.ValueString() {
+			continue
+		}
+		matching = append(matching, deliveryTime)
+	}
+
+	stream.Results = listResultsFrom(ctx, req, matching, func(deliveryTime *DeliveryTime, model *DeliveryTimeResourceModel, diags *diag.Diagnostics) string {
+		mapDeliveryTimeToModel(ctx, deliveryTime, model, diags)
+		return deliveryTime.Name
+	})
+}
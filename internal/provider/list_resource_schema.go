@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ list.ListResource = &SchemaListResource{}
+var _ list.ListResourceWithConfigure = &SchemaListResource{}
+
+func NewSchemaListResource() list.ListResource {
+	return &SchemaListResource{}
+}
+
+// SchemaListResource lists the schemas of the tenant for `terraform query`.
+type SchemaListResource struct {
+	client *EmporixClient
+}
+
+// SchemaListResourceModel describes the list block arguments.
+type SchemaListResourceModel struct {
+	Type types.String `tfsdk:"type"`
+}
+
+func (l *SchemaListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema"
+}
+
+func (l *SchemaListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the schemas of the tenant, optionally only those applying to one entity type.",
+
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only return schemas that apply to this entity type (e.g., `PRODUCT`, `CUSTOMER`).",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (l *SchemaListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	l.client = listResourceClient(req, resp)
+}
+
+func (l *SchemaListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data SchemaListResourceModel
+	var diags diag.Diagnostics
+
+	diags.Append(req.Config.Get(ctx, &data)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "Listing schemas", map[string]interface{}{
+		"type": data.Type.ValueString(),
+	})
+
+	schemas, err := l.client.ListSchemas(ctx, nil)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list schemas, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var matching []Schema
+	for _, s := range schemas {
+		if !data.Type.IsNull() && !slices.Contains(s.Types, data.Type.ValueString()) {
+			continue
+		}
+		matching = append(matching, s)
+	}
+
+	stream.Results = listResultsFrom(ctx, req, matching, func(s *Schema, model *SchemaResourceModel, diags *diag.Diagnostics) string {
+		mapSchemaToModel(ctx, s, model, diags)
+		return s.ID
+	})
+}
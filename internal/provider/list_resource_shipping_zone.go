@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ list.ListResource = &ShippingZoneListResource{}
+var _ list.ListResourceWithConfigure = &ShippingZoneListResource{}
+
+func NewShippingZoneListResource() list.ListResource {
+	return &ShippingZoneListResource{}
+}
+
+// ShippingZoneListResource lists the shipping zones of a site for `terraform query`.
+type ShippingZoneListResource struct {
+	client *EmporixClient
+}
+
+// ShippingZoneListResourceModel describes the list block arguments.
+type ShippingZoneListResourceModel struct {
+	Site  types.String `tfsdk:"site"`
+	Query types.String `tfsdk:"q"`
+}
+
+func (l *ShippingZoneListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_shipping_zone"
+}
+
+func (l *ShippingZoneListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the shipping zones of a site.",
+
+		Attributes: map[string]schema.Attribute{
+			"site": schema.StringAttribute{
+				MarkdownDescription: "Site identifier. Typically 'main' for single-shop tenants.",
+				Required:            true,
+			},
+			"q": schema.StringAttribute{
+				MarkdownDescription: "Optional Emporix query expression to filter zones (e.g., `default:true`).",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (l *ShippingZoneListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	l.client = listResourceClient(req, resp)
+}
+
+func (l *ShippingZoneListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data ShippingZoneListResourceModel
+	var diags diag.Diagnostics
+
+	diags.Append(req.Config.Get(ctx, &data)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	site := data.Site.ValueString()
+	opts := &ListOptions{Query: data.Query.ValueString()}
+
+	tflog.Debug(ctx, "Listing shipping zones", map[string]interface{}{
+		"site": site,
+		"q":    opts.Query,
+	})
+
+	zones, err := l.client.ListShippingZones(ctx, site, opts)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list shipping zones, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = listResultsFrom(ctx, req, zones, func(zone *ShippingZone, model *ShippingZoneResourceModel, diags *diag.Diagnostics) string {
+		mapShippingZoneToModel(ctx, zone, nil, model, diags)
+		model.Site = types.StringValue(site)
+		return fmt.Sprintf("%s:%s", site, zone.ID)
+	})
+}
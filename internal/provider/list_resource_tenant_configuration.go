@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ list.ListResource = &TenantConfigurationListResource{}
+var _ list.ListResourceWithConfigure = &TenantConfigurationListResource{}
+
+func NewTenantConfigurationListResource() list.ListResource {
+	return &TenantConfigurationListResource{}
+}
+
+// TenantConfigurationListResource lists the tenant configurations for `terraform query`.
+type TenantConfigurationListResource struct {
+	client *EmporixClient
+}
+
+func (l *TenantConfigurationListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tenant_configuration"
+}
+
+func (l *TenantConfigurationListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the configurations of the tenant. " +
+			"`project_lang` is left out, the tenant's languages are managed with `emporix_language`.",
+	}
+}
+
+func (l *TenantConfigurationListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	l.client = listResourceClient(req, resp)
+}
+
+func (l *TenantConfigurationListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Debug(ctx, "Listing tenant configurations")
+
+	configurations, err := l.client.ListTenantConfigurations(ctx)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list tenant configurations, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	// The languages are managed with emporix_language, see exportTenantConfigurations
+	unmanaged := configurations[:0]
+	for _, config := range configurations {
+		if config.Key != languagesConfigurationKey {
+			unmanaged = append(unmanaged, config)
+		}
+	}
+
+	stream.Results = listResultsFrom(ctx, req, unmanaged, func(config *TenantConfiguration, model *TenantConfigurationResourceModel, diags *diag.Diagnostics) string {
+		mapTenantConfigurationToModel(config, model, diags)
+		return config.Key
+	})
+}
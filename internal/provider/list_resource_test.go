@@ -0,0 +1,346 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TestProvider_SchemasAreValid runs the schema validation Terraform triggers on startup,
+// which checks identity schemas and that every list resource has a matching resource.
+// Every resource has an identity, so it can be imported with an `identity` block.
+func TestProvider_SchemasAreValid(t *testing.T) {
+	ctx := context.Background()
+	server := providerserver.NewProtocol6(New("test")())()
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range schemaResp.Diagnostics {
+		t.Errorf("provider schema diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+	for name := range schemaResp.ListResourceSchemas {
+		if _, ok := schemaResp.ResourceSchemas[name]; !ok {
+			t.Errorf("list resource %s has no matching resource", name)
+		}
+	}
+
+	identityResp, err := server.GetResourceIdentitySchemas(ctx, &tfprotov6.GetResourceIdentitySchemasRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range identityResp.Diagnostics {
+		t.Errorf("identity schema diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+	for name := range schemaResp.ResourceSchemas {
+		if _, ok := identityResp.IdentitySchemas[name]; !ok {
+			t.Errorf("resource %s has no identity schema", name)
+		}
+	}
+}
+
+// runListResource calls a list resource the way `terraform query` does and collects its results
+func runListResource(t *testing.T, client *EmporixClient, l list.ListResource, r resource.Resource, config map[string]tftypes.Value, includeResource bool, limit int64) []list.ListResult {
+	t.Helper()
+	ctx := context.Background()
+
+	var configureResp resource.ConfigureResponse
+	l.(list.ListResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: client}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("configure returned errors: %v", configureResp.Diagnostics)
+	}
+
+	var listSchemaResp list.ListResourceSchemaResponse
+	l.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &listSchemaResp)
+	listType := listSchemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range listType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range config {
+		values[name] = value
+	}
+
+	_, resourceSchema, diags := resourceSchema(ctx, r)
+	if diags.HasError() {
+		t.Fatalf("resource schema returned errors: %v", diags)
+	}
+	var identityResp resource.IdentitySchemaResponse
+	r.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+
+	req := list.ListRequest{
+		Config:                 tfsdk.Config{Schema: listSchemaResp.Schema, Raw: tftypes.NewValue(listType, values)},
+		IncludeResource:        includeResource,
+		Limit:                  limit,
+		ResourceSchema:         resourceSchema,
+		ResourceIdentitySchema: identityResp.IdentitySchema,
+	}
+	stream := &list.ListResultsStream{}
+	l.List(ctx, req, stream)
+
+	var results []list.ListResult
+	for result := range stream.Results {
+		if result.Diagnostics.HasError() {
+			t.Fatalf("list result has errors: %v", result.Diagnostics)
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+func identityAttribute(t *testing.T, result list.ListResult, name string) string {
+	t.Helper()
+
+	var value types.String
+	if diags := result.Identity.GetAttribute(context.Background(), path.Root(name), &value); diags.HasError() {
+		t.Fatalf("unable to read identity attribute %s: %v", name, diags)
+	}
+	return value.ValueString()
+}
+
+func TestListResource_ShippingZones(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newFakeAPIClient(t)
+
+	for _, site := range []string{"main", "other"} {
+		zone := &ShippingZone{ID: site + "-zone", Name: map[string]string{"en": site}, ShipTo: []ShippingDestination{{Country: "DE"}}}
+		if _, err := client.CreateShippingZone(ctx, site, zone); err != nil {
+			t.Fatalf("unexpected error creating zone: %s", err)
+		}
+	}
+
+	results := runListResource(t, client, NewShippingZoneListResource(), NewShippingZoneResource(), map[string]tftypes.Value{
+		"site": tftypes.NewValue(tftypes.String, "main"),
+	}, true, 0)
+
+	if len(results) != 1 {
+		t.Fatalf("expected 1 zone of site main, got %d", len(results))
+	}
+	if site, id := identityAttribute(t, results[0], "site"), identityAttribute(t, results[0], "id"); site != "main" || id != "main-zone" {
+		t.Errorf("expected identity main/main-zone, got %s/%s", site, id)
+	}
+	if results[0].DisplayName != "main:main-zone" {
+		t.Errorf("unexpected display name %q", results[0].DisplayName)
+	}
+
+	var model ShippingZoneResourceModel
+	if diags := results[0].Resource.Get(ctx, &model); diags.HasError() {
+		t.Fatalf("unable to read listed resource: %v", diags)
+	}
+	if model.Site.ValueString() != "main" || len(model.ShipTo.Elements()) != 1 {
+		t.Errorf("expected the full zone in the result, got %+v", model)
+	}
+}
+
+func TestListResource_WebhooksWithoutResource(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newFakeAPIClient(t)
+
+	for _, code := range []string{"first", "second", "third"} {
+		if _, err := client.CreateWebhook(ctx, &webhookCreateRequest{
+			Code:          code,
+			Active:        code != "third",
+			Provider:      "HTTP",
+			Configuration: &NestedConfigCreate{DestinationUrl: "https://example.com/" + code},
+		}); err != nil {
+			t.Fatalf("unexpected error creating webhook: %s", err)
+		}
+	}
+
+	results := runListResource(t, client, NewWebhookListResource(), NewWebhookResource(), map[string]tftypes.Value{
+		"active": tftypes.NewValue(tftypes.Bool, true),
+	}, false, 0)
+
+	if len(results) != 2 {
+		t.Fatalf("expected 2 active webhooks, got %d", len(results))
+	}
+	for _, result := range results {
+		if result.Resource != nil {
+			t.Errorf("expected no resource when it wasn't requested")
+		}
+		if code := identityAttribute(t, result, "code"); code != result.DisplayName {
+			t.Errorf("expected identity code %q to match display name", code)
+		}
+	}
+}
+
+func TestListResource_CustomEntityInstancesRespectLimit(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newFakeAPIClient(t)
+
+	if _, err := client.CreateCustomEntityType(ctx, &CustomEntityTypeCreate{ID: "BRAND", Name: map[string]string{"en": "Brand"}}); err != nil {
+		t.Fatalf("unexpected error creating custom entity type: %s", err)
+	}
+	for _, id := range []string{"a", "b", "c"} {
+		if _, err := client.CreateCustomEntityInstance(ctx, "BRAND", &CustomEntityInstanceCreate{ID: id, Name: map[string]string{"en": id}}); err != nil {
+			t.Fatalf("unexpected error creating custom entity: %s", err)
+		}
+	}
+
+	config := map[string]tftypes.Value{
+		"type": tftypes.NewValue(tftypes.String, "BRAND"),
+	}
+	results := runListResource(t, client, NewCustomEntityInstanceListResource(), NewCustomEntityInstanceResource(), config, true, 0)
+	if len(results) != 3 {
+		t.Fatalf("expected 3 instances, got %d", len(results))
+	}
+	if entityType := identityAttribute(t, results[0], "type"); entityType != "BRAND" {
+		t.Errorf("expected identity type BRAND, got %q", entityType)
+	}
+
+	// Terraform stops reading once it has the number of results it asked for
+	results = runListResource(t, client, NewCustomEntityInstanceListResource(), NewCustomEntityInstanceResource(), config, true, 2)
+	if len(results) != 2 {
+		t.Errorf("expected the limit of 2 results to be respected, got %d", len(results))
+	}
+}
+
+func TestListResource_TenantConfigurationsWithoutLanguages(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newFakeAPIClient(t)
+
+	for _, key := range []string{"project_country", languagesConfigurationKey} {
+		if _, err := client.CreateTenantConfiguration(ctx, &TenantConfigurationCreate{Key: key, Value: "[]"}); err != nil {
+			t.Fatalf("unexpected error creating %s: %s", key, err)
+		}
+	}
+
+	results := runListResource(t, client, NewTenantConfigurationListResource(), NewTenantConfigurationResource(), nil, false, 0)
+
+	// project_lang is managed with emporix_language
+	if len(results) != 1 {
+		t.Fatalf("expected 1 configuration, got %d", len(results))
+	}
+	if key := identityAttribute(t, results[0], "key"); key != "project_country" {
+		t.Errorf("expected identity key project_country, got %q", key)
+	}
+}
+
+func TestImportState_IDAndIdentity(t *testing.T) {
+	ctx := context.Background()
+	r := NewShippingZoneResource()
+
+	_, resourceSchema, _ := resourceSchema(ctx, r)
+	var identityResp resource.IdentitySchemaResponse
+	r.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+
+	importWith := func(req resource.ImportStateRequest) (*resource.ImportStateResponse, ShippingZoneResourceModel) {
+		resp := &resource.ImportStateResponse{
+			State: tfsdk.State{Schema: resourceSchema, Raw: nullObjectValue(ctx, resourceSchema.Type())},
+		}
+		r.(resource.ResourceWithImportState).ImportState(ctx, req, resp)
+
+		var model ShippingZoneResourceModel
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(resp.State.Get(ctx, &model)...)
+		}
+		return resp, model
+	}
+
+	resp, model := importWith(resource.ImportStateRequest{ID: "main:zone-express"})
+	if resp.Diagnostics.HasError() || model.Site.ValueString() != "main" || model.ID.ValueString() != "zone-express" {
+		t.Errorf("unexpected import by ID: %v %+v", resp.Diagnostics, model)
+	}
+
+	resp, _ = importWith(resource.ImportStateRequest{ID: "zone-express"})
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an error for an import ID without a site")
+	}
+
+	identity := &tfsdk.ResourceIdentity{
+		Schema: identityResp.IdentitySchema,
+		Raw:    nullObjectValue(ctx, identityResp.IdentitySchema.Type()),
+	}
+	identity.SetAttribute(ctx, path.Root("site"), "main")
+	identity.SetAttribute(ctx, path.Root("id"), "zone-express")
+
+	resp, model = importWith(resource.ImportStateRequest{Identity: identity})
+	if resp.Diagnostics.HasError() || model.Site.ValueString() != "main" || model.ID.ValueString() != "zone-express" {
+		t.Errorf("unexpected import by identity: %v %+v", resp.Diagnostics, model)
+	}
+}
+
+// Import IDs with too many ":" segments are rejected, unless the last attribute may contain ":"
+func TestImportState_IDSegments(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		resource resource.Resource
+		id       string
+		expected map[string]string
+	}{
+		"shipping method":                   {resource: NewShippingMethodResource(), id: "main:zone-us:standard", expected: map[string]string{"site": "main", "zone_id": "zone-us", "id": "standard"}},
+		"shipping method with extra part":   {resource: NewShippingMethodResource(), id: "main:zone-us:standard:extra"},
+		"shipping zone with extra part":     {resource: NewShippingZoneResource(), id: "main:zone-us:extra"},
+		"assignment with extra part":        {resource: NewCategoryAssignmentResource(), id: "category:product:extra"},
+		"single attribute with colon":       {resource: NewTenantConfigurationResource(), id: "custom:key", expected: map[string]string{"key": "custom:key"}},
+		"custom entity instance with colon": {resource: NewCustomEntityInstanceResource(), id: "DOCUMENT:doc:2024", expected: map[string]string{"type": "DOCUMENT", "id": "doc:2024"}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, resourceSchema, _ := resourceSchema(ctx, tc.resource)
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{Schema: resourceSchema, Raw: nullObjectValue(ctx, resourceSchema.Type())},
+			}
+			tc.resource.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: tc.id}, resp)
+
+			if tc.expected == nil {
+				if !resp.Diagnostics.HasError() {
+					t.Fatalf("expected import ID %q to be rejected", tc.id)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected import errors: %v", resp.Diagnostics)
+			}
+			for attribute, expected := range tc.expected {
+				var value types.String
+				resp.State.GetAttribute(ctx, path.Root(attribute), &value)
+				if value.ValueString() != expected {
+					t.Errorf("expected %s %q, got %q", attribute, expected, value.ValueString())
+				}
+			}
+		})
+	}
+}
+
+func TestImportState_ShippingMethodByIdentity(t *testing.T) {
+	ctx := context.Background()
+	r := NewShippingMethodResource()
+
+	_, resourceSchema, _ := resourceSchema(ctx, r)
+	var identityResp resource.IdentitySchemaResponse
+	r.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+
+	identity := &tfsdk.ResourceIdentity{
+		Schema: identityResp.IdentitySchema,
+		Raw:    nullObjectValue(ctx, identityResp.IdentitySchema.Type()),
+	}
+	identity.SetAttribute(ctx, path.Root("site"), "main")
+	identity.SetAttribute(ctx, path.Root("zone_id"), "zone-us")
+	identity.SetAttribute(ctx, path.Root("id"), "standard-shipping")
+
+	resp := &resource.ImportStateResponse{
+		State: tfsdk.State{Schema: resourceSchema, Raw: nullObjectValue(ctx, resourceSchema.Type())},
+	}
+	r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{Identity: identity}, resp)
+
+	var model ShippingMethodResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected import errors: %v", resp.Diagnostics)
+	}
+	if model.Site.ValueString() != "main" || model.ZoneID.ValueString() != "zone-us" || model.ID.ValueString() != "standard-shipping" {
+		t.Errorf("unexpected import by identity: %+v", model)
+	}
+}
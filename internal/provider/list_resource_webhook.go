@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ list.ListResource = &WebhookListResource{}
+var _ list.ListResourceWithConfigure = &WebhookListResource{}
+
+func NewWebhookListResource() list.ListResource {
+	return &WebhookListResource{}
+}
+
+// WebhookListResource lists webhook configurations for `terraform query`.
+// The webhook config endpoint is not paginated, so filters are applied client-side.
+type WebhookListResource struct {
+	client *EmporixClient
+}
+
+// WebhookListResourceModel describes the list block arguments.
+type WebhookListResourceModel struct {
+	Active       types.Bool   `tfsdk:"active"`
+	ProviderType types.String `tfsdk:"provider_type"`
+}
+
+func (l *WebhookListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook"
+}
+
+func (l *WebhookListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the webhook configurations of the tenant, optionally filtered by active status and provider type.",
+
+		Attributes: map[string]schema.Attribute{
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Only return webhook configurations with this active status.",
+				Optional:            true,
+			},
+			"provider_type": schema.StringAttribute{
+				MarkdownDescription: "Only return webhook configurations of this provider type: `SVIX_SHARED`, `SVIX` or `HTTP`.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (l *WebhookListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	l.client = listResourceClient(req, resp)
+}
+
+func (l *WebhookListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data WebhookListResourceModel
+	var diags diag.Diagnostics
+
+	diags.Append(req.Config.Get(ctx, &data)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "Listing webhook configurations")
+
+	webhooks, err := l.client.ListWebhooks(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list webhook configurations, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var matching []WebhookConfigGet
+	for _, webhook := range webhooks {
+		if !data.Active.IsNull() && webhook.Active != data.Active.ValueBool() {
+			continue
+		}
+		if !data.ProviderType.IsNull() && !strings.EqualFold(normalizeProvider(webhook.Provider), data.ProviderType.ValueString()) {
+			continue
+		}
+		matching = append(matching, webhook)
+	}
+
+	// Subscription status is only part of the full resource, and is listed once for all webhooks
+	var statuses map[string]string
+	if req.IncludeResource && len(matching) > 0 {
+		entries, err := l.client.ListEventSubscriptions(ctx)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to list event subscriptions, got error: %s", err))
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+		statuses = subscriptionStatusMap(entries)
+	}
+
+	stream.Results = listResultsFrom(ctx, req, matching, func(webhook *WebhookConfigGet, model *WebhookResourceModel, diags *diag.Diagnostics) string {
+		*model = webhookToModel(webhook)
+		applyCurrentSubscriptions(model.EventsConfiguration, statuses)
+		return webhook.Code
+	})
+}
@@ -78,6 +78,16 @@ type CountryUpdate struct {
 	Metadata *Metadata `json:"metadata,omitempty"`
 }
 
+// Language represents a language of the tenant, an entry of the project_lang tenant configuration.
+// Label is the name shown in the Emporix Management Dashboard; Name holds its translations.
+type Language struct {
+	Code     string            `json:"id"`
+	Label    string            `json:"label"`
+	Name     map[string]string `json:"name,omitempty"`
+	Default  bool              `json:"default"`
+	Required bool              `json:"required"`
+}
+
 // Currency represents a currency in Emporix
 type Currency struct {
 	Code     string            `json:"code"`
@@ -103,6 +113,195 @@ type CurrencyUpdate struct {
 	Metadata *Metadata   `json:"metadata,omitempty"`
 }
 
+// Unit represents a measurement unit of the unit handling service.
+// The factor converts a quantity of the unit into its type's base unit.
+type Unit struct {
+	Code     string            `json:"code"`
+	Name     map[string]string `json:"name"` // Always sent and returned as map (Content-Language/Accept-Language: *)
+	Type     string            `json:"type"`
+	BaseUnit bool              `json:"baseUnit"`
+	Factor   float64           `json:"factor"`
+	Metadata *Metadata         `json:"metadata,omitempty"`
+}
+
+// Category represents a node of the catalog's category tree.
+// Localized fields are always sent and returned as maps (Content-Language/Accept-Language: *).
+type Category struct {
+	ID            string                 `json:"id,omitempty"`
+	Name          map[string]string      `json:"name"`
+	Description   map[string]string      `json:"description,omitempty"`
+	LocalizedSlug map[string]string      `json:"localizedSlug,omitempty"`
+	ParentID      string                 `json:"parentId,omitempty"` // Empty for a root category
+	Position      *int64                 `json:"position,omitempty"` // nil lets the API append the category to its siblings
+	Published     bool                   `json:"published"`
+	Mixins        map[string]interface{} `json:"mixins,omitempty"`
+	Metadata      *Metadata              `json:"metadata,omitempty"`
+}
+
+// CategoryAssignment links a referenced object (e.g. a product) to a category
+type CategoryAssignment struct {
+	ID  string                `json:"id,omitempty"`
+	Ref CategoryAssignmentRef `json:"ref"`
+}
+
+// CategoryAssignmentRef identifies the object assigned to a category
+type CategoryAssignmentRef struct {
+	ID   string `json:"id"`
+	Type string `json:"type"` // PRODUCT
+}
+
+// PriceList represents a price list of the price service, scoping prices to a site,
+// a currency and optionally customer groups and a validity period
+type PriceList struct {
+	ID             string            `json:"id,omitempty"`
+	Name           map[string]string `json:"name"` // Always sent and returned as map (Content-Language/Accept-Language: *)
+	Currency       string            `json:"currency"`
+	SiteCode       string            `json:"siteCode"`
+	CustomerGroups []string          `json:"customerGroups,omitempty"`
+	Validity       *Validity         `json:"validity,omitempty"`
+	Metadata       *Metadata         `json:"metadata,omitempty"`
+}
+
+// Validity is the period in which an object such as a price list applies; either bound may be open
+type Validity struct {
+	From string `json:"from,omitempty"` // ISO 8601, e.g. 2024-01-01T00:00:00.000Z
+	To   string `json:"to,omitempty"`
+}
+
+// IAMGroup represents a group of the IAM service. Its members get the permissions of the
+// group's access control templates and of its individually bound access controls.
+type IAMGroup struct {
+	ID             string            `json:"id,omitempty"`
+	Name           map[string]string `json:"name"` // Always sent and returned as map (Content-Language/Accept-Language: *)
+	Description    map[string]string `json:"description,omitempty"`
+	UserType       string            `json:"userType"` // EMPLOYEE or CUSTOMER
+	Templates      []IAMReference    `json:"templates,omitempty"`
+	AccessControls []IAMReference    `json:"accessControls,omitempty"`
+	Metadata       *Metadata         `json:"metadata,omitempty"`
+}
+
+// IAMReference refers to another IAM object, such as an access control template, by ID
+type IAMReference struct {
+	ID string `json:"id"`
+}
+
+// IAMGroupUser is a member of an IAM group
+type IAMGroupUser struct {
+	ID       string `json:"id"`
+	UserType string `json:"userType,omitempty"`
+}
+
+// APIClient represents a custom API key of the IAM service: OAuth client credentials
+// limited to a set of scopes. ClientSecret is only returned when the client is created.
+type APIClient struct {
+	ID           string    `json:"id,omitempty"`
+	Name         string    `json:"name"`
+	Description  string    `json:"description,omitempty"`
+	Scopes       []string  `json:"scopes"`
+	ClientID     string    `json:"clientId,omitempty"`
+	ClientSecret string    `json:"clientSecret,omitempty"`
+	Metadata     *Metadata `json:"metadata,omitempty"`
+}
+
+// CustomerSegment represents a customer segment: a group of customers of a site that
+// products and categories can be attached to, e.g. for segment-specific assortments.
+// Localized fields are always sent and returned as maps (Content-Language/Accept-Language: *).
+type CustomerSegment struct {
+	ID          string            `json:"id,omitempty"`
+	Name        map[string]string `json:"name"`
+	Description map[string]string `json:"description,omitempty"`
+	SiteCode    string            `json:"siteCode"`
+	Validity    *Validity         `json:"validity,omitempty"`
+	Metadata    *Metadata         `json:"metadata,omitempty"`
+}
+
+// CustomerSegmentMember is a customer belonging to a customer segment
+type CustomerSegmentMember struct {
+	ID string `json:"id"`
+}
+
+// CustomerSegmentItem attaches a product or category to a customer segment
+type CustomerSegmentItem struct {
+	ID   string                 `json:"id,omitempty"`
+	Type string                 `json:"type"` // PRODUCT or CATEGORY
+	Item CustomerSegmentItemRef `json:"item"`
+}
+
+// CustomerSegmentItemRef identifies the product or category attached to a customer segment
+type CustomerSegmentItemRef struct {
+	ID string `json:"id"`
+}
+
+// PhysicalLocation represents a location of the availability service: a warehouse, store or
+// pickup point with its address, coordinates, contact details and opening hours.
+// Not to be confused with Location, which holds the coordinates only.
+type PhysicalLocation struct {
+	ID             string          `json:"id,omitempty"`
+	Name           string          `json:"name"`
+	Type           string          `json:"type"` // WAREHOUSE, STORE or PICKUP_POINT
+	Active         bool            `json:"active"`
+	Address        *Address        `json:"address"`
+	Location       *Location       `json:"location,omitempty"`
+	ContactDetails *ContactDetails `json:"contactDetails,omitempty"`
+	OpeningHours   []OpeningHours  `json:"openingHours,omitempty"`
+	Metadata       *Metadata       `json:"metadata,omitempty"`
+}
+
+// ContactDetails holds how to reach a location
+type ContactDetails struct {
+	Email string `json:"email,omitempty"`
+	Phone string `json:"phone,omitempty"`
+}
+
+// OpeningHours lists the time ranges a location is open on a day of the week
+type OpeningHours struct {
+	Weekday    string      `json:"weekday"` // MONDAY, TUESDAY, etc.
+	TimeRanges []TimeRange `json:"timeRanges"`
+}
+
+// SequentialIDSchema represents a number format of the sequential ID service, e.g. for order,
+// quote or invoice numbers: prefix, counter and suffix. Each schema type has at most one active
+// schema, which is set with SetActiveSequentialIDSchema rather than through this struct.
+type SequentialIDSchema struct {
+	ID           string    `json:"id,omitempty"`
+	Name         string    `json:"name"`
+	SchemaType   string    `json:"schemaType"`
+	PreText      string    `json:"preText,omitempty"`
+	PostText     string    `json:"postText,omitempty"`
+	CounterStart int64     `json:"counterStart"`
+	MaxValue     int64     `json:"maxValue"`
+	Active       bool      `json:"active,omitempty"` // read-only
+	Metadata     *Metadata `json:"metadata,omitempty"`
+}
+
+// Coupon represents a coupon of the coupon service. The code is chosen by the caller and identifies
+// the coupon. A PERCENT coupon has a discountPercentage, an ABSOLUTE coupon a discountAbsolute, and a
+// FREE_SHIPPING coupon neither.
+type Coupon struct {
+	Code                      string          `json:"code"`
+	Name                      string          `json:"name"`
+	Description               string          `json:"description,omitempty"`
+	DiscountType              string          `json:"discountType"`
+	DiscountPercentage        float64         `json:"discountPercentage,omitempty"`
+	DiscountAbsolute          *MonetaryAmount `json:"discountAbsolute,omitempty"`
+	Validity                  *Validity       `json:"validity,omitempty"`
+	MaxRedemptions            int64           `json:"maxRedemptions,omitempty"`
+	MaxRedemptionsPerCustomer int64           `json:"maxRedemptionsPerCustomer,omitempty"`
+	SegmentIDs                []string        `json:"segmentIds,omitempty"`
+	Metadata                  *Metadata       `json:"metadata,omitempty"`
+}
+
+// RewardPointsConfig is the tenant-wide configuration of the reward points service.
+// It always exists; see defaultRewardPointsConfig for the values of a fresh tenant.
+type RewardPointsConfig struct {
+	Active            bool      `json:"active"`
+	EarnRate          float64   `json:"earnRate"`       // points per currency unit spent
+	RedemptionRate    float64   `json:"redemptionRate"` // points per currency unit of discount
+	MinPointsToRedeem int64     `json:"minPointsToRedeem"`
+	PointsExpiryDays  int64     `json:"pointsExpiryDays"` // 0 means points never expire
+	Metadata          *Metadata `json:"metadata,omitempty"`
+}
+
 // TenantConfiguration represents a tenant configuration
 type TenantConfiguration struct {
 	Key     string      `json:"key"`
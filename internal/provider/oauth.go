@@ -20,7 +20,10 @@ type OAuthTokenResponse struct {
 	Scope        string `json:"scope"`
 }
 
-func generateAccessToken(ctx context.Context, apiUrl, clientId, clientSecret, scope string) (string, error) {
+// requestAccessToken runs the client_credentials grant and returns the full token response,
+// including expires_in, so callers can track when the token has to be refreshed.
+// The provider's token source and emporix_access_token both issue their tokens with it.
+func requestAccessToken(ctx context.Context, apiUrl, clientId, clientSecret, scope string) (*OAuthTokenResponse, error) {
 	tokenURL := apiUrl + "/oauth/token"
 
 	// Prepare form data
@@ -47,22 +50,22 @@ func generateAccessToken(ctx context.Context, apiUrl, clientId, clientSecret, sc
 
 	req, err := http.NewRequestWithContext(ctx, "POST", tokenURL, strings.NewReader(formData.Encode()))
 	if err != nil {
-		return "", fmt.Errorf("error creating token request: %w", err)
+		return nil, fmt.Errorf("error creating token request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Set("Accept", "application/json")
 
-	client := &http.Client{}
+	client := &http.Client{Transport: httpTransport}
 	resp, err := client.Do(req)
 	if err != nil {
-		return "", fmt.Errorf("error making token request: %w", err)
+		return nil, fmt.Errorf("error making token request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return "", fmt.Errorf("error reading token response: %w", err)
+		return nil, fmt.Errorf("error reading token response: %w", err)
 	}
 
 	if resp.StatusCode != http.StatusOK {
@@ -73,16 +76,16 @@ func generateAccessToken(ctx context.Context, apiUrl, clientId, clientSecret, sc
 				"status":      resp.Status,
 				"response":    string(respBody),
 			})
-		return "", fmt.Errorf("token request failed with status %d: %s", resp.StatusCode, string(respBody))
+		return nil, fmt.Errorf("token request failed with status %d: %s", resp.StatusCode, string(respBody))
 	}
 
 	var tokenResponse OAuthTokenResponse
 	if err := json.Unmarshal(respBody, &tokenResponse); err != nil {
-		return "", fmt.Errorf("error parsing token response: %w", err)
+		return nil, fmt.Errorf("error parsing token response: %w", err)
 	}
 
 	if tokenResponse.AccessToken == "" {
-		return "", fmt.Errorf("no access token in response")
+		return nil, fmt.Errorf("no access token in response")
 	}
 
 	tflog.Debug(ctx, "Successfully obtained OAuth access token",
@@ -92,5 +95,5 @@ func generateAccessToken(ctx context.Context, apiUrl, clientId, clientSecret, sc
 			"token_type": tokenResponse.TokenType,
 		})
 
-	return tokenResponse.AccessToken, nil
+	return &tokenResponse, nil
 }
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	// defaultPageSize is the number of items requested per page by list calls
+	defaultPageSize = 100
+	// maxListPages guards against endless paging when the API ignores pageNumber
+	maxListPages = 1000
+)
+
+// ListOptions controls filtering and paging of list calls
+type ListOptions struct {
+	// Query is sent as the Emporix `q` parameter, e.g. "active:true regions:EU"
+	Query string
+	// PageSize is the number of items requested per page, defaults to defaultPageSize
+	PageSize int
+}
+
+func (o *ListOptions) pageSize() int {
+	if o == nil || o.PageSize <= 0 {
+		return defaultPageSize
+	}
+	return o.PageSize
+}
+
+func (o *ListOptions) query() string {
+	if o == nil {
+		return ""
+	}
+	return o.Query
+}
+
+// buildListQuery joins non-empty `field:value` conditions into a single Emporix `q` expression
+func buildListQuery(conditions ...string) string {
+	var parts []string
+	for _, condition := range conditions {
+		if condition != "" {
+			parts = append(parts, condition)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// listAllPages fetches every page of a list endpoint returning a JSON array.
+// Pages are numbered from 1; paging stops at the first page shorter than the page size.
+func listAllPages[T any](ctx context.Context, c *EmporixClient, path string, opts *ListOptions, headers map[string]string) ([]T, error) {
+	pageSize := opts.pageSize()
+	var items []T
+
+	for pageNumber := 1; pageNumber <= maxListPages; pageNumber++ {
+		params := url.Values{}
+		params.Set("pageNumber", strconv.Itoa(pageNumber))
+		params.Set("pageSize", strconv.Itoa(pageSize))
+		if q := opts.query(); q != "" {
+			params.Set("q", q)
+		}
+
+		resp, err := c.doRequest(ctx, "GET", path+"?"+params.Encode(), nil, headers)
+		if err != nil {
+			return nil, err
+		}
+
+		bodyBytes, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if readErr != nil {
+			return nil, fmt.Errorf("error reading response body: %w", readErr)
+		}
+
+		if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
+			return nil, err
+		}
+
+		var page []T
+		if err := json.Unmarshal(bodyBytes, &page); err != nil {
+			return nil, fmt.Errorf("error decoding list response: %w", err)
+		}
+
+		items = append(items, page...)
+		if len(page) < pageSize {
+			return items, nil
+		}
+	}
+
+	return nil, fmt.Errorf("list of %s did not end after %d pages", path, maxListPages)
+}
+
+// listUnpaged fetches a list endpoint that returns every item in a single JSON array
+func listUnpaged[T any](ctx context.Context, c *EmporixClient, path string, headers map[string]string) ([]T, error) {
+	resp, err := c.doRequest(ctx, "GET", path, nil, headers)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response body: %w", readErr)
+	}
+
+	if err := c.checkResponse(ctx, resp, bodyBytes, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	var items []T
+	if err := json.Unmarshal(bodyBytes, &items); err != nil {
+		return nil, fmt.Errorf("error decoding list response: %w", err)
+	}
+	return items, nil
+}
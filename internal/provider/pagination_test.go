@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestListCountries_FollowsPagination(t *testing.T) {
+	all := []Country{{Code: "DE"}, {Code: "FR"}, {Code: "IT"}, {Code: "PL"}, {Code: "NL"}}
+
+	var requestedPages []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/country/test/countries" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("q"); got != "active:true regions:EU" {
+			t.Errorf("expected q to be forwarded, got %q", got)
+		}
+
+		pageNumber, _ := strconv.Atoi(r.URL.Query().Get("pageNumber"))
+		pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+		requestedPages = append(requestedPages, pageNumber)
+
+		start := (pageNumber - 1) * pageSize
+		end := start + pageSize
+		if start > len(all) {
+			start = len(all)
+		}
+		if end > len(all) {
+			end = len(all)
+		}
+		_ = json.NewEncoder(w).Encode(all[start:end])
+	}))
+	defer server.Close()
+
+	client := NewEmporixClient("test", "token", server.URL)
+	countries, err := client.ListCountries(context.Background(), &ListOptions{
+		Query:    buildListQuery("active:true", "", "regions:EU"),
+		PageSize: 2,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(countries) != len(all) {
+		t.Fatalf("expected %d countries, got %d", len(all), len(countries))
+	}
+	for i := range all {
+		if countries[i].Code != all[i].Code {
+			t.Errorf("country %d: expected %s, got %s", i, all[i].Code, countries[i].Code)
+		}
+	}
+	if len(requestedPages) != 3 || requestedPages[0] != 1 || requestedPages[2] != 3 {
+		t.Errorf("expected pages 1..3 to be requested, got %v", requestedPages)
+	}
+}
+
+func TestListShippingZones_StopsOnFullLastPageFollowedByEmptyPage(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.URL.Query().Get("q") != "" {
+			t.Errorf("expected no q parameter without a query, got %q", r.URL.RawQuery)
+		}
+		if r.URL.Query().Get("pageSize") != strconv.Itoa(defaultPageSize) {
+			t.Errorf("expected default page size, got %q", r.URL.Query().Get("pageSize"))
+		}
+		if r.URL.Query().Get("pageNumber") == "1" {
+			zones := make([]ShippingZone, defaultPageSize)
+			for i := range zones {
+				zones[i].ID = "zone-" + strconv.Itoa(i)
+			}
+			_ = json.NewEncoder(w).Encode(zones)
+			return
+		}
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client := NewEmporixClient("test", "token", server.URL)
+	zones, err := client.ListShippingZones(context.Background(), "main", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(zones) != defaultPageSize {
+		t.Fatalf("expected %d zones, got %d", defaultPageSize, len(zones))
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 requests, got %d", calls)
+	}
+}
+
+func TestListCurrencies_ReturnsAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"status":400,"message":"Invalid query"}`))
+	}))
+	defer server.Close()
+
+	client := NewEmporixClient("test", "token", server.URL)
+	_, err := client.ListCurrencies(context.Background(), &ListOptions{Query: "code:"})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		t.Fatalf("expected an APIError, got %T", err)
+	}
+	if apiErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected status 400, got %d", apiErr.StatusCode)
+	}
+}
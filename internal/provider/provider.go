@@ -4,29 +4,51 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ provider.Provider = &EmporixProvider{}
+var _ provider.ProviderWithListResources = &EmporixProvider{}
+var _ provider.ProviderWithEphemeralResources = &EmporixProvider{}
+var _ provider.ProviderWithFunctions = &EmporixProvider{}
 
 type EmporixProvider struct {
 	version string
 }
 
 type EmporixProviderModel struct {
-	Tenant       types.String `tfsdk:"tenant"`
-	AccessToken  types.String `tfsdk:"access_token"`
-	ClientId     types.String `tfsdk:"client_id"`
-	ClientSecret types.String `tfsdk:"client_secret"`
-	Scope        types.String `tfsdk:"scope"`
-	ApiUrl       types.String `tfsdk:"api_url"`
+	Tenant            types.String `tfsdk:"tenant"`
+	AccessToken       types.String `tfsdk:"access_token"`
+	ClientId          types.String `tfsdk:"client_id"`
+	ClientSecret      types.String `tfsdk:"client_secret"`
+	Scope             types.String `tfsdk:"scope"`
+	ApiUrl            types.String `tfsdk:"api_url"`
+	Profile           types.String `tfsdk:"profile"`
+	CredentialProcess types.Object `tfsdk:"credential_process"`
+	MaxRetries        types.Int64  `tfsdk:"max_retries"`
+	MaxRetryWait      types.Int64  `tfsdk:"max_retry_wait"`
+}
+
+// CredentialProcessModel describes the credential_process attribute.
+type CredentialProcessModel struct {
+	Command types.String `tfsdk:"command"`
+	Args    types.List   `tfsdk:"args"`
 }
 
 func (p *EmporixProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -65,6 +87,45 @@ func (p *EmporixProvider) Schema(ctx context.Context, req provider.SchemaRequest
 				Description: "Emporix API base URL. Defaults to https://api.emporix.io. Can be set via EMPORIX_API_URL environment variable.",
 				Optional:    true,
 			},
+			"profile": schema.StringAttribute{
+				Description: "Named profile of the credentials file (~/.emporix/credentials, or the file named by EMPORIX_CREDENTIALS_FILE; INI or YAML) to read tenant, client_id, client_secret, scope and api_url from. Values set in the configuration or environment variables take precedence over the profile. Can be set via EMPORIX_PROFILE environment variable.",
+				Optional:    true,
+			},
+			"credential_process": schema.SingleNestedAttribute{
+				Description: "External command that prints an access token, used instead of client_id and client_secret, e.g. a helper reading short-lived tokens from Vault. " +
+					"It must write a JSON document with access_token and optionally expires_at (RFC 3339) to stdout, and is run again shortly before the token expires. " +
+					"An access_token takes precedence over it, and so do client_id and client_secret set at a more specific level (configuration, then environment, then profile). " +
+					"Can be set via EMPORIX_CREDENTIAL_PROCESS environment variable as a command line, quoting arguments that contain spaces like a POSIX shell does.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"command": schema.StringAttribute{
+						Description: "Executable to run, looked up in PATH unless it contains a path separator.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"args": schema.ListAttribute{
+						Description: "Arguments passed to the command.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of retries for throttled (429) and transient gateway (502/503/504) API responses. Gateway errors are only retried for idempotent requests. Set to 0 to disable retries. Defaults to 3. Can be set via EMPORIX_MAX_RETRIES environment variable.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_retry_wait": schema.Int64Attribute{
+				Description: "Maximum wait between retries in seconds, including waits requested by the API via the Retry-After header. Defaults to 30. Can be set via EMPORIX_MAX_RETRY_WAIT environment variable.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -78,6 +139,14 @@ func (p *EmporixProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
+	// Client credentials and a credential process may come from different levels; the more specific one wins
+	clientCredentialsSource := credentialSourceNone
+	if config.ClientId.ValueString() != "" || config.ClientSecret.ValueString() != "" {
+		clientCredentialsSource = credentialSourceConfig
+	} else if os.Getenv("EMPORIX_CLIENT_ID") != "" || os.Getenv("EMPORIX_CLIENT_SECRET") != "" {
+		clientCredentialsSource = credentialSourceEnv
+	}
+
 	// Check environment variables if values not set in config
 	if config.Tenant.IsNull() {
 		config.Tenant = types.StringValue(os.Getenv("EMPORIX_TENANT"))
@@ -100,11 +169,113 @@ func (p *EmporixProvider) Configure(ctx context.Context, req provider.ConfigureR
 	}
 
 	if config.ApiUrl.IsNull() {
-		apiUrl := os.Getenv("EMPORIX_API_URL")
-		if apiUrl == "" {
-			apiUrl = "https://api.emporix.io"
+		config.ApiUrl = types.StringValue(os.Getenv("EMPORIX_API_URL"))
+	}
+
+	if config.Profile.IsNull() {
+		config.Profile = types.StringValue(os.Getenv("EMPORIX_PROFILE"))
+	}
+
+	var credentialProcess []string
+	credentialProcessSource := credentialSourceNone
+	if !config.CredentialProcess.IsNull() {
+		var process CredentialProcessModel
+		resp.Diagnostics.Append(config.CredentialProcess.As(ctx, &process, basetypes.ObjectAsOptions{})...)
+		var args []string
+		resp.Diagnostics.Append(process.Args.ElementsAs(ctx, &args, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		credentialProcess = append([]string{process.Command.ValueString()}, args...)
+		credentialProcessSource = credentialSourceConfig
+	} else if v := os.Getenv("EMPORIX_CREDENTIAL_PROCESS"); v != "" {
+		var err error
+		credentialProcess, err = splitCommandLine(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("credential_process"),
+				"Invalid Credential Process",
+				fmt.Sprintf("The EMPORIX_CREDENTIAL_PROCESS environment variable is not a valid command line: %s", err),
+			)
+			return
+		}
+		credentialProcessSource = credentialSourceEnv
+	}
+
+	// The credentials profile fills in whatever neither the configuration nor the environment set
+	profileHint := ""
+	if profileName := config.Profile.ValueString(); profileName != "" {
+		filename, err := credentialsFilePath()
+		var profile *credentialsProfile
+		if err == nil {
+			profile, err = loadCredentialsProfile(filename, profileName)
+		}
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("profile"),
+				"Invalid Credentials Profile",
+				fmt.Sprintf("Unable to load credentials profile %q: %s", profileName, err),
+			)
+			return
+		}
+
+		config.Tenant = stringOrProfileValue(config.Tenant, profile.Tenant)
+		config.ClientId = stringOrProfileValue(config.ClientId, profile.ClientId)
+		config.ClientSecret = stringOrProfileValue(config.ClientSecret, profile.ClientSecret)
+		if clientCredentialsSource == credentialSourceNone && (profile.ClientId != "" || profile.ClientSecret != "") {
+			clientCredentialsSource = credentialSourceProfile
+		}
+		config.Scope = stringOrProfileValue(config.Scope, profile.Scope)
+		config.ApiUrl = stringOrProfileValue(config.ApiUrl, profile.ApiUrl)
+		if credentialProcessSource == credentialSourceNone && profile.CredentialProcess != "" {
+			credentialProcess, err = splitCommandLine(profile.CredentialProcess)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("profile"),
+					"Invalid Credentials Profile",
+					fmt.Sprintf("The credential_process of profile %q is not a valid command line: %s", profileName, err),
+				)
+				return
+			}
+			credentialProcessSource = credentialSourceProfile
+		}
+		profileHint = fmt.Sprintf(" Profile %q in %s doesn't set it either.", profileName, filename)
+	}
+
+	if config.ApiUrl.ValueString() == "" {
+		config.ApiUrl = types.StringValue(defaultApiUrl)
+	}
+
+	if config.MaxRetries.IsNull() {
+		config.MaxRetries = types.Int64Value(defaultMaxRetries)
+		if v := os.Getenv("EMPORIX_MAX_RETRIES"); v != "" {
+			maxRetries, err := strconv.ParseInt(v, 10, 64)
+			if err != nil || maxRetries < 0 {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("max_retries"),
+					"Invalid Retry Configuration",
+					fmt.Sprintf("The EMPORIX_MAX_RETRIES environment variable must be a non-negative integer, got: %q", v),
+				)
+				return
+			}
+			config.MaxRetries = types.Int64Value(maxRetries)
+		}
+	}
+
+	if config.MaxRetryWait.IsNull() {
+		config.MaxRetryWait = types.Int64Value(int64(defaultRetryWaitMax / time.Second))
+		if v := os.Getenv("EMPORIX_MAX_RETRY_WAIT"); v != "" {
+			maxRetryWait, err := strconv.ParseInt(v, 10, 64)
+			if err != nil || maxRetryWait < 1 {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("max_retry_wait"),
+					"Invalid Retry Configuration",
+					fmt.Sprintf("The EMPORIX_MAX_RETRY_WAIT environment variable must be a positive number of seconds, got: %q", v),
+				)
+				return
+			}
+			config.MaxRetryWait = types.Int64Value(maxRetryWait)
 		}
-		config.ApiUrl = types.StringValue(apiUrl)
 	}
 
 	// Validate tenant (always required)
@@ -113,21 +284,51 @@ func (p *EmporixProvider) Configure(ctx context.Context, req provider.ConfigureR
 			path.Root("tenant"),
 			"Missing Tenant Configuration",
 			"The provider cannot create the Emporix API client as there is a missing or empty value for the Emporix tenant. "+
-				"Set the tenant value in the configuration or use the EMPORIX_TENANT environment variable.",
+				"Set the tenant value in the configuration, use the EMPORIX_TENANT environment variable or add it to a credentials profile."+profileHint,
 		)
 		return
 	}
 
-	// Check if we need to generate an access token
+	// A user-supplied access token is used as-is; otherwise tokens are obtained
+	// (and refreshed before they expire) from the credential process or the client credentials
+	var tokens tokenSource = &staticTokenSource{token: config.AccessToken.ValueString()}
 	needsTokenGeneration := config.AccessToken.IsNull() || config.AccessToken.ValueString() == ""
 
+	if needsTokenGeneration && credentialProcessSource != credentialSourceNone && credentialProcessSource == clientCredentialsSource {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("credential_process"),
+			"Conflicting Authentication Configuration",
+			fmt.Sprintf("Both client credentials and a credential process are set in the %s. Set only one of them.", credentialProcessSource),
+		)
+		return
+	}
+
+	// Client credentials set at a more specific level than the credential process are used instead of it
+	if needsTokenGeneration && credentialProcessSource > clientCredentialsSource {
+		process := newProcessTokenSource(credentialProcess)
+
+		// Run the process now so a broken helper fails at configure time
+		if _, err := process.Token(ctx); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("credential_process"),
+				"Failed to Run Credential Process",
+				fmt.Sprintf("Could not obtain an access token from the credential process: %s", err.Error()),
+			)
+			return
+		}
+
+		tokens = process
+		needsTokenGeneration = false
+		tflog.Debug(ctx, "Successfully obtained access token from credential process")
+	}
+
 	if needsTokenGeneration {
 		// Validate client credentials are provided
 		if config.ClientId.IsNull() || config.ClientId.ValueString() == "" {
 			resp.Diagnostics.AddError(
 				"Missing Authentication Configuration",
-				"Either access_token or client_id must be provided. "+
-					"Set via provider configuration or environment variables (EMPORIX_ACCESS_TOKEN or EMPORIX_CLIENT_ID).",
+				"Either access_token, credential_process or client_id must be provided. "+
+					"Set via provider configuration, environment variables (EMPORIX_ACCESS_TOKEN or EMPORIX_CLIENT_ID) or a credentials profile."+profileHint,
 			)
 			return
 		}
@@ -135,8 +336,8 @@ func (p *EmporixProvider) Configure(ctx context.Context, req provider.ConfigureR
 		if config.ClientSecret.IsNull() || config.ClientSecret.ValueString() == "" {
 			resp.Diagnostics.AddError(
 				"Missing Authentication Configuration",
-				"Either access_token or client_secret must be provided. "+
-					"Set via provider configuration or environment variables (EMPORIX_ACCESS_TOKEN or EMPORIX_CLIENT_SECRET).",
+				"Either access_token, credential_process or client_secret must be provided. "+
+					"Set via provider configuration, environment variables (EMPORIX_ACCESS_TOKEN or EMPORIX_CLIENT_SECRET) or a credentials profile."+profileHint,
 			)
 			return
 		}
@@ -147,15 +348,15 @@ func (p *EmporixProvider) Configure(ctx context.Context, req provider.ConfigureR
 			scope = config.Scope.ValueString()
 		}
 
-		// Generate access token
-		token, err := generateAccessToken(
-			ctx,
+		credentials := newClientCredentialsTokenSource(
 			config.ApiUrl.ValueString(),
 			config.ClientId.ValueString(),
 			config.ClientSecret.ValueString(),
 			scope,
 		)
-		if err != nil {
+
+		// Generate the first access token now so bad credentials fail at configure time
+		if _, err := credentials.Token(ctx); err != nil {
 			resp.Diagnostics.AddError(
 				"Failed to Generate Access Token",
 				fmt.Sprintf("Could not generate OAuth access token: %s", err.Error()),
@@ -163,19 +364,26 @@ func (p *EmporixProvider) Configure(ctx context.Context, req provider.ConfigureR
 			return
 		}
 
-		config.AccessToken = types.StringValue(token)
+		tokens = credentials
 		tflog.Debug(ctx, "Successfully generated OAuth access token")
 	}
 
 	// Create API client with timeout
-	client := NewEmporixClient(
+	client := newEmporixClientWithTokenSource(
 		config.Tenant.ValueString(),
-		config.AccessToken.ValueString(),
+		tokens,
 		config.ApiUrl.ValueString(),
 	)
+	client.MaxRetries = int(config.MaxRetries.ValueInt64())
+	client.RetryWaitMax = time.Duration(config.MaxRetryWait.ValueInt64()) * time.Second
+	if client.RetryWaitMin > client.RetryWaitMax {
+		client.RetryWaitMin = client.RetryWaitMax
+	}
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.ListResourceData = client
+	resp.EphemeralResourceData = client
 }
 
 func (p *EmporixProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -184,6 +392,7 @@ func (p *EmporixProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewPaymentModeResource,
 		NewCountryResource,
 		NewCurrencyResource,
+		NewLanguageResource,
 		NewTenantConfigurationResource,
 		NewWebhookResource,
 		NewShippingZoneResource,
@@ -193,11 +402,66 @@ func (p *EmporixProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewDeliveryTimeResource,
 		NewShippingMethodResource,
 		NewTaxResource,
+		NewUnitResource,
+		NewCategoryResource,
+		NewCategoryAssignmentResource,
+		NewPriceListResource,
+		NewIAMGroupResource,
+		NewIAMGroupMembershipResource,
+		NewAPIClientResource,
+		NewCustomerSegmentResource,
+		NewCustomerSegmentItemAssignmentResource,
+		NewLocationResource,
+		NewSequentialIDSchemaResource,
+		NewCouponResource,
+		NewRewardPointsConfigResource,
 	}
 }
 
 func (p *EmporixProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewSiteDataSource,
+		NewPaymentModeDataSource,
+		NewCountryDataSource,
+		NewCurrencyDataSource,
+		NewTenantConfigurationDataSource,
+		NewWebhookDataSource,
+		NewShippingZoneDataSource,
+		NewSchemaDataSource,
+		NewCustomEntityTypeDataSource,
+		NewDeliveryTimeDataSource,
+		NewShippingMethodDataSource,
+		NewTaxDataSource,
+		NewUnitDataSource,
+		NewCountriesDataSource,
+		NewCurrenciesDataSource,
+		NewShippingZonesDataSource,
+		NewWebhooksDataSource,
+	}
+}
+
+func (p *EmporixProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewWebhookListResource,
+		NewShippingZoneListResource,
+		NewSchemaListResource,
+		NewCustomEntityInstanceListResource,
+		NewDeliveryTimeListResource,
+		NewTenantConfigurationListResource,
+	}
+}
+
+func (p *EmporixProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAccessTokenEphemeralResource,
+	}
+}
+
+func (p *EmporixProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewLocalizedFunction,
+		NewMixinsFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
@@ -7,6 +7,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"terraform-provider-emporix/internal/emporixfake"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -18,13 +20,20 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 }
 
 // testAccPreCheck validates that required environment variables are set before
-// running acceptance tests.
+// running acceptance tests. With EMPORIX_FAKE_API=true the tests run against an
+// in-process fake API instead of a real tenant; EMPORIX_VCR_MODE records or replays
+// the API interactions, see testAccVCR.
 func testAccPreCheck(t *testing.T) {
 	// Check TF_ACC is set
 	if os.Getenv("TF_ACC") == "" {
 		t.Skip("TF_ACC must be set for acceptance tests")
 	}
 
+	if os.Getenv("EMPORIX_FAKE_API") == "true" {
+		startFakeAPI(t)
+	}
+	testAccVCR(t)
+
 	// Check required Emporix environment variables
 	requiredEnvVars := []string{
 		"EMPORIX_TENANT",
@@ -39,6 +48,22 @@ func testAccPreCheck(t *testing.T) {
 	}
 }
 
+// startFakeAPI starts a fresh fake Emporix API for the test and points the
+// provider and getTestClient at it through the environment.
+func startFakeAPI(t *testing.T) *emporixfake.Server {
+	t.Helper()
+
+	server := emporixfake.New()
+	t.Cleanup(server.Close)
+
+	t.Setenv("EMPORIX_API_URL", server.URL)
+	t.Setenv("EMPORIX_TENANT", server.Tenant)
+	t.Setenv("EMPORIX_CLIENT_ID", server.ClientID)
+	t.Setenv("EMPORIX_CLIENT_SECRET", server.ClientSecret)
+
+	return server
+}
+
 // testAccProviderConfig returns a basic provider configuration for use in tests.
 // It reads credentials from environment variables.
 func testAccProviderConfig() string {
@@ -64,11 +89,11 @@ func getTestClient() (*EmporixClient, error) {
 	}
 
 	// Get OAuth token
-	token, err := generateAccessToken(context.Background(), apiURL, clientID, clientSecret, "")
+	tokenResponse, err := requestAccessToken(context.Background(), apiURL, clientID, clientSecret, "")
 	if err != nil {
 		return nil, err
 	}
 
 	// Create and return client
-	return NewEmporixClient(tenant, token, apiURL), nil
+	return NewEmporixClient(tenant, tokenResponse.AccessToken, apiURL), nil
 }
@@ -0,0 +1,276 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &APIClientResource{}
+var _ resource.ResourceWithImportState = &APIClientResource{}
+var _ resource.ResourceWithIdentity = &APIClientResource{}
+
+func NewAPIClientResource() resource.Resource {
+	return &APIClientResource{}
+}
+
+// APIClientResource defines the resource implementation.
+type APIClientResource struct {
+	client *EmporixClient
+}
+
+// APIClientResourceModel describes the resource data model.
+type APIClientResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Scopes       types.Set    `tfsdk:"scopes"`
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+}
+
+// mapAPIClientToModel converts an APIClient API response to an APIClientResourceModel.
+// The secret is only set when the response has one, i.e. right after creation; afterwards
+// the model keeps the secret it was read with.
+func mapAPIClientToModel(ctx context.Context, apiClient *APIClient, data *APIClientResourceModel, diags *diag.Diagnostics) {
+	data.ID = types.StringValue(apiClient.ID)
+	data.Name = types.StringValue(apiClient.Name)
+	data.Description = stringOrNull(apiClient.Description)
+	data.ClientID = types.StringValue(apiClient.ClientID)
+
+	scopes, d := types.SetValueFrom(ctx, types.StringType, apiClient.Scopes)
+	diags.Append(d...)
+	data.Scopes = scopes
+
+	if apiClient.ClientSecret != "" {
+		data.ClientSecret = types.StringValue(apiClient.ClientSecret)
+	}
+}
+
+// apiClientFromModel converts an APIClientResourceModel to an APIClient API payload
+func apiClientFromModel(ctx context.Context, data *APIClientResourceModel, diags *diag.Diagnostics) *APIClient {
+	apiClient := &APIClient{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+	}
+	diags.Append(data.Scopes.ElementsAs(ctx, &apiClient.Scopes, false)...)
+	return apiClient
+}
+
+func (r *APIClientResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_client"
+}
+
+func (r *APIClientResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a custom API key of the Emporix tenant: OAuth client credentials limited to a set of scopes. " +
+			"The client secret is only returned by the API when the key is created and is kept in state from then on.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the API key, generated by the API.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the API key, e.g. the service using it.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the API key.",
+				Optional:            true,
+			},
+			"scopes": schema.SetAttribute{
+				MarkdownDescription: "Scopes granted to the API key (e.g., `site.site_read`). Changing the scopes updates the key in place; its credentials stay the same.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(
+							regexp.MustCompile(`^[a-z][a-z0-9-]*\.[a-z0-9_]+$`),
+							"must be a scope of the form <service>.<permission>, e.g. site.site_read",
+						),
+					),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "OAuth client ID of the API key, for the `client_id` of a provider or service.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "OAuth client secret of the API key. Only known for keys created by Terraform; null after import.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *APIClientResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "API key ID.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *APIClientResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *APIClientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data APIClientResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating API client", map[string]interface{}{
+		"name": data.Name.ValueString(),
+	})
+
+	apiClient := apiClientFromModel(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateAPIClient(ctx, apiClient)
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to create API client, got error: %s", err))
+		return
+	}
+
+	data.ClientSecret = types.StringNull()
+	mapAPIClientToModel(ctx, created, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *APIClientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data APIClientResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading API client", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	apiClient, err := r.client.GetAPIClient(ctx, data.ID.ValueString())
+	if err != nil {
+		// If resource not found, remove from state (drift detection)
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read API client, got error: %s", err))
+		return
+	}
+
+	mapAPIClientToModel(ctx, apiClient, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *APIClientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data APIClientResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating API client", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	apiClient := apiClientFromModel(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateAPIClient(ctx, data.ID.ValueString(), apiClient)
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to update API client, got error: %s", err))
+		return
+	}
+
+	// The plan carries the secret from state, which the update leaves unchanged
+	mapAPIClientToModel(ctx, updated, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *APIClientResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data APIClientResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Deleting API client", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteAPIClient(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete API client, got error: %s", err),
+		)
+		return
+	}
+}
+
+func (r *APIClientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by API key ID, or by identity { id }
+	importState(ctx, req, resp, "id")
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccAPIClientResource_basic(t *testing.T) {
+	var clientID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAPIClientDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAPIClientResourceConfig(`["site.site_read"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("emporix_api_client.test", "id"),
+					resource.TestCheckResourceAttr("emporix_api_client.test", "name", "tf-test-storefront"),
+					resource.TestCheckResourceAttr("emporix_api_client.test", "scopes.#", "1"),
+					resource.TestCheckResourceAttrSet("emporix_api_client.test", "client_id"),
+					resource.TestCheckResourceAttrSet("emporix_api_client.test", "client_secret"),
+					resource.TestCheckResourceAttrWith("emporix_api_client.test", "client_id", func(value string) error {
+						clientID = value
+						return nil
+					}),
+				),
+			},
+			// ImportState testing: the secret can't be read back
+			{
+				ResourceName:            "emporix_api_client.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"client_secret"},
+			},
+			// Update testing: scopes change in place and the credentials are kept
+			{
+				Config: testAccAPIClientResourceConfig(`["site.site_read", "category.category_read"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("emporix_api_client.test", "scopes.#", "2"),
+					resource.TestCheckResourceAttrSet("emporix_api_client.test", "client_secret"),
+					resource.TestCheckResourceAttrWith("emporix_api_client.test", "client_id", func(value string) error {
+						if value != clientID {
+							return fmt.Errorf("expected client_id %s to be kept, got %s", clientID, value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAPIClientResource_invalidScope(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAPIClientResourceConfig(`["tenant=mytenant"]`),
+				ExpectError: regexp.MustCompile(`must be a scope of the form`),
+			},
+		},
+	})
+}
+
+// testAccAPIClientResourceConfig generates an API client with the given scopes (an HCL list)
+func testAccAPIClientResourceConfig(scopes string) string {
+	return fmt.Sprintf(`
+resource "emporix_api_client" "test" {
+  name        = "tf-test-storefront"
+  description = "Managed by Terraform"
+  scopes      = %[1]s
+}
+`, scopes)
+}
+
+// testAccCheckAPIClientDestroy verifies that API clients have been deleted
+func testAccCheckAPIClientDestroy(s *terraform.State) error {
+	ctx := context.Background()
+
+	client, err := getTestClient()
+	if err != nil {
+		return fmt.Errorf("failed to get test client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "emporix_api_client" {
+			continue
+		}
+
+		_, err := client.GetAPIClient(ctx, rs.Primary.ID)
+		if IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("unexpected error checking API client: %w", err)
+		}
+
+		return fmt.Errorf("API client %s still exists after destroy", rs.Primary.ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,447 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CategoryResource{}
+var _ resource.ResourceWithImportState = &CategoryResource{}
+var _ resource.ResourceWithIdentity = &CategoryResource{}
+
+func NewCategoryResource() resource.Resource {
+	return &CategoryResource{}
+}
+
+// CategoryResource defines the resource implementation.
+type CategoryResource struct {
+	client *EmporixClient
+}
+
+// CategoryResourceModel describes the resource data model.
+type CategoryResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.Map    `tfsdk:"name"`
+	Description types.Map    `tfsdk:"description"`
+	Slug        types.Map    `tfsdk:"slug"`
+	ParentID    types.String `tfsdk:"parent_id"`
+	Position    types.Int64  `tfsdk:"position"`
+	Published   types.Bool   `tfsdk:"published"`
+	Mixins      types.List   `tfsdk:"mixins"`
+}
+
+// mapCategoryToModel converts a Category API response to a CategoryResourceModel.
+// The mixins already in data decide the order of the mapped mixins.
+func mapCategoryToModel(ctx context.Context, category *Category, data *CategoryResourceModel, diags *diag.Diagnostics) {
+	data.ID = types.StringValue(category.ID)
+	data.ParentID = stringOrNull(category.ParentID)
+	data.Published = types.BoolValue(category.Published)
+
+	data.Position = types.Int64Null()
+	if category.Position != nil {
+		data.Position = types.Int64Value(*category.Position)
+	}
+
+	data.Name = localizedMapOrNull(ctx, category.Name, diags)
+	data.Description = localizedMapOrNull(ctx, category.Description, diags)
+	data.Slug = localizedMapOrNull(ctx, category.LocalizedSlug, diags)
+
+	var schemaURLs map[string]string
+	if category.Metadata != nil {
+		schemaURLs = category.Metadata.Mixins
+	}
+	data.Mixins = mixinsToList(ctx, schemaURLs, category.Mixins, data.Mixins, diags)
+}
+
+// categoryFromModel converts a CategoryResourceModel to a Category API payload
+func categoryFromModel(ctx context.Context, data *CategoryResourceModel, diags *diag.Diagnostics) *Category {
+	category := &Category{
+		Name:      localizedMapFromModel(ctx, data.Name, diags),
+		ParentID:  data.ParentID.ValueString(),
+		Published: data.Published.ValueBool(),
+	}
+
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		category.Description = localizedMapFromModel(ctx, data.Description, diags)
+	}
+
+	// Unknown on create, so the API derives the slugs from the name; afterwards the
+	// known slugs are sent back so that renaming a category doesn't change its URLs
+	if !data.Slug.IsNull() && !data.Slug.IsUnknown() {
+		category.LocalizedSlug = localizedMapFromModel(ctx, data.Slug, diags)
+	}
+
+	if !data.Position.IsNull() && !data.Position.IsUnknown() {
+		position := data.Position.ValueInt64()
+		category.Position = &position
+	}
+
+	mixins, schemaURLs := mixinsFromList(ctx, data.Mixins, diags)
+	if len(mixins) > 0 {
+		category.Mixins = mixins
+		category.Metadata = &Metadata{Mixins: schemaURLs}
+	}
+
+	return category
+}
+
+// localizedMapOrNull converts a localized API map to a Terraform map, null when empty
+func localizedMapOrNull(ctx context.Context, values map[string]string, diags *diag.Diagnostics) types.Map {
+	if len(values) == 0 {
+		return types.MapNull(types.StringType)
+	}
+	mapValue, d := types.MapValueFrom(ctx, types.StringType, values)
+	diags.Append(d...)
+	return mapValue
+}
+
+// localizedMapFromModel converts a Terraform map of language code to text to an API map
+func localizedMapFromModel(ctx context.Context, value types.Map, diags *diag.Diagnostics) map[string]string {
+	values := make(map[string]string)
+	diags.Append(value.ElementsAs(ctx, &values, false)...)
+	return values
+}
+
+// mixinsFromList converts a mixins list (see MixinModel) to the mixin data and the schema URLs
+// the API expects in mixins and metadata.mixins
+func mixinsFromList(ctx context.Context, list types.List, diags *diag.Diagnostics) (map[string]interface{}, map[string]string) {
+	if list.IsNull() || list.IsUnknown() {
+		return nil, nil
+	}
+
+	var mixinsList []MixinModel
+	diags.Append(list.ElementsAs(ctx, &mixinsList, false)...)
+
+	mixins := make(map[string]interface{}, len(mixinsList))
+	schemaURLs := make(map[string]string, len(mixinsList))
+	for _, mixin := range mixinsList {
+		mixinName := mixin.Name.ValueString()
+		schemaURLs[mixinName] = mixin.SchemaURL.ValueString()
+
+		fields := map[string]interface{}{}
+		if !mixin.Fields.IsNull() && mixin.Fields.ValueString() != "" {
+			if err := json.Unmarshal([]byte(mixin.Fields.ValueString()), &fields); err != nil {
+				diags.AddError(
+					"Invalid Mixin Fields JSON",
+					fmt.Sprintf("Failed to parse mixin '%s' fields JSON: %s", mixinName, err.Error()),
+				)
+				continue
+			}
+		}
+		mixins[mixinName] = fields
+	}
+
+	return mixins, schemaURLs
+}
+
+// mixinsToList converts mixin data and schema URLs returned by the API to a mixins list.
+// Mixins keep their order in previous; without mixins the list stays empty if previous
+// was an empty list, and null otherwise.
+func mixinsToList(ctx context.Context, schemaURLs map[string]string, mixins map[string]interface{}, previous types.List, diags *diag.Diagnostics) types.List {
+	mixinsList := []MixinModel{}
+	for _, mixinName := range orderedMixinNames(ctx, schemaURLs, previous) {
+		mixinData, ok := mixins[mixinName]
+		if !ok {
+			continue
+		}
+
+		fieldsJSON, err := json.Marshal(mixinData)
+		if err != nil {
+			diags.AddError(
+				"Failed to Marshal Mixin Data",
+				fmt.Sprintf("Could not convert mixin '%s' data to JSON: %s", mixinName, err.Error()),
+			)
+			continue
+		}
+
+		mixinsList = append(mixinsList, MixinModel{
+			Name:      types.StringValue(mixinName),
+			SchemaURL: types.StringValue(schemaURLs[mixinName]),
+			Fields:    types.StringValue(string(fieldsJSON)),
+		})
+	}
+
+	if len(mixinsList) == 0 && (previous.IsNull() || previous.IsUnknown()) {
+		return types.ListNull(types.ObjectType{AttrTypes: mixinObjectAttrTypes})
+	}
+
+	listValue, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: mixinObjectAttrTypes}, mixinsList)
+	diags.Append(d...)
+	return listValue
+}
+
+// categoryFieldPath maps API field errors for a category onto the plan: localized slugs
+// belong to slug, and mixin data ("mixins.<name>.<field>") to the fields of a mixin block
+func categoryFieldPath(ctx context.Context, plan *CategoryResourceModel) apiFieldPathFunc {
+	return func(field string) (path.Path, bool) {
+		if field == "localizedSlug" || strings.HasPrefix(field, "localizedSlug.") {
+			return path.Root("slug"), true
+		}
+		parts := strings.SplitN(field, ".", 3)
+		if len(parts) < 2 || parts[0] != "mixins" {
+			return path.Empty(), false
+		}
+		return mixinFieldsPath(ctx, plan.Mixins, parts[1])
+	}
+}
+
+func (r *CategoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_category"
+}
+
+func (r *CategoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a category of the Emporix catalog. " +
+			"Categories form a tree through `parent_id`; products are linked to them with `emporix_category_assignment`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the category, generated by the API.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.MapAttribute{
+				MarkdownDescription: "Category name as a map of language code to name (e.g., {\"en\": \"Shoes\", \"de\": \"Schuhe\"}). " +
+					"Provide at least one language translation.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"description": schema.MapAttribute{
+				MarkdownDescription: "Category description as a map of language code to text.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"slug": schema.MapAttribute{
+				MarkdownDescription: "URL slug of the category as a map of language code to slug. " +
+					"Derived from the name by Emporix when not set, and kept when the category is renamed.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"parent_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the parent category. Omit for a root category. Changing it moves the category with its subcategories.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"position": schema.Int64Attribute{
+				MarkdownDescription: "Position of the category among the children of its parent, starting at 0. " +
+					"When not set, Emporix appends the category to its siblings.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"published": schema.BoolAttribute{
+				MarkdownDescription: "Whether the category is visible in the storefront. Defaults to false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"mixins": schema.ListNestedAttribute{
+				Description: "List of mixins with their schema URLs and data. Each mixin combines the schema URL (from metadata) and the actual fields in a single object.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the mixin (must be unique within the category).",
+							Required:    true,
+						},
+						"schema_url": schema.StringAttribute{
+							Description: "URL to the JSON schema that defines this mixin's structure.",
+							Required:    true,
+						},
+						"fields": schema.StringAttribute{
+							Description: "Mixin data as JSON string. Use jsonencode() to convert a map to JSON. Example: jsonencode({\"field1\" = \"value1\"})",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *CategoryResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "Category ID.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *CategoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CategoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CategoryResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating category", map[string]interface{}{
+		"parent_id": data.ParentID.ValueString(),
+	})
+
+	category := categoryFromModel(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateCategory(ctx, category)
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, categoryFieldPath(ctx, &data), err,
+			"Client Error", fmt.Sprintf("Unable to create category, got error: %s", err))
+		return
+	}
+
+	mapCategoryToModel(ctx, created, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *CategoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CategoryResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading category", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	category, err := r.client.GetCategory(ctx, data.ID.ValueString())
+	if err != nil {
+		// If resource not found, remove from state (drift detection)
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read category, got error: %s", err))
+		return
+	}
+
+	mapCategoryToModel(ctx, category, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *CategoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CategoryResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating category", map[string]interface{}{
+		"id":        data.ID.ValueString(),
+		"parent_id": data.ParentID.ValueString(),
+	})
+
+	category := categoryFromModel(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateCategory(ctx, data.ID.ValueString(), category)
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, categoryFieldPath(ctx, &data), err,
+			"Client Error", fmt.Sprintf("Unable to update category, got error: %s", err))
+		return
+	}
+
+	mapCategoryToModel(ctx, updated, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *CategoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CategoryResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Deleting category", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteCategory(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete category, got error: %s", err),
+		)
+		return
+	}
+}
+
+func (r *CategoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by category ID, or by identity { id }
+	importState(ctx, req, resp, "id")
+}
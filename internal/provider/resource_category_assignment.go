@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CategoryAssignmentResource{}
+var _ resource.ResourceWithImportState = &CategoryAssignmentResource{}
+var _ resource.ResourceWithIdentity = &CategoryAssignmentResource{}
+
+func NewCategoryAssignmentResource() resource.Resource {
+	return &CategoryAssignmentResource{}
+}
+
+// CategoryAssignmentResource defines the resource implementation.
+type CategoryAssignmentResource struct {
+	client *EmporixClient
+}
+
+// CategoryAssignmentResourceModel describes the resource data model.
+type CategoryAssignmentResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	CategoryID types.String `tfsdk:"category_id"`
+	ProductID  types.String `tfsdk:"product_id"`
+}
+
+func (r *CategoryAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_category_assignment"
+}
+
+func (r *CategoryAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Assigns a product to a category of the Emporix catalog. " +
+			"Each assignment links one product to one category; changing either creates a new assignment.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the assignment, generated by the API.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"category_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the category the product is assigned to.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"product_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the assigned product.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CategoryAssignmentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"category_id": identityschema.StringAttribute{
+				Description:       "ID of the category.",
+				RequiredForImport: true,
+			},
+			"product_id": identityschema.StringAttribute{
+				Description:       "ID of the assigned product.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *CategoryAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CategoryAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CategoryAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating category assignment", map[string]interface{}{
+		"category_id": data.CategoryID.ValueString(),
+		"product_id":  data.ProductID.ValueString(),
+	})
+
+	assignment, err := r.client.CreateCategoryAssignment(ctx, data.CategoryID.ValueString(), data.ProductID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to assign product to category, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(assignment.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *CategoryAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CategoryAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading category assignment", map[string]interface{}{
+		"category_id": data.CategoryID.ValueString(),
+		"product_id":  data.ProductID.ValueString(),
+	})
+
+	assignment, err := r.client.GetCategoryAssignment(ctx, data.CategoryID.ValueString(), data.ProductID.ValueString())
+	if err != nil {
+		// If the assignment or its category is gone, remove from state (drift detection)
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read category assignment, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(assignment.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *CategoryAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Both category_id and product_id require replacement, so there is nothing to update
+	var data CategoryAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *CategoryAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CategoryAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Deleting category assignment", map[string]interface{}{
+		"category_id": data.CategoryID.ValueString(),
+		"product_id":  data.ProductID.ValueString(),
+	})
+
+	err := r.client.DeleteCategoryAssignment(ctx, data.CategoryID.ValueString(), data.ProductID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete category assignment, got error: %s", err),
+		)
+		return
+	}
+}
+
+func (r *CategoryAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by "category_id:product_id", or by identity { category_id, product_id }
+	importState(ctx, req, resp, "category_id", "product_id")
+}
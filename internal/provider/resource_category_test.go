@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccCategoryResource_tree(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCategoryDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCategoryResourceConfig("Sneakers", "emporix_category.shoes.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("emporix_category.shoes", "id"),
+					resource.TestCheckResourceAttr("emporix_category.shoes", "published", "true"),
+					resource.TestCheckResourceAttr("emporix_category.shoes", "slug.en", "tf-shoes"),
+					resource.TestCheckResourceAttr("emporix_category.shoes", "mixins.#", "1"),
+					resource.TestCheckResourceAttrPair("emporix_category.sneakers", "parent_id", "emporix_category.shoes", "id"),
+					resource.TestCheckResourceAttr("emporix_category.sneakers", "name.en", "Sneakers"),
+					resource.TestCheckResourceAttrSet("emporix_category.sneakers", "slug.en"),
+					resource.TestCheckResourceAttrSet("emporix_category.sneakers", "position"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "emporix_category.shoes",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Rename and move to the root: the generated slug is kept
+			{
+				Config: testAccCategoryResourceConfig("Trainers", "null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("emporix_category.sneakers", "name.en", "Trainers"),
+					resource.TestCheckNoResourceAttr("emporix_category.sneakers", "parent_id"),
+					resource.TestCheckResourceAttr("emporix_category.sneakers", "slug.en", "sneakers"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCategoryAssignmentResource_basic(t *testing.T) {
+	// Products aren't managed by the provider, so the test needs an existing one
+	productID := testAccFixture(t, "EMPORIX_TEST_PRODUCT_ID", "tf-test-product")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCategoryAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCategoryAssignmentResourceConfig(productID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("emporix_category_assignment.test", "id"),
+					resource.TestCheckResourceAttrPair("emporix_category_assignment.test", "category_id", "emporix_category.test", "id"),
+					resource.TestCheckResourceAttr("emporix_category_assignment.test", "product_id", productID),
+				),
+			},
+			{
+				ResourceName:                         "emporix_category_assignment.test",
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccCategoryAssignmentImportStateIdFunc("emporix_category_assignment.test"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "product_id",
+			},
+		},
+	})
+}
+
+// testAccCategoryResourceConfig generates a root category with a mixin and a subcategory below parentID
+func testAccCategoryResourceConfig(childName, parentID string) string {
+	return fmt.Sprintf(`
+resource "emporix_category" "shoes" {
+  name = {
+    en = "Shoes"
+  }
+  slug = {
+    en = "tf-shoes"
+  }
+  published = true
+
+  mixins = [
+    {
+      name       = "seo"
+      schema_url = "https://res.cloudinary.com/saas-ag/raw/upload/schemata/seo.json"
+      fields = jsonencode({
+        keywords = "shoes"
+      })
+    }
+  ]
+}
+
+resource "emporix_category" "sneakers" {
+  name = {
+    en = %[1]q
+  }
+  parent_id = %[2]s
+}
+`, childName, parentID)
+}
+
+// testAccCategoryAssignmentResourceConfig generates a category with the given product assigned
+func testAccCategoryAssignmentResourceConfig(productID string) string {
+	return fmt.Sprintf(`
+resource "emporix_category" "test" {
+  name = {
+    en = "Terraform Assignment Test"
+  }
+}
+
+resource "emporix_category_assignment" "test" {
+  category_id = emporix_category.test.id
+  product_id  = %[1]q
+}
+`, productID)
+}
+
+// testAccCategoryAssignmentImportStateIdFunc builds the "category_id:product_id" import identifier from state,
+// since the category id is server-generated and unknown ahead of time.
+func testAccCategoryAssignmentImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes["category_id"], rs.Primary.Attributes["product_id"]), nil
+	}
+}
+
+// testAccCheckCategoryDestroy verifies that categories have been deleted
+func testAccCheckCategoryDestroy(s *terraform.State) error {
+	ctx := context.Background()
+
+	client, err := getTestClient()
+	if err != nil {
+		return fmt.Errorf("failed to get test client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "emporix_category" {
+			continue
+		}
+
+		_, err := client.GetCategory(ctx, rs.Primary.ID)
+		if IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("unexpected error checking category: %w", err)
+		}
+
+		return fmt.Errorf("category %s still exists after destroy", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+// testAccCheckCategoryAssignmentDestroy verifies that category assignments have been removed
+func testAccCheckCategoryAssignmentDestroy(s *terraform.State) error {
+	ctx := context.Background()
+
+	client, err := getTestClient()
+	if err != nil {
+		return fmt.Errorf("failed to get test client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "emporix_category_assignment" {
+			continue
+		}
+
+		categoryID := rs.Primary.Attributes["category_id"]
+		productID := rs.Primary.Attributes["product_id"]
+
+		_, err := client.GetCategoryAssignment(ctx, categoryID, productID)
+		if IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("unexpected error checking category assignment: %w", err)
+		}
+
+		return fmt.Errorf("product %s is still assigned to category %s after destroy", productID, categoryID)
+	}
+
+	return nil
+}
@@ -5,8 +5,8 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -18,6 +18,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &CountryResource{}
 var _ resource.ResourceWithImportState = &CountryResource{}
+var _ resource.ResourceWithIdentity = &CountryResource{}
 
 func NewCountryResource() resource.Resource {
 	return &CountryResource{}
@@ -97,6 +98,17 @@ func (r *CountryResource) Schema(ctx context.Context, req resource.SchemaRequest
 	}
 }
 
+func (r *CountryResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"code": identityschema.StringAttribute{
+				Description:       "Country code (e.g., US, GB, DE).",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *CountryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -150,7 +162,7 @@ func (r *CountryResource) Create(ctx context.Context, req resource.CreateRequest
 
 		country, err = r.client.UpdateCountry(ctx, data.Code.ValueString(), updateData)
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update country, got error: %s", err))
+			addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to update country, got error: %s", err))
 			return
 		}
 	}
@@ -159,6 +171,7 @@ func (r *CountryResource) Create(ctx context.Context, req resource.CreateRequest
 	mapCountryToModel(ctx, country, &data, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *CountryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -189,6 +202,7 @@ func (r *CountryResource) Read(ctx context.Context, req resource.ReadRequest, re
 	mapCountryToModel(ctx, country, &data, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *CountryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -213,7 +227,7 @@ func (r *CountryResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Update country via API
 	country, err := r.client.UpdateCountry(ctx, data.Code.ValueString(), updateData)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update country, got error: %s", err))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to update country, got error: %s", err))
 		return
 	}
 
@@ -221,6 +235,7 @@ func (r *CountryResource) Update(ctx context.Context, req resource.UpdateRequest
 	mapCountryToModel(ctx, country, &data, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *CountryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -251,6 +266,6 @@ func (r *CountryResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *CountryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import by country code (e.g., "US", "GB", "DE")
-	resource.ImportStatePassthroughID(ctx, path.Root("code"), req, resp)
+	// Import by country code (e.g., "US", "GB", "DE"), or by identity { code }
+	importState(ctx, req, resp, "code")
 }
@@ -0,0 +1,391 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CouponResource{}
+var _ resource.ResourceWithImportState = &CouponResource{}
+var _ resource.ResourceWithIdentity = &CouponResource{}
+var _ resource.ResourceWithValidateConfig = &CouponResource{}
+
+func NewCouponResource() resource.Resource {
+	return &CouponResource{}
+}
+
+// CouponResource defines the resource implementation.
+type CouponResource struct {
+	client *EmporixClient
+}
+
+// CouponResourceModel describes the resource data model.
+type CouponResourceModel struct {
+	Code                      types.String  `tfsdk:"code"`
+	Name                      types.String  `tfsdk:"name"`
+	Description               types.String  `tfsdk:"description"`
+	DiscountType              types.String  `tfsdk:"discount_type"`
+	DiscountPercentage        types.Float64 `tfsdk:"discount_percentage"`
+	DiscountAbsolute          types.Object  `tfsdk:"discount_absolute"`
+	Validity                  types.Object  `tfsdk:"validity"`
+	MaxRedemptions            types.Int64   `tfsdk:"max_redemptions"`
+	MaxRedemptionsPerCustomer types.Int64   `tfsdk:"max_redemptions_per_customer"`
+	SegmentIDs                types.Set     `tfsdk:"segment_ids"`
+}
+
+// int64OrNull returns a null Int64 for zero, for optional counts the API omits when unset
+func int64OrNull(v int64) types.Int64 {
+	if v == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(v)
+}
+
+// mapCouponToModel converts a Coupon API response to a CouponResourceModel
+func mapCouponToModel(ctx context.Context, coupon *Coupon, data *CouponResourceModel, diags *diag.Diagnostics) {
+	data.Code = types.StringValue(coupon.Code)
+	data.Name = types.StringValue(coupon.Name)
+	data.Description = stringOrNull(coupon.Description)
+	data.DiscountType = types.StringValue(coupon.DiscountType)
+	data.Validity = validityToObject(ctx, coupon.Validity, diags)
+	data.MaxRedemptions = int64OrNull(coupon.MaxRedemptions)
+	data.MaxRedemptionsPerCustomer = int64OrNull(coupon.MaxRedemptionsPerCustomer)
+
+	if coupon.DiscountType == "PERCENT" {
+		data.DiscountPercentage = types.Float64Value(coupon.DiscountPercentage)
+	} else {
+		data.DiscountPercentage = types.Float64Null()
+	}
+
+	if coupon.DiscountAbsolute != nil {
+		discount := MonetaryAmountModel{
+			Amount:   types.Float64Value(coupon.DiscountAbsolute.Amount),
+			Currency: types.StringValue(coupon.DiscountAbsolute.Currency),
+		}
+		obj, d := types.ObjectValueFrom(ctx, discount.AttributeTypes(), discount)
+		diags.Append(d...)
+		data.DiscountAbsolute = obj
+	} else {
+		data.DiscountAbsolute = types.ObjectNull(MonetaryAmountModel{}.AttributeTypes())
+	}
+
+	if len(coupon.SegmentIDs) > 0 {
+		segmentIDs, d := types.SetValueFrom(ctx, types.StringType, coupon.SegmentIDs)
+		diags.Append(d...)
+		data.SegmentIDs = segmentIDs
+	} else {
+		data.SegmentIDs = types.SetNull(types.StringType)
+	}
+}
+
+// couponFromModel converts a CouponResourceModel to a Coupon API payload
+func couponFromModel(ctx context.Context, data *CouponResourceModel, diags *diag.Diagnostics) *Coupon {
+	coupon := &Coupon{
+		Code:                      data.Code.ValueString(),
+		Name:                      data.Name.ValueString(),
+		Description:               data.Description.ValueString(),
+		DiscountType:              data.DiscountType.ValueString(),
+		DiscountPercentage:        data.DiscountPercentage.ValueFloat64(),
+		Validity:                  validityFromObject(ctx, data.Validity, diags),
+		MaxRedemptions:            data.MaxRedemptions.ValueInt64(),
+		MaxRedemptionsPerCustomer: data.MaxRedemptionsPerCustomer.ValueInt64(),
+	}
+
+	if !data.DiscountAbsolute.IsNull() && !data.DiscountAbsolute.IsUnknown() {
+		var discount MonetaryAmountModel
+		diags.Append(data.DiscountAbsolute.As(ctx, &discount, basetypes.ObjectAsOptions{})...)
+		coupon.DiscountAbsolute = &MonetaryAmount{
+			Amount:   discount.Amount.ValueFloat64(),
+			Currency: discount.Currency.ValueString(),
+		}
+	}
+
+	if !data.SegmentIDs.IsNull() && !data.SegmentIDs.IsUnknown() {
+		diags.Append(data.SegmentIDs.ElementsAs(ctx, &coupon.SegmentIDs, false)...)
+	}
+
+	return coupon
+}
+
+func (r *CouponResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_coupon"
+}
+
+func (r *CouponResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a coupon of the Emporix coupon service: a code customers enter at checkout for a discount. " +
+			"Suited for evergreen codes such as welcome or referral coupons that should be the same on every tenant.",
+
+		Attributes: map[string]schema.Attribute{
+			"code": schema.StringAttribute{
+				MarkdownDescription: "Code customers enter to redeem the coupon (e.g., 'WELCOME10'). Identifies the coupon. Letters, digits, '-' and '_' only. " +
+					"Changing this forces a new coupon to be created.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[A-Za-z0-9_-]+$`),
+						"must contain only letters, digits, '-' and '_'",
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the coupon (e.g., 'Welcome discount').",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the coupon.",
+				Optional:            true,
+			},
+			"discount_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the discount: `PERCENT` (set `discount_percentage`), `ABSOLUTE` (set `discount_absolute`) or `FREE_SHIPPING` (no discount value).",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("PERCENT", "ABSOLUTE", "FREE_SHIPPING"),
+				},
+			},
+			"discount_percentage": schema.Float64Attribute{
+				MarkdownDescription: "Discount in percent of the order value (0.01 to 100), for `PERCENT` coupons.",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.Between(0.01, 100),
+				},
+			},
+			"discount_absolute": schema.SingleNestedAttribute{
+				MarkdownDescription: "Discount as an amount of money, for `ABSOLUTE` coupons.",
+				Optional:            true,
+				Attributes:          monetaryAmountAttributes(),
+			},
+			"validity": validityAttribute("Period in which the coupon can be redeemed. Omit for a coupon that is always valid."),
+			"max_redemptions": schema.Int64Attribute{
+				MarkdownDescription: "How often the coupon can be redeemed in total. Omit for no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_redemptions_per_customer": schema.Int64Attribute{
+				MarkdownDescription: "How often one customer can redeem the coupon. Omit for no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"segment_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the customer segments whose customers can redeem the coupon. Omit to allow all customers.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *CouponResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"code": identityschema.StringAttribute{
+				Description:       "Coupon code.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *CouponResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks that the discount value matches the discount type, and the validity period
+func (r *CouponResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CouponResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateValidity(ctx, data.Validity, path.Root("validity"), &resp.Diagnostics)
+
+	if data.DiscountType.IsNull() || data.DiscountType.IsUnknown() {
+		return
+	}
+	discountType := data.DiscountType.ValueString()
+
+	// Which discount value each type needs; the other must not be set
+	needs := map[string]string{
+		"PERCENT":  "discount_percentage",
+		"ABSOLUTE": "discount_absolute",
+	}
+	set := map[string]bool{
+		"discount_percentage": !data.DiscountPercentage.IsNull(),
+		"discount_absolute":   !data.DiscountAbsolute.IsNull(),
+	}
+	for _, attr := range []string{"discount_percentage", "discount_absolute"} {
+		switch {
+		case needs[discountType] == attr && !set[attr]:
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr),
+				"Missing Discount Value",
+				fmt.Sprintf("%s is required when discount_type is %s.", attr, discountType),
+			)
+		case needs[discountType] != attr && set[attr]:
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr),
+				"Unexpected Discount Value",
+				fmt.Sprintf("%s cannot be set when discount_type is %s.", attr, discountType),
+			)
+		}
+	}
+}
+
+func (r *CouponResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CouponResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating coupon", map[string]interface{}{
+		"code": data.Code.ValueString(),
+	})
+
+	coupon := couponFromModel(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateCoupon(ctx, coupon)
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to create coupon, got error: %s", err))
+		return
+	}
+
+	mapCouponToModel(ctx, created, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *CouponResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CouponResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading coupon", map[string]interface{}{
+		"code": data.Code.ValueString(),
+	})
+
+	coupon, err := r.client.GetCoupon(ctx, data.Code.ValueString())
+	if err != nil {
+		// If the coupon was deleted outside Terraform, remove from state (drift detection)
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read coupon, got error: %s", err))
+		return
+	}
+
+	mapCouponToModel(ctx, coupon, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *CouponResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CouponResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating coupon", map[string]interface{}{
+		"code": data.Code.ValueString(),
+	})
+
+	coupon := couponFromModel(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateCoupon(ctx, data.Code.ValueString(), coupon)
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to update coupon, got error: %s", err))
+		return
+	}
+
+	mapCouponToModel(ctx, updated, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *CouponResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CouponResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Deleting coupon", map[string]interface{}{
+		"code": data.Code.ValueString(),
+	})
+
+	err := r.client.DeleteCoupon(ctx, data.Code.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete coupon, got error: %s", err),
+		)
+		return
+	}
+}
+
+func (r *CouponResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by coupon code, or by identity { code }
+	importState(ctx, req, resp, "code")
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccCouponResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCouponDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCouponResourceConfig("Welcome", `
+  discount_type = "ABSOLUTE"
+  discount_absolute = {
+    amount   = 10
+    currency = "EUR"
+  }
+  validity = {
+    from = "2025-01-01T00:00:00.000Z"
+  }
+  max_redemptions_per_customer = 1`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("emporix_coupon.test", "code", "TFACC-WELCOME"),
+					resource.TestCheckResourceAttr("emporix_coupon.test", "name", "Welcome"),
+					resource.TestCheckResourceAttr("emporix_coupon.test", "discount_type", "ABSOLUTE"),
+					resource.TestCheckResourceAttr("emporix_coupon.test", "discount_absolute.amount", "10"),
+					resource.TestCheckResourceAttr("emporix_coupon.test", "discount_absolute.currency", "EUR"),
+					resource.TestCheckResourceAttr("emporix_coupon.test", "validity.from", "2025-01-01T00:00:00.000Z"),
+					resource.TestCheckResourceAttr("emporix_coupon.test", "max_redemptions_per_customer", "1"),
+					resource.TestCheckNoResourceAttr("emporix_coupon.test", "max_redemptions"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "emporix_coupon.test",
+				ImportState:                          true,
+				ImportStateId:                        "TFACC-WELCOME",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "code",
+			},
+			// Update testing: switch to a percentage restricted to a segment
+			{
+				Config: testAccCouponResourceConfig("Welcome VIP", `
+  discount_type       = "PERCENT"
+  discount_percentage = 15
+  max_redemptions     = 500
+  segment_ids         = ["vip"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("emporix_coupon.test", "name", "Welcome VIP"),
+					resource.TestCheckResourceAttr("emporix_coupon.test", "discount_percentage", "15"),
+					resource.TestCheckNoResourceAttr("emporix_coupon.test", "discount_absolute.amount"),
+					resource.TestCheckNoResourceAttr("emporix_coupon.test", "validity.from"),
+					resource.TestCheckResourceAttr("emporix_coupon.test", "max_redemptions", "500"),
+					resource.TestCheckTypeSetElemAttr("emporix_coupon.test", "segment_ids.*", "vip"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCouponResource_invalidDiscount(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCouponResourceConfig("Welcome", `
+  discount_type = "PERCENT"`),
+				ExpectError: regexp.MustCompile(`Missing Discount Value`),
+			},
+			{
+				Config: testAccCouponResourceConfig("Welcome", `
+  discount_type       = "FREE_SHIPPING"
+  discount_percentage = 10`),
+				ExpectError: regexp.MustCompile(`Unexpected Discount Value`),
+			},
+		},
+	})
+}
+
+// testAccCouponResourceConfig generates the TFACC-WELCOME coupon with the given discount attributes
+func testAccCouponResourceConfig(name, discount string) string {
+	return fmt.Sprintf(`
+resource "emporix_coupon" "test" {
+  code = "TFACC-WELCOME"
+  name = %[1]q
+%[2]s
+}
+`, name, discount)
+}
+
+func testAccCheckCouponDestroy(s *terraform.State) error {
+	ctx := context.Background()
+
+	client, err := getTestClient()
+	if err != nil {
+		return fmt.Errorf("failed to get test client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "emporix_coupon" {
+			continue
+		}
+
+		code := rs.Primary.Attributes["code"]
+		_, err := client.GetCoupon(ctx, code)
+		if IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("unexpected error checking coupon: %w", err)
+		}
+
+		return fmt.Errorf("coupon %s still exists after destroy", code)
+	}
+
+	return nil
+}
@@ -5,8 +5,8 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -17,6 +17,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &CurrencyResource{}
 var _ resource.ResourceWithImportState = &CurrencyResource{}
+var _ resource.ResourceWithIdentity = &CurrencyResource{}
 
 func NewCurrencyResource() resource.Resource {
 	return &CurrencyResource{}
@@ -78,6 +79,17 @@ func (r *CurrencyResource) Schema(ctx context.Context, req resource.SchemaReques
 	}
 }
 
+func (r *CurrencyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"code": identityschema.StringAttribute{
+				Description:       "Currency code (e.g., USD, EUR, GBP).",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *CurrencyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -122,7 +134,7 @@ func (r *CurrencyResource) Create(ctx context.Context, req resource.CreateReques
 
 	currency, err := r.client.CreateCurrency(ctx, currencyCreate)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create currency, got error: %s", err))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to create currency, got error: %s", err))
 		return
 	}
 
@@ -130,6 +142,7 @@ func (r *CurrencyResource) Create(ctx context.Context, req resource.CreateReques
 	mapCurrencyToModel(ctx, currency, &data, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *CurrencyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -160,6 +173,7 @@ func (r *CurrencyResource) Read(ctx context.Context, req resource.ReadRequest, r
 	mapCurrencyToModel(ctx, currency, &data, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *CurrencyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -189,7 +203,7 @@ func (r *CurrencyResource) Update(ctx context.Context, req resource.UpdateReques
 	// Update currency via API
 	currency, err := r.client.UpdateCurrency(ctx, data.Code.ValueString(), updateData)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update currency, got error: %s", err))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to update currency, got error: %s", err))
 		return
 	}
 
@@ -197,6 +211,7 @@ func (r *CurrencyResource) Update(ctx context.Context, req resource.UpdateReques
 	mapCurrencyToModel(ctx, currency, &data, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *CurrencyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -225,6 +240,6 @@ func (r *CurrencyResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *CurrencyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import by currency code (e.g., "USD", "EUR", "GBP")
-	resource.ImportStatePassthroughID(ctx, path.Root("code"), req, resp)
+	// Import by currency code (e.g., "USD", "EUR", "GBP"), or by identity { code }
+	importState(ctx, req, resp, "code")
 }
@@ -4,13 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
@@ -26,6 +26,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &CustomEntityInstanceResource{}
 var _ resource.ResourceWithImportState = &CustomEntityInstanceResource{}
+var _ resource.ResourceWithIdentity = &CustomEntityInstanceResource{}
 var _ resource.ResourceWithValidateConfig = &CustomEntityInstanceResource{}
 
 func NewCustomEntityInstanceResource() resource.Resource {
@@ -152,6 +153,21 @@ func (r *CustomEntityInstanceResource) Schema(ctx context.Context, req resource.
 	}
 }
 
+func (r *CustomEntityInstanceResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"type": identityschema.StringAttribute{
+				Description:       "Custom entity type ID.",
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "Custom entity instance ID.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *CustomEntityInstanceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -242,13 +258,14 @@ func (r *CustomEntityInstanceResource) Create(ctx context.Context, req resource.
 
 	instance, err := r.client.CreateCustomEntityInstance(ctx, entityType, createData)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom entity instance, got error: %s", err))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to create custom entity instance, got error: %s", err))
 		return
 	}
 
 	mapCustomEntityInstanceToModel(ctx, instance, entityType, &data, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *CustomEntityInstanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -279,6 +296,7 @@ func (r *CustomEntityInstanceResource) Read(ctx context.Context, req resource.Re
 	mapCustomEntityInstanceToModel(ctx, instance, entityType, &data, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *CustomEntityInstanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -323,13 +341,14 @@ func (r *CustomEntityInstanceResource) Update(ctx context.Context, req resource.
 
 	instance, err := r.client.UpdateCustomEntityInstance(ctx, entityType, data.ID.ValueString(), updateData)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update custom entity instance, got error: %s", err))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to update custom entity instance, got error: %s", err))
 		return
 	}
 
 	mapCustomEntityInstanceToModel(ctx, instance, entityType, &data, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *CustomEntityInstanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -354,18 +373,8 @@ func (r *CustomEntityInstanceResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *CustomEntityInstanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: "type:id" (e.g. "DOCUMENT:doc-123")
-	parts := strings.Split(req.ID, ":")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID in format 'type:id', got: %s", req.ID),
-		)
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), parts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+	// Import ID format: "type:id" (e.g. "DOCUMENT:doc-123"), or identity { type, id }. The id may contain ":".
+	importStateLastTakesRest(ctx, req, resp, "type", "id")
 }
 
 // customEntityOwnerFromModel converts the "owner" attribute from plan/config into an API payload.
@@ -7,8 +7,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -20,6 +20,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &CustomEntityTypeResource{}
 var _ resource.ResourceWithImportState = &CustomEntityTypeResource{}
+var _ resource.ResourceWithIdentity = &CustomEntityTypeResource{}
 
 func NewCustomEntityTypeResource() resource.Resource {
 	return &CustomEntityTypeResource{}
@@ -78,6 +79,17 @@ func (r *CustomEntityTypeResource) Schema(ctx context.Context, req resource.Sche
 	}
 }
 
+func (r *CustomEntityTypeResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "Custom entity type ID.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *CustomEntityTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -118,13 +130,14 @@ func (r *CustomEntityTypeResource) Create(ctx context.Context, req resource.Crea
 		Name: nameMap,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom entity type, got error: %s", err))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to create custom entity type, got error: %s", err))
 		return
 	}
 
 	mapCustomEntityTypeToModel(ctx, entityType, &data, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *CustomEntityTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -152,6 +165,7 @@ func (r *CustomEntityTypeResource) Read(ctx context.Context, req resource.ReadRe
 	mapCustomEntityTypeToModel(ctx, entityType, &data, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *CustomEntityTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -178,13 +192,14 @@ func (r *CustomEntityTypeResource) Update(ctx context.Context, req resource.Upda
 
 	entityType, err := r.client.UpdateCustomEntityType(ctx, data.ID.ValueString(), updateData)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update custom entity type, got error: %s", err))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to update custom entity type, got error: %s", err))
 		return
 	}
 
 	mapCustomEntityTypeToModel(ctx, entityType, &data, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *CustomEntityTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -208,7 +223,8 @@ func (r *CustomEntityTypeResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *CustomEntityTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Import by custom entity type ID, or by identity { id }
+	importState(ctx, req, resp, "id")
 }
 
 // mapCustomEntityTypeToModel converts a CustomEntityType API response into a CustomEntityTypeResourceModel.
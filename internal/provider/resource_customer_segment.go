@@ -0,0 +1,379 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CustomerSegmentResource{}
+var _ resource.ResourceWithImportState = &CustomerSegmentResource{}
+var _ resource.ResourceWithIdentity = &CustomerSegmentResource{}
+var _ resource.ResourceWithValidateConfig = &CustomerSegmentResource{}
+
+func NewCustomerSegmentResource() resource.Resource {
+	return &CustomerSegmentResource{}
+}
+
+// CustomerSegmentResource defines the resource implementation.
+type CustomerSegmentResource struct {
+	client *EmporixClient
+}
+
+// CustomerSegmentResourceModel describes the resource data model.
+type CustomerSegmentResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.Map    `tfsdk:"name"`
+	Description types.Map    `tfsdk:"description"`
+	SiteCode    types.String `tfsdk:"site_code"`
+	Validity    types.Object `tfsdk:"validity"`
+	CustomerIDs types.Set    `tfsdk:"customer_ids"`
+}
+
+// mapCustomerSegmentToModel converts a CustomerSegment API response to a CustomerSegmentResourceModel.
+// Members are read separately, see readMembers.
+func mapCustomerSegmentToModel(ctx context.Context, segment *CustomerSegment, data *CustomerSegmentResourceModel, diags *diag.Diagnostics) {
+	data.ID = types.StringValue(segment.ID)
+	data.SiteCode = types.StringValue(segment.SiteCode)
+	data.Name = localizedMapOrNull(ctx, segment.Name, diags)
+	data.Description = localizedMapOrNull(ctx, segment.Description, diags)
+	data.Validity = validityToObject(ctx, segment.Validity, diags)
+}
+
+// customerSegmentFromModel converts a CustomerSegmentResourceModel to a CustomerSegment API payload
+func customerSegmentFromModel(ctx context.Context, data *CustomerSegmentResourceModel, diags *diag.Diagnostics) *CustomerSegment {
+	segment := &CustomerSegment{
+		Name:     localizedMapFromModel(ctx, data.Name, diags),
+		SiteCode: data.SiteCode.ValueString(),
+		Validity: validityFromObject(ctx, data.Validity, diags),
+	}
+
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		segment.Description = localizedMapFromModel(ctx, data.Description, diags)
+	}
+
+	return segment
+}
+
+func (r *CustomerSegmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_customer_segment"
+}
+
+func (r *CustomerSegmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a customer segment of an Emporix site. " +
+			"Products and categories are attached to a segment with `emporix_customer_segment_item_assignment`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the segment, generated by the API.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.MapAttribute{
+				MarkdownDescription: "Segment name as a map of language code to name (e.g., {\"en\": \"B2B Customers\"}). " +
+					"Provide at least one language translation.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"description": schema.MapAttribute{
+				MarkdownDescription: "Segment description as a map of language code to text.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"site_code": schema.StringAttribute{
+				MarkdownDescription: "Code of the site the segment belongs to.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"validity": validityAttribute("Period in which the segment applies. Omit for a segment that is always valid."),
+			"customer_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the customers in the segment. When set, Terraform manages the complete member list " +
+					"and removes customers added elsewhere; when omitted, members are not managed.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *CustomerSegmentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "Customer segment ID.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *CustomerSegmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CustomerSegmentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CustomerSegmentResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateValidity(ctx, data.Validity, path.Root("validity"), &resp.Diagnostics)
+}
+
+// readMembers sets the members of the segment in data, unless members aren't managed (customer_ids is null)
+func (r *CustomerSegmentResource) readMembers(ctx context.Context, data *CustomerSegmentResourceModel, diags *diag.Diagnostics) {
+	if data.CustomerIDs.IsNull() {
+		return
+	}
+
+	members, err := r.client.ListCustomerSegmentMembers(ctx, data.ID.ValueString(), nil)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read customer segment members, got error: %s", err))
+		return
+	}
+
+	ids := make([]string, len(members))
+	for i, member := range members {
+		ids[i] = member.ID
+	}
+	customerIDs, d := types.SetValueFrom(ctx, types.StringType, ids)
+	diags.Append(d...)
+	data.CustomerIDs = customerIDs
+}
+
+// syncMembers adds and removes customers so the segment has exactly the planned members.
+// current are the members known from state, or nil for a new segment.
+func (r *CustomerSegmentResource) syncMembers(ctx context.Context, segmentID string, planned types.Set, current []string, diags *diag.Diagnostics) {
+	if planned.IsNull() || planned.IsUnknown() {
+		return
+	}
+
+	var want []string
+	diags.Append(planned.ElementsAs(ctx, &want, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	wanted := make(map[string]bool, len(want))
+	for _, id := range want {
+		wanted[id] = true
+	}
+	existing := make(map[string]bool, len(current))
+	for _, id := range current {
+		existing[id] = true
+	}
+
+	// Sorted so that requests are made in a stable order
+	sort.Strings(want)
+	for _, id := range want {
+		if existing[id] {
+			continue
+		}
+		if err := r.client.AddCustomerSegmentMember(ctx, segmentID, id); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to add customer %s to customer segment, got error: %s", id, err))
+			return
+		}
+	}
+	for _, id := range current {
+		if wanted[id] {
+			continue
+		}
+		err := r.client.RemoveCustomerSegmentMember(ctx, segmentID, id)
+		if apiErr, ok := AsAPIError(err); ok && apiErr.StatusCode == http.StatusNotFound {
+			// Already removed elsewhere
+			continue
+		}
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to remove customer %s from customer segment, got error: %s", id, err))
+			return
+		}
+	}
+}
+
+func (r *CustomerSegmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CustomerSegmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating customer segment", map[string]interface{}{
+		"site_code": data.SiteCode.ValueString(),
+	})
+
+	segment := customerSegmentFromModel(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateCustomerSegment(ctx, segment)
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to create customer segment, got error: %s", err))
+		return
+	}
+
+	mapCustomerSegmentToModel(ctx, created, &data, &resp.Diagnostics)
+
+	// Save the segment before adding members, so a failed member doesn't orphan it. Members are
+	// saved as unmanaged until added, so the next apply adds the missing ones.
+	saved := data
+	saved.CustomerIDs = types.SetNull(types.StringType)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &saved)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.syncMembers(ctx, created.ID, data.CustomerIDs, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.readMembers(ctx, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomerSegmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CustomerSegmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading customer segment", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	segment, err := r.client.GetCustomerSegment(ctx, data.ID.ValueString())
+	if err != nil {
+		// If resource not found, remove from state (drift detection)
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read customer segment, got error: %s", err))
+		return
+	}
+
+	mapCustomerSegmentToModel(ctx, segment, &data, &resp.Diagnostics)
+	r.readMembers(ctx, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *CustomerSegmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CustomerSegmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating customer segment", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	segment := customerSegmentFromModel(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateCustomerSegment(ctx, data.ID.ValueString(), segment)
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to update customer segment, got error: %s", err))
+		return
+	}
+
+	mapCustomerSegmentToModel(ctx, updated, &data, &resp.Diagnostics)
+
+	// Members in state are the ones last read; when they weren't managed, start from the actual members
+	var current []string
+	if !state.CustomerIDs.IsNull() {
+		resp.Diagnostics.Append(state.CustomerIDs.ElementsAs(ctx, &current, false)...)
+	} else if !data.CustomerIDs.IsNull() {
+		members, err := r.client.ListCustomerSegmentMembers(ctx, data.ID.ValueString(), nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read customer segment members, got error: %s", err))
+			return
+		}
+		for _, member := range members {
+			current = append(current, member.ID)
+		}
+	}
+	r.syncMembers(ctx, data.ID.ValueString(), data.CustomerIDs, current, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.readMembers(ctx, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *CustomerSegmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CustomerSegmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Deleting customer segment", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteCustomerSegment(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete customer segment, got error: %s", err),
+		)
+		return
+	}
+}
+
+func (r *CustomerSegmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by segment ID, or by identity { id }. Members are managed once customer_ids is configured.
+	importState(ctx, req, resp, "id")
+}
@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CustomerSegmentItemAssignmentResource{}
+var _ resource.ResourceWithImportState = &CustomerSegmentItemAssignmentResource{}
+var _ resource.ResourceWithIdentity = &CustomerSegmentItemAssignmentResource{}
+
+func NewCustomerSegmentItemAssignmentResource() resource.Resource {
+	return &CustomerSegmentItemAssignmentResource{}
+}
+
+// CustomerSegmentItemAssignmentResource defines the resource implementation.
+type CustomerSegmentItemAssignmentResource struct {
+	client *EmporixClient
+}
+
+// CustomerSegmentItemAssignmentResourceModel describes the resource data model.
+type CustomerSegmentItemAssignmentResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	SegmentID types.String `tfsdk:"segment_id"`
+	ItemType  types.String `tfsdk:"item_type"`
+	ItemID    types.String `tfsdk:"item_id"`
+}
+
+func (r *CustomerSegmentItemAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_customer_segment_item_assignment"
+}
+
+func (r *CustomerSegmentItemAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Attaches a product or category to a customer segment. " +
+			"Each assignment links one item to one segment; changing any attribute creates a new assignment.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the assignment, generated by the API.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"segment_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the customer segment the item is attached to.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"item_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the attached item: `PRODUCT` or `CATEGORY`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("PRODUCT", "CATEGORY"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"item_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the attached product or category.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CustomerSegmentItemAssignmentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"segment_id": identityschema.StringAttribute{
+				Description:       "ID of the customer segment.",
+				RequiredForImport: true,
+			},
+			"item_type": identityschema.StringAttribute{
+				Description:       "Type of the attached item (PRODUCT or CATEGORY).",
+				RequiredForImport: true,
+			},
+			"item_id": identityschema.StringAttribute{
+				Description:       "ID of the attached product or category.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *CustomerSegmentItemAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CustomerSegmentItemAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CustomerSegmentItemAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating customer segment item assignment", map[string]interface{}{
+		"segment_id": data.SegmentID.ValueString(),
+		"item_type":  data.ItemType.ValueString(),
+		"item_id":    data.ItemID.ValueString(),
+	})
+
+	item, err := r.client.CreateCustomerSegmentItem(ctx, data.SegmentID.ValueString(), data.ItemType.ValueString(), data.ItemID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to attach item to customer segment, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(item.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *CustomerSegmentItemAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CustomerSegmentItemAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading customer segment item assignment", map[string]interface{}{
+		"segment_id": data.SegmentID.ValueString(),
+		"item_type":  data.ItemType.ValueString(),
+		"item_id":    data.ItemID.ValueString(),
+	})
+
+	item, err := r.client.GetCustomerSegmentItem(ctx, data.SegmentID.ValueString(), data.ItemType.ValueString(), data.ItemID.ValueString())
+	if err != nil {
+		// If the assignment or its segment is gone, remove from state (drift detection)
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read customer segment item assignment, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(item.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *CustomerSegmentItemAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All attributes require replacement, so there is nothing to update
+	var data CustomerSegmentItemAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *CustomerSegmentItemAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CustomerSegmentItemAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Deleting customer segment item assignment", map[string]interface{}{
+		"segment_id": data.SegmentID.ValueString(),
+		"id":         data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteCustomerSegmentItem(ctx, data.SegmentID.ValueString(), data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete customer segment item assignment, got error: %s", err),
+		)
+		return
+	}
+}
+
+func (r *CustomerSegmentItemAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by "segment_id:item_type:item_id", or by identity { segment_id, item_type, item_id }
+	importState(ctx, req, resp, "segment_id", "item_type", "item_id")
+}
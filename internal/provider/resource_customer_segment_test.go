@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccCustomerSegmentResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCustomerSegmentDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCustomerSegmentResourceConfig("VIP", `
+  validity = {
+    from = "2024-01-01T00:00:00.000Z"
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("emporix_customer_segment.test", "id"),
+					resource.TestCheckResourceAttr("emporix_customer_segment.test", "name.en", "VIP"),
+					resource.TestCheckResourceAttr("emporix_customer_segment.test", "name.de", "VIP"),
+					resource.TestCheckResourceAttr("emporix_customer_segment.test", "site_code", "main"),
+					resource.TestCheckResourceAttr("emporix_customer_segment.test", "validity.from", "2024-01-01T00:00:00.000Z"),
+					resource.TestCheckNoResourceAttr("emporix_customer_segment.test", "customer_ids"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "emporix_customer_segment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update testing: rename and drop the validity period
+			{
+				Config: testAccCustomerSegmentResourceConfig("VIP Gold", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("emporix_customer_segment.test", "name.en", "VIP Gold"),
+					resource.TestCheckNoResourceAttr("emporix_customer_segment.test", "validity.from"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCustomerSegmentItemAssignmentResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCustomerSegmentItemAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomerSegmentItemAssignmentResourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("emporix_customer_segment_item_assignment.test", "id"),
+					resource.TestCheckResourceAttrPair("emporix_customer_segment_item_assignment.test", "segment_id", "emporix_customer_segment.test", "id"),
+					resource.TestCheckResourceAttrPair("emporix_customer_segment_item_assignment.test", "item_id", "emporix_category.test", "id"),
+					resource.TestCheckResourceAttr("emporix_customer_segment_item_assignment.test", "item_type", "CATEGORY"),
+				),
+			},
+			{
+				ResourceName:                         "emporix_customer_segment_item_assignment.test",
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccCustomerSegmentItemAssignmentImportStateIdFunc("emporix_customer_segment_item_assignment.test"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "item_id",
+			},
+		},
+	})
+}
+
+func TestAccCustomerSegmentResource_members(t *testing.T) {
+	// Customers aren't managed by the provider, so the test needs existing ones
+	customerID := testAccFixture(t, "EMPORIX_TEST_CUSTOMER_ID", "tf-test-customer")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCustomerSegmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomerSegmentResourceConfig("Members", fmt.Sprintf(`customer_ids = [%q]`, customerID)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("emporix_customer_segment.test", "customer_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("emporix_customer_segment.test", "customer_ids.*", customerID),
+				),
+			},
+			// An empty set removes all members
+			{
+				Config: testAccCustomerSegmentResourceConfig("Members", `customer_ids = []`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("emporix_customer_segment.test", "customer_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestCustomerSegmentResource_SyncMembers(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newFakeAPIClient(t)
+	r := &CustomerSegmentResource{client: client}
+
+	segment, err := client.CreateCustomerSegment(ctx, &CustomerSegment{Name: map[string]string{"en": "VIP"}, SiteCode: "main"})
+	if err != nil {
+		t.Fatalf("unexpected error creating customer segment: %s", err)
+	}
+	for _, customerID := range []string{"kept", "removed", "removed-elsewhere"} {
+		if err := client.AddCustomerSegmentMember(ctx, segment.ID, customerID); err != nil {
+			t.Fatalf("unexpected error adding customer: %s", err)
+		}
+	}
+	if err := client.RemoveCustomerSegmentMember(ctx, segment.ID, "removed-elsewhere"); err != nil {
+		t.Fatalf("unexpected error removing customer: %s", err)
+	}
+
+	planned, _ := types.SetValueFrom(ctx, types.StringType, []string{"kept", "added"})
+	var diags diag.Diagnostics
+	r.syncMembers(ctx, segment.ID, planned, []string{"kept", "removed", "removed-elsewhere"}, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error syncing members: %v", diags)
+	}
+
+	data := &CustomerSegmentResourceModel{ID: types.StringValue(segment.ID), CustomerIDs: planned}
+	r.readMembers(ctx, data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error reading members: %v", diags)
+	}
+	if !data.CustomerIDs.Equal(planned) {
+		t.Errorf("expected members %v, got %v", planned, data.CustomerIDs)
+	}
+}
+
+// testAccCustomerSegmentResourceConfig generates a customer segment of the main site with extra attributes
+func testAccCustomerSegmentResourceConfig(name, extra string) string {
+	return fmt.Sprintf(`
+resource "emporix_customer_segment" "test" {
+  site_code = "main"
+  name = {
+    en = %[1]q
+    de = "VIP"
+  }
+  description = {
+    en = "Managed by Terraform"
+  }
+%[2]s
+}
+`, name, extra)
+}
+
+// testAccCustomerSegmentItemAssignmentResourceConfig generates a segment with a category attached
+func testAccCustomerSegmentItemAssignmentResourceConfig() string {
+	return `
+resource "emporix_customer_segment" "test" {
+  site_code = "main"
+  name = {
+    en = "Terraform Item Test"
+  }
+}
+
+resource "emporix_category" "test" {
+  name = {
+    en = "Terraform Segment Category"
+  }
+}
+
+resource "emporix_customer_segment_item_assignment" "test" {
+  segment_id = emporix_customer_segment.test.id
+  item_type  = "CATEGORY"
+  item_id    = emporix_category.test.id
+}
+`
+}
+
+// testAccCustomerSegmentItemAssignmentImportStateIdFunc builds the "segment_id:item_type:item_id" import
+// identifier from state, since the segment and category ids are server-generated.
+func testAccCustomerSegmentItemAssignmentImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s:%s:%s", rs.Primary.Attributes["segment_id"], rs.Primary.Attributes["item_type"], rs.Primary.Attributes["item_id"]), nil
+	}
+}
+
+// testAccCheckCustomerSegmentDestroy verifies that customer segments have been deleted
+func testAccCheckCustomerSegmentDestroy(s *terraform.State) error {
+	ctx := context.Background()
+
+	client, err := getTestClient()
+	if err != nil {
+		return fmt.Errorf("failed to get test client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "emporix_customer_segment" {
+			continue
+		}
+
+		_, err := client.GetCustomerSegment(ctx, rs.Primary.ID)
+		if IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("unexpected error checking customer segment: %w", err)
+		}
+
+		return fmt.Errorf("customer segment %s still exists after destroy", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+// testAccCheckCustomerSegmentItemAssignmentDestroy verifies that items have been detached from their segments
+func testAccCheckCustomerSegmentItemAssignmentDestroy(s *terraform.State) error {
+	ctx := context.Background()
+
+	client, err := getTestClient()
+	if err != nil {
+		return fmt.Errorf("failed to get test client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "emporix_customer_segment_item_assignment" {
+			continue
+		}
+
+		segmentID := rs.Primary.Attributes["segment_id"]
+		itemID := rs.Primary.Attributes["item_id"]
+
+		_, err := client.GetCustomerSegmentItem(ctx, segmentID, rs.Primary.Attributes["item_type"], itemID)
+		if IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("unexpected error checking customer segment item assignment: %w", err)
+		}
+
+		return fmt.Errorf("item %s is still attached to customer segment %s after destroy", itemID, segmentID)
+	}
+
+	return nil
+}
@@ -8,8 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &DeliveryTimeResource{}
 	_ resource.ResourceWithConfigure   = &DeliveryTimeResource{}
 	_ resource.ResourceWithImportState = &DeliveryTimeResource{}
+	_ resource.ResourceWithIdentity    = &DeliveryTimeResource{}
 )
 
 func NewDeliveryTimeResource() resource.Resource {
@@ -67,6 +68,13 @@ type TimeRangeModel struct {
 	TimeTo   types.String `tfsdk:"time_to"`
 }
 
+func (m TimeRangeModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"time_from": types.StringType,
+		"time_to":   types.StringType,
+	}
+}
+
 type CutOffTimeModel struct {
 	Time              types.String `tfsdk:"time"`
 	DeliveryCycleName types.String `tfsdk:"delivery_cycle_name"`
@@ -122,6 +130,27 @@ type CutOffTime struct {
 	DeliveryCycleName string `json:"deliveryCycleName"`
 }
 
+// iso8601DateTimePattern matches timestamps in the format the Emporix API uses, e.g. 2024-12-25T10:00:00.000Z
+var iso8601DateTimePattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}\.\d{3}Z$`)
+
+// iso8601DateTimeValidator validates a timestamp attribute against iso8601DateTimePattern
+func iso8601DateTimeValidator() validator.String {
+	return stringvalidator.RegexMatches(iso8601DateTimePattern, "must be in ISO 8601 format (YYYY-MM-DDTHH:MM:SS.sssZ)")
+}
+
+// timeOfDayPattern matches times of day in HH:MM format, e.g. 10:00
+var timeOfDayPattern = regexp.MustCompile(`^([0-1][0-9]|2[0-3]):([0-5][0-9])$`)
+
+// timeOfDayValidator validates a time attribute against timeOfDayPattern; example is shown in the error
+func timeOfDayValidator(example string) validator.String {
+	return stringvalidator.RegexMatches(timeOfDayPattern, fmt.Sprintf("must be in HH:MM format (e.g., '%s')", example))
+}
+
+// weekdayValidator validates a day of the week as the API spells it, e.g. MONDAY
+func weekdayValidator() validator.String {
+	return stringvalidator.OneOf("MONDAY", "TUESDAY", "WEDNESDAY", "THURSDAY", "FRIDAY", "SATURDAY", "SUNDAY")
+}
+
 func (r *DeliveryTimeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_delivery_time"
 }
@@ -183,37 +212,28 @@ func (r *DeliveryTimeResource) Schema(ctx context.Context, req resource.SchemaRe
 						MarkdownDescription: "Day of the week for recurring delivery (MONDAY, TUESDAY, WEDNESDAY, THURSDAY, FRIDAY, SATURDAY, SUNDAY). Use for weekly recurring deliveries.",
 						Optional:            true,
 						Validators: []validator.String{
-							stringvalidator.OneOf("MONDAY", "TUESDAY", "WEDNESDAY", "THURSDAY", "FRIDAY", "SATURDAY", "SUNDAY"),
+							weekdayValidator(),
 						},
 					},
 					"date": schema.StringAttribute{
 						MarkdownDescription: "Specific date for one-time delivery in ISO 8601 format. Example: '2024-12-25T10:00:00.000Z'.",
 						Optional:            true,
 						Validators: []validator.String{
-							stringvalidator.RegexMatches(
-								regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}\.\d{3}Z$`),
-								"must be in ISO 8601 format (YYYY-MM-DDTHH:MM:SS.sssZ)",
-							),
+							iso8601DateTimeValidator(),
 						},
 					},
 					"date_from": schema.StringAttribute{
 						MarkdownDescription: "Start date for delivery date range in ISO 8601 format. Example: '2024-06-01T10:00:00.000Z'.",
 						Optional:            true,
 						Validators: []validator.String{
-							stringvalidator.RegexMatches(
-								regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}\.\d{3}Z$`),
-								"must be in ISO 8601 format (YYYY-MM-DDTHH:MM:SS.sssZ)",
-							),
+							iso8601DateTimeValidator(),
 						},
 					},
 					"date_to": schema.StringAttribute{
 						MarkdownDescription: "End date for delivery date range in ISO 8601 format. Example: '2024-08-31T10:00:00.000Z'.",
 						Optional:            true,
 						Validators: []validator.String{
-							stringvalidator.RegexMatches(
-								regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}\.\d{3}Z$`),
-								"must be in ISO 8601 format (YYYY-MM-DDTHH:MM:SS.sssZ)",
-							),
+							iso8601DateTimeValidator(),
 						},
 					},
 				},
@@ -239,20 +259,14 @@ func (r *DeliveryTimeResource) Schema(ctx context.Context, req resource.SchemaRe
 									MarkdownDescription: "Start time in HH:MM format (e.g., '10:00').",
 									Required:            true,
 									Validators: []validator.String{
-										stringvalidator.RegexMatches(
-											regexp.MustCompile(`^([0-1][0-9]|2[0-3]):([0-5][0-9])$`),
-											"must be in HH:MM format (e.g., '10:00')",
-										),
+										timeOfDayValidator("10:00"),
 									},
 								},
 								"time_to": schema.StringAttribute{
 									MarkdownDescription: "End time in HH:MM format (e.g., '12:00').",
 									Required:            true,
 									Validators: []validator.String{
-										stringvalidator.RegexMatches(
-											regexp.MustCompile(`^([0-1][0-9]|2[0-3]):([0-5][0-9])$`),
-											"must be in HH:MM format (e.g., '12:00')",
-										),
+										timeOfDayValidator("12:00"),
 									},
 								},
 							},
@@ -278,6 +292,17 @@ func (r *DeliveryTimeResource) Schema(ctx context.Context, req resource.SchemaRe
 	}
 }
 
+func (r *DeliveryTimeResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "Delivery time ID.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *DeliveryTimeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -494,7 +519,7 @@ func (r *DeliveryTimeResource) Create(ctx context.Context, req resource.CreateRe
 	// Create via API
 	createdDeliveryTime, err := r.client.CreateDeliveryTime(ctx, deliveryTime)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create delivery time, got error: %s", err))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to create delivery time, got error: %s", err))
 		return
 	}
 
@@ -523,12 +548,13 @@ func (r *DeliveryTimeResource) Create(ctx context.Context, req resource.CreateRe
 
 	// Create fresh state model from API response (don't reuse plan data)
 	var stateModel DeliveryTimeResourceModel
-	r.syncModelFromAPI(ctx, &stateModel, actualDeliveryTime, &resp.Diagnostics)
+	mapDeliveryTimeToModel(ctx, actualDeliveryTime, &stateModel, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *DeliveryTimeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -556,12 +582,13 @@ func (r *DeliveryTimeResource) Read(ctx context.Context, req resource.ReadReques
 
 	// Create fresh state model from API response
 	var stateModel DeliveryTimeResourceModel
-	r.syncModelFromAPI(ctx, &stateModel, actualDeliveryTime, &resp.Diagnostics)
+	mapDeliveryTimeToModel(ctx, actualDeliveryTime, &stateModel, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *DeliveryTimeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -596,7 +623,7 @@ func (r *DeliveryTimeResource) Update(ctx context.Context, req resource.UpdateRe
 	// Update via API
 	_, err := r.client.UpdateDeliveryTime(ctx, data.ID.ValueString(), deliveryTime)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update delivery time, got error: %s", err))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to update delivery time, got error: %s", err))
 		return
 	}
 
@@ -612,12 +639,13 @@ func (r *DeliveryTimeResource) Update(ctx context.Context, req resource.UpdateRe
 
 	// Create fresh state model from API response (don't reuse plan data)
 	var stateModel DeliveryTimeResourceModel
-	r.syncModelFromAPI(ctx, &stateModel, actualDeliveryTime, &resp.Diagnostics)
+	mapDeliveryTimeToModel(ctx, actualDeliveryTime, &stateModel, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *DeliveryTimeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -643,13 +671,12 @@ func (r *DeliveryTimeResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *DeliveryTimeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: "id"
-	// Example: terraform import emporix_delivery_time.example abc123
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Import by delivery time ID, or by identity { id }
+	importState(ctx, req, resp, "id")
 }
 
-// syncModelFromAPI syncs the Terraform model from API response
-func (r *DeliveryTimeResource) syncModelFromAPI(ctx context.Context, model *DeliveryTimeResourceModel, api *DeliveryTime, diags *diag.Diagnostics) {
+// mapDeliveryTimeToModel syncs the Terraform model from API response
+func mapDeliveryTimeToModel(ctx context.Context, api *DeliveryTime, model *DeliveryTimeResourceModel, diags *diag.Diagnostics) {
 	model.ID = types.StringValue(api.ID)
 	model.Name = types.StringValue(api.Name)
 	model.SiteCode = types.StringValue(api.SiteCode)
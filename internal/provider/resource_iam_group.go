@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IAMGroupResource{}
+var _ resource.ResourceWithImportState = &IAMGroupResource{}
+var _ resource.ResourceWithIdentity = &IAMGroupResource{}
+
+func NewIAMGroupResource() resource.Resource {
+	return &IAMGroupResource{}
+}
+
+// IAMGroupResource defines the resource implementation.
+type IAMGroupResource struct {
+	client *EmporixClient
+}
+
+// IAMGroupResourceModel describes the resource data model.
+type IAMGroupResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.Map    `tfsdk:"name"`
+	Description      types.Map    `tfsdk:"description"`
+	UserType         types.String `tfsdk:"user_type"`
+	TemplateIDs      types.Set    `tfsdk:"template_ids"`
+	AccessControlIDs types.Set    `tfsdk:"access_control_ids"`
+}
+
+// iamReferencesToSet converts IAM references to a set of their IDs, or null when there are none
+func iamReferencesToSet(ctx context.Context, refs []IAMReference, diags *diag.Diagnostics) types.Set {
+	if len(refs) == 0 {
+		return types.SetNull(types.StringType)
+	}
+	ids := make([]string, len(refs))
+	for i, ref := range refs {
+		ids[i] = ref.ID
+	}
+	set, d := types.SetValueFrom(ctx, types.StringType, ids)
+	diags.Append(d...)
+	return set
+}
+
+// iamReferencesFromSet converts a set of IDs to IAM references
+func iamReferencesFromSet(ctx context.Context, set types.Set, diags *diag.Diagnostics) []IAMReference {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+	var ids []string
+	diags.Append(set.ElementsAs(ctx, &ids, false)...)
+	refs := make([]IAMReference, len(ids))
+	for i, id := range ids {
+		refs[i] = IAMReference{ID: id}
+	}
+	return refs
+}
+
+// mapIAMGroupToModel converts an IAMGroup API response to an IAMGroupResourceModel
+func mapIAMGroupToModel(ctx context.Context, group *IAMGroup, data *IAMGroupResourceModel, diags *diag.Diagnostics) {
+	data.ID = types.StringValue(group.ID)
+	data.UserType = types.StringValue(group.UserType)
+	data.Name = localizedMapOrNull(ctx, group.Name, diags)
+	data.Description = localizedMapOrNull(ctx, group.Description, diags)
+	data.TemplateIDs = iamReferencesToSet(ctx, group.Templates, diags)
+	data.AccessControlIDs = iamReferencesToSet(ctx, group.AccessControls, diags)
+}
+
+// iamGroupFromModel converts an IAMGroupResourceModel to an IAMGroup API payload
+func iamGroupFromModel(ctx context.Context, data *IAMGroupResourceModel, diags *diag.Diagnostics) *IAMGroup {
+	group := &IAMGroup{
+		Name:           localizedMapFromModel(ctx, data.Name, diags),
+		UserType:       data.UserType.ValueString(),
+		Templates:      iamReferencesFromSet(ctx, data.TemplateIDs, diags),
+		AccessControls: iamReferencesFromSet(ctx, data.AccessControlIDs, diags),
+	}
+
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		group.Description = localizedMapFromModel(ctx, data.Description, diags)
+	}
+
+	return group
+}
+
+func (r *IAMGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_group"
+}
+
+func (r *IAMGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a group of the Emporix IAM service. " +
+			"Members of the group get the permissions of its access control templates and access controls.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the group, generated by the API.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.MapAttribute{
+				MarkdownDescription: "Group name as a map of language code to name (e.g., {\"en\": \"Order Managers\"}). " +
+					"Provide at least one language translation.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"description": schema.MapAttribute{
+				MarkdownDescription: "Group description as a map of language code to text.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"user_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the users in the group: `EMPLOYEE` or `CUSTOMER`. " +
+					"Changing this forces a new group to be created.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("EMPLOYEE", "CUSTOMER"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"template_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the access control templates bound to the group.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"access_control_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of individual access controls (permissions) bound to the group, in addition to those of its templates.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *IAMGroupResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "IAM group ID.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *IAMGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *IAMGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IAMGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating IAM group", map[string]interface{}{
+		"user_type": data.UserType.ValueString(),
+	})
+
+	group := iamGroupFromModel(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateIAMGroup(ctx, group)
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to create IAM group, got error: %s", err))
+		return
+	}
+
+	mapIAMGroupToModel(ctx, created, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *IAMGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IAMGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading IAM group", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	group, err := r.client.GetIAMGroup(ctx, data.ID.ValueString())
+	if err != nil {
+		// If the group was deleted outside Terraform, remove from state (drift detection)
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read IAM group, got error: %s", err))
+		return
+	}
+
+	mapIAMGroupToModel(ctx, group, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *IAMGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data IAMGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating IAM group", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	group := iamGroupFromModel(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateIAMGroup(ctx, data.ID.ValueString(), group)
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to update IAM group, got error: %s", err))
+		return
+	}
+
+	mapIAMGroupToModel(ctx, updated, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *IAMGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IAMGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Deleting IAM group", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteIAMGroup(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete IAM group, got error: %s", err),
+		)
+		return
+	}
+}
+
+func (r *IAMGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by group ID, or by identity { id }
+	importState(ctx, req, resp, "id")
+}
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IAMGroupMembershipResource{}
+var _ resource.ResourceWithImportState = &IAMGroupMembershipResource{}
+var _ resource.ResourceWithIdentity = &IAMGroupMembershipResource{}
+
+func NewIAMGroupMembershipResource() resource.Resource {
+	return &IAMGroupMembershipResource{}
+}
+
+// IAMGroupMembershipResource defines the resource implementation.
+type IAMGroupMembershipResource struct {
+	client *EmporixClient
+}
+
+// IAMGroupMembershipResourceModel describes the resource data model.
+type IAMGroupMembershipResourceModel struct {
+	GroupID types.String `tfsdk:"group_id"`
+	UserID  types.String `tfsdk:"user_id"`
+}
+
+func (r *IAMGroupMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_group_membership"
+}
+
+func (r *IAMGroupMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Adds a user to a group of the Emporix IAM service. " +
+			"Each membership links one user to one group; changing either creates a new membership.",
+
+		Attributes: map[string]schema.Attribute{
+			"group_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the group the user is added to.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the user. The user must be of the group's `user_type`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *IAMGroupMembershipResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"group_id": identityschema.StringAttribute{
+				Description:       "ID of the IAM group.",
+				RequiredForImport: true,
+			},
+			"user_id": identityschema.StringAttribute{
+				Description:       "ID of the group member.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *IAMGroupMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *IAMGroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IAMGroupMembershipResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Adding user to IAM group", map[string]interface{}{
+		"group_id": data.GroupID.ValueString(),
+		"user_id":  data.UserID.ValueString(),
+	})
+
+	// The API needs the user type along with the user, which is the user type of the group
+	group, err := r.client.GetIAMGroup(ctx, data.GroupID.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("group_id"),
+				"IAM Group Not Found",
+				fmt.Sprintf("No IAM group with ID %q exists in Emporix.", data.GroupID.ValueString()),
+			)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read IAM group, got error: %s", err))
+		return
+	}
+
+	_, err = r.client.CreateIAMGroupMember(ctx, data.GroupID.ValueString(), data.UserID.ValueString(), group.UserType)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add user to IAM group, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *IAMGroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IAMGroupMembershipResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading IAM group membership", map[string]interface{}{
+		"group_id": data.GroupID.ValueString(),
+		"user_id":  data.UserID.ValueString(),
+	})
+
+	_, err := r.client.GetIAMGroupMember(ctx, data.GroupID.ValueString(), data.UserID.ValueString())
+	if err != nil {
+		// If the user left the group or the group is gone, remove from state (drift detection)
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read IAM group membership, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *IAMGroupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Both group_id and user_id require replacement, so there is nothing to update
+	var data IAMGroupMembershipResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *IAMGroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IAMGroupMembershipResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Removing user from IAM group", map[string]interface{}{
+		"group_id": data.GroupID.ValueString(),
+		"user_id":  data.UserID.ValueString(),
+	})
+
+	err := r.client.DeleteIAMGroupMember(ctx, data.GroupID.ValueString(), data.UserID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to remove user from IAM group, got error: %s", err),
+		)
+		return
+	}
+}
+
+func (r *IAMGroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by "group_id:user_id", or by identity { group_id, user_id }
+	importState(ctx, req, resp, "group_id", "user_id")
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccIAMGroupResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckIAMGroupDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccIAMGroupResourceConfig("Terraform Test Group", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("emporix_iam_group.test", "id"),
+					resource.TestCheckResourceAttr("emporix_iam_group.test", "name.en", "Terraform Test Group"),
+					resource.TestCheckResourceAttr("emporix_iam_group.test", "description.en", "Managed by Terraform"),
+					resource.TestCheckResourceAttr("emporix_iam_group.test", "user_type", "EMPLOYEE"),
+					resource.TestCheckNoResourceAttr("emporix_iam_group.test", "access_control_ids"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "emporix_iam_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update testing: rename and bind an access control
+			{
+				Config: testAccIAMGroupResourceConfig("Terraform Test Group Renamed", `access_control_ids = ["tf-test-access-control"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("emporix_iam_group.test", "name.en", "Terraform Test Group Renamed"),
+					resource.TestCheckResourceAttr("emporix_iam_group.test", "access_control_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMGroupMembershipResource_basic(t *testing.T) {
+	// Users aren't managed by the provider, so the test needs an existing employee
+	userID := testAccFixture(t, "EMPORIX_TEST_USER_ID", "tf-test-user")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckIAMGroupMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIAMGroupMembershipResourceConfig(userID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("emporix_iam_group_membership.test", "group_id", "emporix_iam_group.test", "id"),
+					resource.TestCheckResourceAttr("emporix_iam_group_membership.test", "user_id", userID),
+				),
+			},
+			{
+				ResourceName:                         "emporix_iam_group_membership.test",
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccIAMGroupMembershipImportStateIdFunc("emporix_iam_group_membership.test"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "user_id",
+			},
+		},
+	})
+}
+
+// testAccIAMGroupResourceConfig generates an employee group with the given name and extra attributes
+func testAccIAMGroupResourceConfig(name, extra string) string {
+	return fmt.Sprintf(`
+resource "emporix_iam_group" "test" {
+  user_type = "EMPLOYEE"
+  name = {
+    en = %[1]q
+  }
+  description = {
+    en = "Managed by Terraform"
+  }
+  %[2]s
+}
+`, name, extra)
+}
+
+// testAccIAMGroupMembershipResourceConfig generates a group with the given user as member
+func testAccIAMGroupMembershipResourceConfig(userID string) string {
+	return fmt.Sprintf(`
+resource "emporix_iam_group" "test" {
+  user_type = "EMPLOYEE"
+  name = {
+    en = "Terraform Membership Test"
+  }
+}
+
+resource "emporix_iam_group_membership" "test" {
+  group_id = emporix_iam_group.test.id
+  user_id  = %[1]q
+}
+`, userID)
+}
+
+// testAccIAMGroupMembershipImportStateIdFunc builds the "group_id:user_id" import identifier from state,
+// since the group id is server-generated and unknown ahead of time.
+func testAccIAMGroupMembershipImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes["group_id"], rs.Primary.Attributes["user_id"]), nil
+	}
+}
+
+// testAccCheckIAMGroupDestroy verifies that IAM groups have been deleted
+func testAccCheckIAMGroupDestroy(s *terraform.State) error {
+	ctx := context.Background()
+
+	client, err := getTestClient()
+	if err != nil {
+		return fmt.Errorf("failed to get test client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "emporix_iam_group" {
+			continue
+		}
+
+		_, err := client.GetIAMGroup(ctx, rs.Primary.ID)
+		if IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("unexpected error checking IAM group: %w", err)
+		}
+
+		return fmt.Errorf("IAM group %s still exists after destroy", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+// testAccCheckIAMGroupMembershipDestroy verifies that users have been removed from their groups
+func testAccCheckIAMGroupMembershipDestroy(s *terraform.State) error {
+	ctx := context.Background()
+
+	client, err := getTestClient()
+	if err != nil {
+		return fmt.Errorf("failed to get test client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "emporix_iam_group_membership" {
+			continue
+		}
+
+		groupID := rs.Primary.Attributes["group_id"]
+		userID := rs.Primary.Attributes["user_id"]
+
+		_, err := client.GetIAMGroupMember(ctx, groupID, userID)
+		if IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("unexpected error checking IAM group membership: %w", err)
+		}
+
+		return fmt.Errorf("user %s is still a member of IAM group %s after destroy", userID, groupID)
+	}
+
+	return nil
+}
@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &LanguageResource{}
+var _ resource.ResourceWithImportState = &LanguageResource{}
+var _ resource.ResourceWithIdentity = &LanguageResource{}
+
+func NewLanguageResource() resource.Resource {
+	return &LanguageResource{}
+}
+
+// LanguageResource defines the resource implementation.
+type LanguageResource struct {
+	client *EmporixClient
+}
+
+// LanguageResourceModel describes the resource data model.
+type LanguageResourceModel struct {
+	Code     types.String `tfsdk:"code"`
+	Name     types.Map    `tfsdk:"name"`
+	Default  types.Bool   `tfsdk:"default"`
+	Required types.Bool   `tfsdk:"required"`
+}
+
+// mapLanguageToModel converts a Language of the tenant to a LanguageResourceModel.
+// Languages added in the Management Dashboard only have a label, which becomes the name in the language itself.
+func mapLanguageToModel(ctx context.Context, language *Language, data *LanguageResourceModel, diags *diag.Diagnostics) {
+	data.Code = types.StringValue(language.Code)
+	data.Default = types.BoolValue(language.Default)
+	data.Required = types.BoolValue(language.Required)
+
+	name := language.Name
+	if len(name) == 0 && language.Label != "" {
+		name = map[string]string{language.Code: language.Label}
+	}
+	nameMapValue, d := types.MapValueFrom(ctx, types.StringType, name)
+	diags.Append(d...)
+	data.Name = nameMapValue
+}
+
+// languageFromModel converts a LanguageResourceModel to a Language of the tenant.
+// The label is the name in the language itself, or else the first name by language code.
+func languageFromModel(ctx context.Context, data *LanguageResourceModel, diags *diag.Diagnostics) *Language {
+	name := make(map[string]string)
+	diags.Append(data.Name.ElementsAs(ctx, &name, false)...)
+
+	label, ok := name[data.Code.ValueString()]
+	if !ok {
+		codes := make([]string, 0, len(name))
+		for code := range name {
+			codes = append(codes, code)
+		}
+		sort.Strings(codes)
+		if len(codes) > 0 {
+			label = name[codes[0]]
+		}
+	}
+
+	return &Language{
+		Code:     data.Code.ValueString(),
+		Label:    label,
+		Name:     name,
+		Default:  data.Default.ValueBool(),
+		Required: data.Required.ValueBool(),
+	}
+}
+
+func (r *LanguageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_language"
+}
+
+func (r *LanguageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a language of the tenant in Emporix. " +
+			"The languages of a tenant are stored in the `project_lang` tenant configuration, and each resource manages one entry of it. " +
+			"Sites can only use languages the tenant has. " +
+			"Do not manage `project_lang` with an `emporix_tenant_configuration` resource at the same time.",
+
+		Attributes: map[string]schema.Attribute{
+			"code": schema.StringAttribute{
+				MarkdownDescription: "Language code (ISO 639-1, e.g., en, de, fr). Cannot be changed after creation.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(2),
+				},
+			},
+			"name": schema.MapAttribute{
+				MarkdownDescription: "Language name as a map of language code to name (e.g., {\"en\": \"German\", \"de\": \"Deutsch\"}). " +
+					"The name in the language itself is shown in the Management Dashboard.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"default": schema.BoolAttribute{
+				MarkdownDescription: "Whether this is the default language of the tenant. " +
+					"Setting it replaces the current default language. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"required": schema.BoolAttribute{
+				MarkdownDescription: "Whether localized values must be provided in this language. Defaults to false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *LanguageResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"code": identityschema.StringAttribute{
+				Description:       "Language code (e.g., en, de, fr).",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *LanguageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *LanguageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data LanguageResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating language", map[string]interface{}{
+		"code": data.Code.ValueString(),
+	})
+
+	language := languageFromModel(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateLanguage(ctx, language)
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to create language, got error: %s", err))
+		return
+	}
+
+	mapLanguageToModel(ctx, created, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *LanguageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data LanguageResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading language", map[string]interface{}{
+		"code": data.Code.ValueString(),
+	})
+
+	language, err := r.client.GetLanguage(ctx, data.Code.ValueString())
+	if err != nil {
+		// If resource not found, remove from state (drift detection)
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read language, got error: %s", err))
+		return
+	}
+
+	mapLanguageToModel(ctx, language, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *LanguageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data LanguageResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating language", map[string]interface{}{
+		"code": data.Code.ValueString(),
+	})
+
+	language := languageFromModel(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateLanguage(ctx, data.Code.ValueString(), language)
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to update language, got error: %s", err))
+		return
+	}
+
+	mapLanguageToModel(ctx, updated, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *LanguageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data LanguageResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Deleting language", map[string]interface{}{
+		"code": data.Code.ValueString(),
+	})
+
+	err := r.client.DeleteLanguage(ctx, data.Code.ValueString())
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete language, got error: %s", err),
+		)
+		return
+	}
+}
+
+func (r *LanguageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by language code (e.g., "en", "de"), or by identity { code }
+	importState(ctx, req, resp, "code")
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccLanguageResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckLanguageDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccLanguageResourceConfig("fi", "Suomi", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("emporix_language.test", "code", "fi"),
+					resource.TestCheckResourceAttr("emporix_language.test", "name.fi", "Suomi"),
+					resource.TestCheckResourceAttr("emporix_language.test", "name.en", "Finnish"),
+					resource.TestCheckResourceAttr("emporix_language.test", "default", "false"),
+					resource.TestCheckResourceAttr("emporix_language.test", "required", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "emporix_language.test",
+				ImportState:                          true,
+				ImportStateId:                        "fi",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "code",
+			},
+			// Update testing
+			{
+				Config: testAccLanguageResourceConfig("fi", "suomi", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("emporix_language.test", "name.fi", "suomi"),
+				),
+			},
+		},
+	})
+}
+
+// testAccLanguageResourceConfig generates a language configuration
+func testAccLanguageResourceConfig(code, nativeName string, isDefault bool) string {
+	return fmt.Sprintf(`
+resource "emporix_language" "test" {
+  code = %[1]q
+  name = {
+    en     = "Finnish"
+    %[1]s = %[2]q
+  }
+  default = %[3]t
+}
+`, code, nativeName, isDefault)
+}
+
+// testAccCheckLanguageDestroy verifies that languages have been removed from the tenant
+func testAccCheckLanguageDestroy(s *terraform.State) error {
+	ctx := context.Background()
+
+	client, err := getTestClient()
+	if err != nil {
+		return fmt.Errorf("failed to get test client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "emporix_language" {
+			continue
+		}
+
+		code := rs.Primary.Attributes["code"]
+
+		_, err := client.GetLanguage(ctx, code)
+		if IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("unexpected error checking language: %w", err)
+		}
+
+		return fmt.Errorf("language %s still exists after destroy", code)
+	}
+
+	return nil
+}
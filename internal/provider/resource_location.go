@@ -0,0 +1,591 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &LocationResource{}
+var _ resource.ResourceWithImportState = &LocationResource{}
+var _ resource.ResourceWithIdentity = &LocationResource{}
+var _ resource.ResourceWithValidateConfig = &LocationResource{}
+
+func NewLocationResource() resource.Resource {
+	return &LocationResource{}
+}
+
+// LocationResource defines the resource implementation.
+type LocationResource struct {
+	client *EmporixClient
+}
+
+// LocationResourceModel describes the resource data model.
+type LocationResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
+	Active         types.Bool   `tfsdk:"active"`
+	Address        types.Object `tfsdk:"address"`
+	Location       types.Object `tfsdk:"location"`
+	ContactDetails types.Object `tfsdk:"contact_details"`
+	OpeningHours   types.List   `tfsdk:"opening_hours"`
+}
+
+// AddressModel describes a postal address, like the address of the site home base
+type AddressModel struct {
+	Street       types.String `tfsdk:"street"`
+	StreetNumber types.String `tfsdk:"street_number"`
+	ZipCode      types.String `tfsdk:"zip_code"`
+	City         types.String `tfsdk:"city"`
+	Country      types.String `tfsdk:"country"`
+	State        types.String `tfsdk:"state"`
+}
+
+func (m AddressModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"street":        types.StringType,
+		"street_number": types.StringType,
+		"zip_code":      types.StringType,
+		"city":          types.StringType,
+		"country":       types.StringType,
+		"state":         types.StringType,
+	}
+}
+
+// CoordinatesModel describes geographic coordinates, like the location of the site home base
+type CoordinatesModel struct {
+	Latitude  types.Float64 `tfsdk:"latitude"`
+	Longitude types.Float64 `tfsdk:"longitude"`
+}
+
+func (m CoordinatesModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"latitude":  types.Float64Type,
+		"longitude": types.Float64Type,
+	}
+}
+
+// ContactDetailsModel describes how to reach a location
+type ContactDetailsModel struct {
+	Email types.String `tfsdk:"email"`
+	Phone types.String `tfsdk:"phone"`
+}
+
+func (m ContactDetailsModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"email": types.StringType,
+		"phone": types.StringType,
+	}
+}
+
+// OpeningHoursModel describes the time ranges a location is open on a weekday
+type OpeningHoursModel struct {
+	Weekday    types.String `tfsdk:"weekday"`
+	TimeRanges types.List   `tfsdk:"time_ranges"`
+}
+
+func (m OpeningHoursModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"weekday":     types.StringType,
+		"time_ranges": types.ListType{ElemType: types.ObjectType{AttrTypes: TimeRangeModel{}.AttributeTypes()}},
+	}
+}
+
+// mapLocationToModel converts a PhysicalLocation API response to a LocationResourceModel
+func mapLocationToModel(ctx context.Context, location *PhysicalLocation, data *LocationResourceModel, diags *diag.Diagnostics) {
+	data.ID = types.StringValue(location.ID)
+	data.Name = types.StringValue(location.Name)
+	data.Type = types.StringValue(location.Type)
+	data.Active = types.BoolValue(location.Active)
+
+	if location.Address != nil {
+		address := AddressModel{
+			Street:       stringOrNull(location.Address.Street),
+			StreetNumber: stringOrNull(location.Address.StreetNumber),
+			ZipCode:      stringOrNull(location.Address.ZipCode),
+			City:         stringOrNull(location.Address.City),
+			Country:      types.StringValue(location.Address.Country),
+			State:        stringOrNull(location.Address.State),
+		}
+		obj, d := types.ObjectValueFrom(ctx, address.AttributeTypes(), address)
+		diags.Append(d...)
+		data.Address = obj
+	} else {
+		data.Address = types.ObjectNull(AddressModel{}.AttributeTypes())
+	}
+
+	if location.Location != nil {
+		coordinates := CoordinatesModel{
+			Latitude:  types.Float64Value(location.Location.Latitude),
+			Longitude: types.Float64Value(location.Location.Longitude),
+		}
+		obj, d := types.ObjectValueFrom(ctx, coordinates.AttributeTypes(), coordinates)
+		diags.Append(d...)
+		data.Location = obj
+	} else {
+		data.Location = types.ObjectNull(CoordinatesModel{}.AttributeTypes())
+	}
+
+	if location.ContactDetails != nil && (location.ContactDetails.Email != "" || location.ContactDetails.Phone != "") {
+		contact := ContactDetailsModel{
+			Email: stringOrNull(location.ContactDetails.Email),
+			Phone: stringOrNull(location.ContactDetails.Phone),
+		}
+		obj, d := types.ObjectValueFrom(ctx, contact.AttributeTypes(), contact)
+		diags.Append(d...)
+		data.ContactDetails = obj
+	} else {
+		data.ContactDetails = types.ObjectNull(ContactDetailsModel{}.AttributeTypes())
+	}
+
+	openingHoursType := types.ObjectType{AttrTypes: OpeningHoursModel{}.AttributeTypes()}
+	if len(location.OpeningHours) == 0 {
+		data.OpeningHours = types.ListNull(openingHoursType)
+		return
+	}
+
+	days := make([]OpeningHoursModel, len(location.OpeningHours))
+	for i, day := range location.OpeningHours {
+		ranges := make([]TimeRangeModel, len(day.TimeRanges))
+		for j, timeRange := range day.TimeRanges {
+			ranges[j] = TimeRangeModel{
+				TimeFrom: types.StringValue(timeRange.TimeFrom),
+				TimeTo:   types.StringValue(timeRange.TimeTo),
+			}
+		}
+		list, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: TimeRangeModel{}.AttributeTypes()}, ranges)
+		diags.Append(d...)
+		days[i] = OpeningHoursModel{
+			Weekday:    types.StringValue(day.Weekday),
+			TimeRanges: list,
+		}
+	}
+	list, d := types.ListValueFrom(ctx, openingHoursType, days)
+	diags.Append(d...)
+	data.OpeningHours = list
+}
+
+// locationFromModel converts a LocationResourceModel to a PhysicalLocation API payload
+func locationFromModel(ctx context.Context, data *LocationResourceModel, diags *diag.Diagnostics) *PhysicalLocation {
+	location := &PhysicalLocation{
+		Name:   data.Name.ValueString(),
+		Type:   data.Type.ValueString(),
+		Active: data.Active.ValueBool(),
+	}
+
+	if !data.Address.IsNull() && !data.Address.IsUnknown() {
+		var address AddressModel
+		diags.Append(data.Address.As(ctx, &address, basetypes.ObjectAsOptions{})...)
+		location.Address = &Address{
+			Street:       address.Street.ValueString(),
+			StreetNumber: address.StreetNumber.ValueString(),
+			ZipCode:      address.ZipCode.ValueString(),
+			City:         address.City.ValueString(),
+			Country:      address.Country.ValueString(),
+			State:        address.State.ValueString(),
+		}
+	}
+
+	if !data.Location.IsNull() && !data.Location.IsUnknown() {
+		var coordinates CoordinatesModel
+		diags.Append(data.Location.As(ctx, &coordinates, basetypes.ObjectAsOptions{})...)
+		location.Location = &Location{
+			Latitude:  coordinates.Latitude.ValueFloat64(),
+			Longitude: coordinates.Longitude.ValueFloat64(),
+		}
+	}
+
+	if !data.ContactDetails.IsNull() && !data.ContactDetails.IsUnknown() {
+		var contact ContactDetailsModel
+		diags.Append(data.ContactDetails.As(ctx, &contact, basetypes.ObjectAsOptions{})...)
+		location.ContactDetails = &ContactDetails{
+			Email: contact.Email.ValueString(),
+			Phone: contact.Phone.ValueString(),
+		}
+	}
+
+	if !data.OpeningHours.IsNull() && !data.OpeningHours.IsUnknown() {
+		var days []OpeningHoursModel
+		diags.Append(data.OpeningHours.ElementsAs(ctx, &days, false)...)
+		for _, day := range days {
+			var ranges []TimeRangeModel
+			diags.Append(day.TimeRanges.ElementsAs(ctx, &ranges, false)...)
+			openingHours := OpeningHours{
+				Weekday:    day.Weekday.ValueString(),
+				TimeRanges: make([]TimeRange, len(ranges)),
+			}
+			for i, timeRange := range ranges {
+				openingHours.TimeRanges[i] = TimeRange{
+					TimeFrom: timeRange.TimeFrom.ValueString(),
+					TimeTo:   timeRange.TimeTo.ValueString(),
+				}
+			}
+			location.OpeningHours = append(location.OpeningHours, openingHours)
+		}
+	}
+
+	return location
+}
+
+func (r *LocationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_location"
+}
+
+func (r *LocationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a location of the Emporix availability service: a warehouse, store or pickup point. " +
+			"Locations feed product availability and click-and-collect.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the location, generated by the API.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the location (e.g., 'Berlin Central Warehouse').",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the location: `WAREHOUSE`, `STORE` or `PICKUP_POINT`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("WAREHOUSE", "STORE", "PICKUP_POINT"),
+				},
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the location is in use. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"address": schema.SingleNestedAttribute{
+				MarkdownDescription: "Postal address of the location.",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"street": schema.StringAttribute{
+						MarkdownDescription: "Street name.",
+						Optional:            true,
+					},
+					"street_number": schema.StringAttribute{
+						MarkdownDescription: "House number.",
+						Optional:            true,
+					},
+					"zip_code": schema.StringAttribute{
+						MarkdownDescription: "Postal code.",
+						Required:            true,
+					},
+					"city": schema.StringAttribute{
+						MarkdownDescription: "City.",
+						Required:            true,
+					},
+					"country": schema.StringAttribute{
+						MarkdownDescription: "Country code (ISO 3166-1 alpha-2, e.g. 'DE').",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(2, 2),
+						},
+					},
+					"state": schema.StringAttribute{
+						MarkdownDescription: "State or region.",
+						Optional:            true,
+					},
+				},
+			},
+			"location": schema.SingleNestedAttribute{
+				MarkdownDescription: "Geographic coordinates of the location, e.g. to find the nearest pickup point.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"latitude": schema.Float64Attribute{
+						MarkdownDescription: "Latitude in degrees.",
+						Required:            true,
+						Validators: []validator.Float64{
+							float64validator.Between(-90, 90),
+						},
+					},
+					"longitude": schema.Float64Attribute{
+						MarkdownDescription: "Longitude in degrees.",
+						Required:            true,
+						Validators: []validator.Float64{
+							float64validator.Between(-180, 180),
+						},
+					},
+				},
+			},
+			"contact_details": schema.SingleNestedAttribute{
+				MarkdownDescription: "How to reach the location.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"email": schema.StringAttribute{
+						MarkdownDescription: "Email address.",
+						Optional:            true,
+					},
+					"phone": schema.StringAttribute{
+						MarkdownDescription: "Phone number.",
+						Optional:            true,
+					},
+				},
+			},
+			"opening_hours": schema.ListNestedAttribute{
+				MarkdownDescription: "Opening hours per weekday. Weekdays without an entry are closed; each weekday may appear once.",
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"weekday": schema.StringAttribute{
+							MarkdownDescription: "Day of the week (MONDAY, TUESDAY, WEDNESDAY, THURSDAY, FRIDAY, SATURDAY, SUNDAY).",
+							Required:            true,
+							Validators: []validator.String{
+								weekdayValidator(),
+							},
+						},
+						"time_ranges": schema.ListNestedAttribute{
+							MarkdownDescription: "Time ranges the location is open on the weekday, e.g. before and after a lunch break.",
+							Required:            true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"time_from": schema.StringAttribute{
+										MarkdownDescription: "Opening time in HH:MM format (e.g., '09:00').",
+										Required:            true,
+										Validators: []validator.String{
+											timeOfDayValidator("09:00"),
+										},
+									},
+									"time_to": schema.StringAttribute{
+										MarkdownDescription: "Closing time in HH:MM format (e.g., '18:00').",
+										Required:            true,
+										Validators: []validator.String{
+											timeOfDayValidator("18:00"),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *LocationResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "Location ID.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *LocationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks that each weekday has opening hours once and that every time range ends after it starts
+func (r *LocationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var openingHours types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("opening_hours"), &openingHours)...)
+	if resp.Diagnostics.HasError() || openingHours.IsNull() || openingHours.IsUnknown() {
+		return
+	}
+
+	var days []OpeningHoursModel
+	resp.Diagnostics.Append(openingHours.ElementsAs(ctx, &days, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[string]bool{}
+	for i, day := range days {
+		dayPath := path.Root("opening_hours").AtListIndex(i)
+
+		if !day.Weekday.IsNull() && !day.Weekday.IsUnknown() {
+			weekday := day.Weekday.ValueString()
+			if seen[weekday] {
+				resp.Diagnostics.AddAttributeError(
+					dayPath.AtName("weekday"),
+					"Duplicate Weekday",
+					fmt.Sprintf("%s has more than one opening_hours entry. List all time ranges of a weekday in one entry.", weekday),
+				)
+			}
+			seen[weekday] = true
+		}
+
+		if day.TimeRanges.IsNull() || day.TimeRanges.IsUnknown() {
+			continue
+		}
+		var ranges []TimeRangeModel
+		resp.Diagnostics.Append(day.TimeRanges.ElementsAs(ctx, &ranges, false)...)
+		for j, timeRange := range ranges {
+			if timeRange.TimeFrom.IsNull() || timeRange.TimeFrom.IsUnknown() || timeRange.TimeTo.IsNull() || timeRange.TimeTo.IsUnknown() {
+				continue
+			}
+			// HH:MM times compare correctly as strings
+			if timeRange.TimeFrom.ValueString() >= timeRange.TimeTo.ValueString() {
+				resp.Diagnostics.AddAttributeError(
+					dayPath.AtName("time_ranges").AtListIndex(j),
+					"Invalid Time Range",
+					fmt.Sprintf("time_from (%s) must be before time_to (%s)", timeRange.TimeFrom.ValueString(), timeRange.TimeTo.ValueString()),
+				)
+			}
+		}
+	}
+}
+
+func (r *LocationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data LocationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating location", map[string]interface{}{
+		"name": data.Name.ValueString(),
+		"type": data.Type.ValueString(),
+	})
+
+	location := locationFromModel(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateLocation(ctx, location)
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to create location, got error: %s", err))
+		return
+	}
+
+	mapLocationToModel(ctx, created, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *LocationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data LocationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading location", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	location, err := r.client.GetLocation(ctx, data.ID.ValueString())
+	if err != nil {
+		// If the location was deleted outside Terraform, remove from state (drift detection)
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read location, got error: %s", err))
+		return
+	}
+
+	mapLocationToModel(ctx, location, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *LocationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data LocationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating location", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	location := locationFromModel(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateLocation(ctx, data.ID.ValueString(), location)
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to update location, got error: %s", err))
+		return
+	}
+
+	mapLocationToModel(ctx, updated, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *LocationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data LocationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Deleting location", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteLocation(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete location, got error: %s", err),
+		)
+		return
+	}
+}
+
+func (r *LocationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by location ID, or by identity { id }
+	importState(ctx, req, resp, "id")
+}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccLocationResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckLocationDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccLocationResourceConfig("Berlin Pickup", `
+  opening_hours = [
+    {
+      weekday = "MONDAY"
+      time_ranges = [
+        { time_from = "09:00", time_to = "12:00" },
+        { time_from = "13:00", time_to = "18:00" },
+      ]
+    },
+  ]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("emporix_location.test", "id"),
+					resource.TestCheckResourceAttr("emporix_location.test", "name", "Berlin Pickup"),
+					resource.TestCheckResourceAttr("emporix_location.test", "type", "PICKUP_POINT"),
+					resource.TestCheckResourceAttr("emporix_location.test", "active", "true"),
+					resource.TestCheckResourceAttr("emporix_location.test", "address.city", "Berlin"),
+					resource.TestCheckResourceAttr("emporix_location.test", "location.latitude", "52.53"),
+					resource.TestCheckResourceAttr("emporix_location.test", "contact_details.email", "pickup@example.com"),
+					resource.TestCheckResourceAttr("emporix_location.test", "opening_hours.#", "1"),
+					resource.TestCheckResourceAttr("emporix_location.test", "opening_hours.0.time_ranges.#", "2"),
+					resource.TestCheckResourceAttr("emporix_location.test", "opening_hours.0.time_ranges.1.time_to", "18:00"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "emporix_location.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update testing: rename and drop the opening hours
+			{
+				Config: testAccLocationResourceConfig("Berlin Pickup Point", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("emporix_location.test", "name", "Berlin Pickup Point"),
+					resource.TestCheckNoResourceAttr("emporix_location.test", "opening_hours.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLocationResource_invalidOpeningHours(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLocationResourceConfig("Berlin Pickup", `
+  opening_hours = [
+    { weekday = "MONDAY", time_ranges = [{ time_from = "09:00", time_to = "12:00" }] },
+    { weekday = "MONDAY", time_ranges = [{ time_from = "13:00", time_to = "18:00" }] },
+  ]`),
+				ExpectError: regexp.MustCompile(`Duplicate Weekday`),
+			},
+			{
+				Config: testAccLocationResourceConfig("Berlin Pickup", `
+  opening_hours = [
+    { weekday = "MONDAY", time_ranges = [{ time_from = "18:00", time_to = "09:00" }] },
+  ]`),
+				ExpectError: regexp.MustCompile(`Invalid Time Range`),
+			},
+		},
+	})
+}
+
+// testAccLocationResourceConfig generates a pickup point in Berlin with extra attributes
+func testAccLocationResourceConfig(name, extra string) string {
+	return fmt.Sprintf(`
+resource "emporix_location" "test" {
+  name = %[1]q
+  type = "PICKUP_POINT"
+
+  address = {
+    street        = "Invalidenstrasse"
+    street_number = "1"
+    zip_code      = "10115"
+    city          = "Berlin"
+    country       = "DE"
+  }
+
+  location = {
+    latitude  = 52.53
+    longitude = 13.38
+  }
+
+  contact_details = {
+    email = "pickup@example.com"
+  }
+%[2]s
+}
+`, name, extra)
+}
+
+func testAccCheckLocationDestroy(s *terraform.State) error {
+	ctx := context.Background()
+
+	client, err := getTestClient()
+	if err != nil {
+		return fmt.Errorf("failed to get test client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "emporix_location" {
+			continue
+		}
+
+		_, err := client.GetLocation(ctx, rs.Primary.ID)
+		if IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("unexpected error checking location: %w", err)
+		}
+
+		return fmt.Errorf("location %s still exists after destroy", rs.Primary.ID)
+	}
+
+	return nil
+}
@@ -4,8 +4,9 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -17,6 +18,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &PaymentModeResource{}
 var _ resource.ResourceWithImportState = &PaymentModeResource{}
+var _ resource.ResourceWithIdentity = &PaymentModeResource{}
 
 func NewPaymentModeResource() resource.Resource {
 	return &PaymentModeResource{}
@@ -36,6 +38,22 @@ type PaymentModeResourceModel struct {
 	Configuration   types.Map    `tfsdk:"configuration"`
 }
 
+// mapPaymentModeToModel converts a PaymentMode API response to a PaymentModeResourceModel
+func mapPaymentModeToModel(ctx context.Context, paymentMode *PaymentMode, data *PaymentModeResourceModel, diags *diag.Diagnostics) {
+	data.ID = types.StringValue(paymentMode.ID)
+	data.Code = types.StringValue(paymentMode.Code)
+	data.Active = types.BoolValue(paymentMode.Active)
+	data.PaymentProvider = types.StringValue(paymentMode.Provider)
+
+	if len(paymentMode.Configuration) > 0 {
+		configMap, d := types.MapValueFrom(ctx, types.StringType, paymentMode.Configuration)
+		diags.Append(d...)
+		data.Configuration = configMap
+	} else {
+		data.Configuration = types.MapNull(types.StringType)
+	}
+}
+
 func (r *PaymentModeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_paymentmode"
 }
@@ -78,6 +96,17 @@ func (r *PaymentModeResource) Schema(ctx context.Context, req resource.SchemaReq
 	}
 }
 
+func (r *PaymentModeResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "Payment mode ID.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *PaymentModeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -129,7 +158,7 @@ func (r *PaymentModeResource) Create(ctx context.Context, req resource.CreateReq
 	// Create payment mode
 	createdMode, err := r.client.CreatePaymentMode(ctx, paymentMode)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create payment mode, got error: %s", err))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to create payment mode, got error: %s", err))
 		return
 	}
 
@@ -156,6 +185,7 @@ func (r *PaymentModeResource) Create(ctx context.Context, req resource.CreateReq
 	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *PaymentModeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -183,23 +213,13 @@ func (r *PaymentModeResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	// Update state
-	state.ID = types.StringValue(paymentMode.ID)
-	state.Code = types.StringValue(paymentMode.Code)
-	state.Active = types.BoolValue(paymentMode.Active)
-	state.PaymentProvider = types.StringValue(paymentMode.Provider)
-
-	if len(paymentMode.Configuration) > 0 {
-		configMap, diags := types.MapValueFrom(ctx, types.StringType, paymentMode.Configuration)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		state.Configuration = configMap
-	} else {
-		state.Configuration = types.MapNull(types.StringType)
+	mapPaymentModeToModel(ctx, paymentMode, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *PaymentModeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -235,7 +255,7 @@ func (r *PaymentModeResource) Update(ctx context.Context, req resource.UpdateReq
 	// Update payment mode
 	updatedMode, err := r.client.UpdatePaymentMode(ctx, state.ID.ValueString(), updateData)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update payment mode, got error: %s", err))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to update payment mode, got error: %s", err))
 		return
 	}
 
@@ -261,6 +281,7 @@ func (r *PaymentModeResource) Update(ctx context.Context, req resource.UpdateReq
 	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *PaymentModeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -287,5 +308,6 @@ func (r *PaymentModeResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *PaymentModeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Import by payment mode ID, or by identity { id }
+	importState(ctx, req, resp, "id")
 }
@@ -4,14 +4,13 @@ import (
 	"context"
 	"fmt"
 	"testing"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccPaymentModeResource_basic(t *testing.T) {
-	code := fmt.Sprintf("test-pm-%d", time.Now().Unix())
+	code := fmt.Sprintf("test-pm-%d", testAccTimestamp(t))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -47,7 +46,7 @@ func TestAccPaymentModeResource_basic(t *testing.T) {
 }
 
 func TestAccPaymentModeResource_cashOnDelivery(t *testing.T) {
-	code := fmt.Sprintf("test-cod-%d", time.Now().Unix())
+	code := fmt.Sprintf("test-cod-%d", testAccTimestamp(t))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -66,7 +65,7 @@ func TestAccPaymentModeResource_cashOnDelivery(t *testing.T) {
 }
 
 func TestAccPaymentModeResource_withConfiguration(t *testing.T) {
-	code := fmt.Sprintf("test-config-%d", time.Now().Unix())
+	code := fmt.Sprintf("test-config-%d", testAccTimestamp(t))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -95,8 +94,8 @@ func TestAccPaymentModeResource_withConfiguration(t *testing.T) {
 }
 
 func TestAccPaymentModeResource_requiresReplace(t *testing.T) {
-	code1 := fmt.Sprintf("test-replace1-%d", time.Now().Unix())
-	code2 := fmt.Sprintf("test-replace2-%d", time.Now().Unix())
+	code1 := fmt.Sprintf("test-replace1-%d", testAccTimestamp(t))
+	code2 := fmt.Sprintf("test-replace2-%d", testAccTimestamp(t))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -121,7 +120,7 @@ func TestAccPaymentModeResource_requiresReplace(t *testing.T) {
 }
 
 func TestAccPaymentModeResource_multiplePaymentModes(t *testing.T) {
-	timestamp := time.Now().Unix()
+	timestamp := testAccTimestamp(t)
 	code1 := fmt.Sprintf("test-multi1-%d", timestamp)
 	code2 := fmt.Sprintf("test-multi2-%d", timestamp)
 
@@ -0,0 +1,324 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PriceListResource{}
+var _ resource.ResourceWithImportState = &PriceListResource{}
+var _ resource.ResourceWithIdentity = &PriceListResource{}
+var _ resource.ResourceWithValidateConfig = &PriceListResource{}
+
+func NewPriceListResource() resource.Resource {
+	return &PriceListResource{}
+}
+
+// PriceListResource defines the resource implementation.
+type PriceListResource struct {
+	client *EmporixClient
+}
+
+// PriceListResourceModel describes the resource data model.
+type PriceListResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.Map    `tfsdk:"name"`
+	Currency       types.String `tfsdk:"currency"`
+	SiteCode       types.String `tfsdk:"site_code"`
+	CustomerGroups types.Set    `tfsdk:"customer_groups"`
+	Validity       types.Object `tfsdk:"validity"`
+}
+
+// mapPriceListToModel converts a PriceList API response to a PriceListResourceModel
+func mapPriceListToModel(ctx context.Context, priceList *PriceList, data *PriceListResourceModel, diags *diag.Diagnostics) {
+	data.ID = types.StringValue(priceList.ID)
+	data.Currency = types.StringValue(priceList.Currency)
+	data.SiteCode = types.StringValue(priceList.SiteCode)
+	data.Name = localizedMapOrNull(ctx, priceList.Name, diags)
+
+	data.CustomerGroups = types.SetNull(types.StringType)
+	if len(priceList.CustomerGroups) > 0 {
+		customerGroups, d := types.SetValueFrom(ctx, types.StringType, priceList.CustomerGroups)
+		diags.Append(d...)
+		data.CustomerGroups = customerGroups
+	}
+
+	data.Validity = validityToObject(ctx, priceList.Validity, diags)
+}
+
+// priceListFromModel converts a PriceListResourceModel to a PriceList API payload
+func priceListFromModel(ctx context.Context, data *PriceListResourceModel, diags *diag.Diagnostics) *PriceList {
+	priceList := &PriceList{
+		Name:     localizedMapFromModel(ctx, data.Name, diags),
+		Currency: data.Currency.ValueString(),
+		SiteCode: data.SiteCode.ValueString(),
+	}
+
+	if !data.CustomerGroups.IsNull() && !data.CustomerGroups.IsUnknown() {
+		diags.Append(data.CustomerGroups.ElementsAs(ctx, &priceList.CustomerGroups, false)...)
+	}
+
+	priceList.Validity = validityFromObject(ctx, data.Validity, diags)
+
+	return priceList
+}
+
+func (r *PriceListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_price_list"
+}
+
+func (r *PriceListResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a price list of the Emporix price service. " +
+			"A price list scopes prices to a site and currency, and optionally to customer groups and a validity period.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the price list, generated by the API.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.MapAttribute{
+				MarkdownDescription: "Price list name as a map of language code to name (e.g., {\"en\": \"B2B Prices\"}). " +
+					"Provide at least one language translation.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"currency": schema.StringAttribute{
+				MarkdownDescription: "Currency code of the prices in the list (ISO 4217, e.g., EUR). " +
+					"Must be one of the `available_currencies` of the site.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 3),
+				},
+			},
+			"site_code": schema.StringAttribute{
+				MarkdownDescription: "Code of the site the price list applies to.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"customer_groups": schema.SetAttribute{
+				MarkdownDescription: "IDs of the customer groups the price list applies to. Omit to apply it to all customers.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"validity": validityAttribute("Period in which the price list applies. Omit for a price list that is always valid."),
+		},
+	}
+}
+
+func (r *PriceListResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "Price list ID.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *PriceListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PriceListResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data PriceListResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateValidity(ctx, data.Validity, path.Root("validity"), &resp.Diagnostics)
+}
+
+// checkSiteCurrency reports an error unless the price list's site offers its currency. The site is
+// read when applying, so a site that gains the currency earlier in the same run is seen with it.
+func (r *PriceListResource) checkSiteCurrency(ctx context.Context, data *PriceListResourceModel, diags *diag.Diagnostics) {
+	siteCode := data.SiteCode.ValueString()
+	currency := data.Currency.ValueString()
+
+	site, err := r.client.GetSite(ctx, siteCode)
+	if err != nil {
+		if IsNotFound(err) {
+			diags.AddAttributeError(
+				path.Root("site_code"),
+				"Site Not Found",
+				fmt.Sprintf("No site with code %q exists in Emporix.", siteCode),
+			)
+			return
+		}
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read site %s to check the price list currency, got error: %s", siteCode, err))
+		return
+	}
+
+	available := append([]string{site.Currency}, site.AvailableCurrencies...)
+	for _, code := range available {
+		if strings.EqualFold(code, currency) {
+			return
+		}
+	}
+
+	diags.AddAttributeError(
+		path.Root("currency"),
+		"Currency Not Available on Site",
+		fmt.Sprintf("Site %q doesn't offer currency %q. Add it to the site's available_currencies, or use one of: %s.",
+			siteCode, currency, strings.Join(site.AvailableCurrencies, ", ")),
+	)
+}
+
+func (r *PriceListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PriceListResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating price list", map[string]interface{}{
+		"site_code": data.SiteCode.ValueString(),
+		"currency":  data.Currency.ValueString(),
+	})
+
+	r.checkSiteCurrency(ctx, &data, &resp.Diagnostics)
+	priceList := priceListFromModel(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreatePriceList(ctx, priceList)
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to create price list, got error: %s", err))
+		return
+	}
+
+	mapPriceListToModel(ctx, created, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *PriceListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PriceListResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading price list", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	priceList, err := r.client.GetPriceList(ctx, data.ID.ValueString())
+	if err != nil {
+		// If resource not found, remove from state (drift detection)
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read price list, got error: %s", err))
+		return
+	}
+
+	mapPriceListToModel(ctx, priceList, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *PriceListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PriceListResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating price list", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	r.checkSiteCurrency(ctx, &data, &resp.Diagnostics)
+	priceList := priceListFromModel(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdatePriceList(ctx, data.ID.ValueString(), priceList)
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to update price list, got error: %s", err))
+		return
+	}
+
+	mapPriceListToModel(ctx, updated, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *PriceListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PriceListResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Deleting price list", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeletePriceList(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete price list, got error: %s", err),
+		)
+		return
+	}
+}
+
+func (r *PriceListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by price list ID, or by identity { id }
+	importState(ctx, req, resp, "id")
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccPriceListResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckPriceListDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccPriceListResourceConfig("B2B Prices", "EUR", `
+  validity = {
+    from = "2024-01-01T00:00:00.000Z"
+    to   = "2024-12-31T23:59:59.000Z"
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("emporix_price_list.test", "id"),
+					resource.TestCheckResourceAttr("emporix_price_list.test", "name.en", "B2B Prices"),
+					resource.TestCheckResourceAttr("emporix_price_list.test", "currency", "EUR"),
+					resource.TestCheckResourceAttr("emporix_price_list.test", "site_code", "tf-price-site"),
+					resource.TestCheckResourceAttr("emporix_price_list.test", "customer_groups.#", "1"),
+					resource.TestCheckResourceAttr("emporix_price_list.test", "validity.from", "2024-01-01T00:00:00.000Z"),
+					resource.TestCheckResourceAttr("emporix_price_list.test", "validity.to", "2024-12-31T23:59:59.000Z"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "emporix_price_list.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update testing: open-ended validity in another available currency
+			{
+				Config: testAccPriceListResourceConfig("B2B Prices CHF", "CHF", `
+  validity = {
+    from = "2025-01-01T00:00:00.000Z"
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("emporix_price_list.test", "name.en", "B2B Prices CHF"),
+					resource.TestCheckResourceAttr("emporix_price_list.test", "currency", "CHF"),
+					resource.TestCheckNoResourceAttr("emporix_price_list.test", "validity.to"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPriceListResource_currencyNotOnSite(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPriceListResourceConfig("USD Prices", "USD", ""),
+				ExpectError: regexp.MustCompile(`Currency Not Available on Site`),
+			},
+		},
+	})
+}
+
+func TestAccPriceListResource_invalidValidity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPriceListResourceConfig("Backwards", "EUR", `
+  validity = {
+    from = "2024-12-31T00:00:00.000Z"
+    to   = "2024-01-01T00:00:00.000Z"
+  }`),
+				ExpectError: regexp.MustCompile(`must be before or equal to validity.to`),
+			},
+		},
+	})
+}
+
+func TestPriceListResource_ChecksSiteCurrency(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newFakeAPIClient(t)
+	r := &PriceListResource{client: client}
+
+	plan := &PriceListResourceModel{SiteCode: types.StringValue("main"), Currency: types.StringValue("CHF")}
+
+	var diags diag.Diagnostics
+	r.checkSiteCurrency(ctx, plan, &diags)
+	if diags.ErrorsCount() != 1 || diags.Errors()[0].Summary() != "Site Not Found" {
+		t.Fatalf("expected a missing site to be reported, got %v", diags)
+	}
+
+	site := &SiteSettings{
+		Code:                "main",
+		Name:                "Main Site",
+		DefaultLanguage:     "en",
+		Languages:           []string{"en"},
+		Currency:            "EUR",
+		AvailableCurrencies: []string{"EUR", "USD"},
+	}
+	if err := client.CreateSite(ctx, site); err != nil {
+		t.Fatalf("unexpected error creating site: %s", err)
+	}
+
+	diags = nil
+	r.checkSiteCurrency(ctx, plan, &diags)
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("expected a single error for CHF, got %v", diags)
+	}
+	if withPath, ok := diags.Errors()[0].(diag.DiagnosticWithPath); !ok || !withPath.Path().Equal(path.Root("currency")) {
+		t.Errorf("expected the error on currency, got %v", diags.Errors()[0])
+	}
+
+	plan.Currency = types.StringValue("usd")
+	diags = nil
+	r.checkSiteCurrency(ctx, plan, &diags)
+	if diags.HasError() {
+		t.Errorf("expected an available currency to pass regardless of case, got %v", diags)
+	}
+}
+
+// testAccPriceListResourceConfig generates a site offering EUR and CHF, and a price list on it
+func testAccPriceListResourceConfig(name, currency, validity string) string {
+	return fmt.Sprintf(`
+resource "emporix_sitesettings" "test" {
+  code                 = "tf-price-site"
+  name                 = "Price List Test Site"
+  active               = true
+  default_language     = "en"
+  languages            = ["en"]
+  currency             = "EUR"
+  available_currencies = ["EUR", "CHF"]
+  ship_to_countries    = ["DE"]
+
+  home_base = {
+    address = {
+      zip_code = "10115"
+      city     = "Berlin"
+      country  = "DE"
+    }
+  }
+}
+
+resource "emporix_price_list" "test" {
+  site_code       = emporix_sitesettings.test.code
+  currency        = %[2]q
+  customer_groups = ["tf-b2b"]
+  name = {
+    en = %[1]q
+  }
+%[3]s
+}
+`, name, currency, validity)
+}
+
+// testAccCheckPriceListDestroy verifies that price lists have been deleted
+func testAccCheckPriceListDestroy(s *terraform.State) error {
+	ctx := context.Background()
+
+	client, err := getTestClient()
+	if err != nil {
+		return fmt.Errorf("failed to get test client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "emporix_price_list" {
+			continue
+		}
+
+		_, err := client.GetPriceList(ctx, rs.Primary.ID)
+		if IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("unexpected error checking price list: %w", err)
+		}
+
+		return fmt.Errorf("price list %s still exists after destroy", rs.Primary.ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RewardPointsConfigResource{}
+var _ resource.ResourceWithImportState = &RewardPointsConfigResource{}
+var _ resource.ResourceWithIdentity = &RewardPointsConfigResource{}
+
+func NewRewardPointsConfigResource() resource.Resource {
+	return &RewardPointsConfigResource{}
+}
+
+// RewardPointsConfigResource defines the resource implementation.
+type RewardPointsConfigResource struct {
+	client *EmporixClient
+}
+
+// RewardPointsConfigResourceModel describes the resource data model.
+type RewardPointsConfigResourceModel struct {
+	ID                types.String  `tfsdk:"id"`
+	Active            types.Bool    `tfsdk:"active"`
+	EarnRate          types.Float64 `tfsdk:"earn_rate"`
+	RedemptionRate    types.Float64 `tfsdk:"redemption_rate"`
+	MinPointsToRedeem types.Int64   `tfsdk:"min_points_to_redeem"`
+	PointsExpiryDays  types.Int64   `tfsdk:"points_expiry_days"`
+}
+
+// defaultRewardPointsConfig returns the reward points configuration of a fresh tenant.
+// Attributes left out of the configuration take these values, and destroying the resource restores them.
+func defaultRewardPointsConfig() *RewardPointsConfig {
+	return &RewardPointsConfig{
+		Active:         false,
+		EarnRate:       1,
+		RedemptionRate: 100,
+	}
+}
+
+// mapRewardPointsConfigToModel converts a RewardPointsConfig API response to a RewardPointsConfigResourceModel
+func mapRewardPointsConfigToModel(tenant string, config *RewardPointsConfig, data *RewardPointsConfigResourceModel) {
+	data.ID = types.StringValue(strings.ToLower(tenant))
+	data.Active = types.BoolValue(config.Active)
+	data.EarnRate = types.Float64Value(config.EarnRate)
+	data.RedemptionRate = types.Float64Value(config.RedemptionRate)
+	data.MinPointsToRedeem = types.Int64Value(config.MinPointsToRedeem)
+	data.PointsExpiryDays = types.Int64Value(config.PointsExpiryDays)
+}
+
+// rewardPointsConfigFromModel converts a RewardPointsConfigResourceModel to a RewardPointsConfig API payload
+func rewardPointsConfigFromModel(data *RewardPointsConfigResourceModel) *RewardPointsConfig {
+	return &RewardPointsConfig{
+		Active:            data.Active.ValueBool(),
+		EarnRate:          data.EarnRate.ValueFloat64(),
+		RedemptionRate:    data.RedemptionRate.ValueFloat64(),
+		MinPointsToRedeem: data.MinPointsToRedeem.ValueInt64(),
+		PointsExpiryDays:  data.PointsExpiryDays.ValueInt64(),
+	}
+}
+
+func (r *RewardPointsConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reward_points_config"
+}
+
+func (r *RewardPointsConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	defaults := defaultRewardPointsConfig()
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the reward points configuration of the Emporix tenant: how customers earn and redeem points. " +
+			"There is one configuration per tenant; destroying the resource restores the defaults instead of deleting anything.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Tenant the configuration belongs to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: fmt.Sprintf("Whether customers earn and redeem reward points. Defaults to `%t`.", defaults.Active),
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(defaults.Active),
+			},
+			"earn_rate": schema.Float64Attribute{
+				MarkdownDescription: fmt.Sprintf("Points a customer earns per currency unit spent. Defaults to `%g`.", defaults.EarnRate),
+				Optional:            true,
+				Computed:            true,
+				Default:             float64default.StaticFloat64(defaults.EarnRate),
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"redemption_rate": schema.Float64Attribute{
+				MarkdownDescription: fmt.Sprintf("Points needed for one currency unit of discount. At least 1. Defaults to `%g`.", defaults.RedemptionRate),
+				Optional:            true,
+				Computed:            true,
+				Default:             float64default.StaticFloat64(defaults.RedemptionRate),
+				Validators: []validator.Float64{
+					float64validator.AtLeast(1),
+				},
+			},
+			"min_points_to_redeem": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Points a customer must have before redeeming any. Defaults to `%d`.", defaults.MinPointsToRedeem),
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(defaults.MinPointsToRedeem),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"points_expiry_days": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Days after which earned points expire; `0` means they never expire. Defaults to `%d`.", defaults.PointsExpiryDays),
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(defaults.PointsExpiryDays),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+func (r *RewardPointsConfigResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "Tenant the configuration belongs to.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *RewardPointsConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RewardPointsConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RewardPointsConfigResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The configuration always exists, so creating the resource takes it over and updates it
+	tflog.Debug(ctx, "Taking over reward points config", map[string]interface{}{
+		"tenant": r.client.Tenant,
+	})
+
+	config, err := r.client.UpdateRewardPointsConfig(ctx, rewardPointsConfigFromModel(&data))
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to update reward points config, got error: %s", err))
+		return
+	}
+
+	mapRewardPointsConfigToModel(r.client.Tenant, config, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *RewardPointsConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RewardPointsConfigResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading reward points config", map[string]interface{}{
+		"tenant": r.client.Tenant,
+	})
+
+	config, err := r.client.GetRewardPointsConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read reward points config, got error: %s", err))
+		return
+	}
+
+	mapRewardPointsConfigToModel(r.client.Tenant, config, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *RewardPointsConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RewardPointsConfigResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating reward points config", map[string]interface{}{
+		"tenant": r.client.Tenant,
+	})
+
+	config, err := r.client.UpdateRewardPointsConfig(ctx, rewardPointsConfigFromModel(&data))
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to update reward points config, got error: %s", err))
+		return
+	}
+
+	mapRewardPointsConfigToModel(r.client.Tenant, config, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *RewardPointsConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Restoring reward points config defaults", map[string]interface{}{
+		"tenant": r.client.Tenant,
+	})
+
+	// The configuration cannot be deleted, so restore the defaults (like emporix_country deactivates)
+	_, err := r.client.UpdateRewardPointsConfig(ctx, defaultRewardPointsConfig())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to restore reward points config defaults, got error: %s", err))
+		return
+	}
+
+	// The defaults are restored and the configuration will be removed from Terraform state
+}
+
+func (r *RewardPointsConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by tenant name, or by identity { id }
+	importState(ctx, req, resp, "id")
+}
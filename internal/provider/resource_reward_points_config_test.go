@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccRewardPointsConfigResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRewardPointsConfigDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: `
+resource "emporix_reward_points_config" "test" {
+  active             = true
+  earn_rate          = 2
+  points_expiry_days = 365
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("emporix_reward_points_config.test", "id"),
+					resource.TestCheckResourceAttr("emporix_reward_points_config.test", "active", "true"),
+					resource.TestCheckResourceAttr("emporix_reward_points_config.test", "earn_rate", "2"),
+					resource.TestCheckResourceAttr("emporix_reward_points_config.test", "redemption_rate", "100"),
+					resource.TestCheckResourceAttr("emporix_reward_points_config.test", "min_points_to_redeem", "0"),
+					resource.TestCheckResourceAttr("emporix_reward_points_config.test", "points_expiry_days", "365"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "emporix_reward_points_config.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update testing: attributes removed from the configuration fall back to the defaults
+			{
+				Config: `
+resource "emporix_reward_points_config" "test" {
+  active               = true
+  min_points_to_redeem = 500
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("emporix_reward_points_config.test", "earn_rate", "1"),
+					resource.TestCheckResourceAttr("emporix_reward_points_config.test", "min_points_to_redeem", "500"),
+					resource.TestCheckResourceAttr("emporix_reward_points_config.test", "points_expiry_days", "0"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckRewardPointsConfigDestroy verifies destroy restored the defaults, as the configuration cannot be deleted
+func testAccCheckRewardPointsConfigDestroy(s *terraform.State) error {
+	ctx := context.Background()
+
+	client, err := getTestClient()
+	if err != nil {
+		return fmt.Errorf("failed to get test client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "emporix_reward_points_config" {
+			continue
+		}
+
+		config, err := client.GetRewardPointsConfig(ctx)
+		if err != nil {
+			return fmt.Errorf("error checking reward points config after destroy: %w", err)
+		}
+
+		defaults := defaultRewardPointsConfig()
+		config.Metadata = nil
+		if *config != *defaults {
+			return fmt.Errorf("reward points config was not restored to the defaults after destroy: %+v", config)
+		}
+	}
+
+	return nil
+}
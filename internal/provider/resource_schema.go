@@ -7,8 +7,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -20,6 +20,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &SchemaResource{}
 var _ resource.ResourceWithImportState = &SchemaResource{}
+var _ resource.ResourceWithIdentity = &SchemaResource{}
 
 func NewSchemaResource() resource.Resource {
 	return &SchemaResource{}
@@ -85,6 +86,17 @@ func (r *SchemaResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+func (r *SchemaResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "Schema ID.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *SchemaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -151,7 +163,7 @@ func (r *SchemaResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	schema, err := r.client.CreateSchema(ctx, schemaCreate)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create schema, got error: %s", err))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to create schema, got error: %s", err))
 		return
 	}
 
@@ -166,6 +178,7 @@ func (r *SchemaResource) Create(ctx context.Context, req resource.CreateRequest,
 	data.Attributes = originalAttributes
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *SchemaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -206,6 +219,7 @@ func (r *SchemaResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *SchemaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -251,7 +265,7 @@ func (r *SchemaResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// Update schema via API
 	schema, err := r.client.UpdateSchema(ctx, data.ID.ValueString(), updateData)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update schema, got error: %s", err))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to update schema, got error: %s", err))
 		return
 	}
 
@@ -265,6 +279,7 @@ func (r *SchemaResource) Update(ctx context.Context, req resource.UpdateRequest,
 	data.Attributes = originalAttributes
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *SchemaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -293,8 +308,8 @@ func (r *SchemaResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *SchemaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import by schema ID
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Import by schema ID, or by identity { id }
+	importState(ctx, req, resp, "id")
 }
 
 // mapSchemaToModel converts a Schema API response to a SchemaResourceModel
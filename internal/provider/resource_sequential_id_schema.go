@@ -0,0 +1,374 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SequentialIDSchemaResource{}
+var _ resource.ResourceWithImportState = &SequentialIDSchemaResource{}
+var _ resource.ResourceWithIdentity = &SequentialIDSchemaResource{}
+
+func NewSequentialIDSchemaResource() resource.Resource {
+	return &SequentialIDSchemaResource{}
+}
+
+// SequentialIDSchemaResource defines the resource implementation.
+type SequentialIDSchemaResource struct {
+	client *EmporixClient
+}
+
+// SequentialIDSchemaResourceModel describes the resource data model.
+type SequentialIDSchemaResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	SchemaType   types.String `tfsdk:"schema_type"`
+	Prefix       types.String `tfsdk:"prefix"`
+	Suffix       types.String `tfsdk:"suffix"`
+	CounterStart types.Int64  `tfsdk:"counter_start"`
+	MaxValue     types.Int64  `tfsdk:"max_value"`
+	Active       types.Bool   `tfsdk:"active"`
+}
+
+// mapSequentialIDSchemaToModel converts a SequentialIDSchema API response to a SequentialIDSchemaResourceModel
+func mapSequentialIDSchemaToModel(schema *SequentialIDSchema, data *SequentialIDSchemaResourceModel) {
+	data.ID = types.StringValue(schema.ID)
+	data.Name = types.StringValue(schema.Name)
+	data.SchemaType = types.StringValue(schema.SchemaType)
+	data.Prefix = stringOrNull(schema.PreText)
+	data.Suffix = stringOrNull(schema.PostText)
+	data.CounterStart = types.Int64Value(schema.CounterStart)
+	data.MaxValue = types.Int64Value(schema.MaxValue)
+	data.Active = types.BoolValue(schema.Active)
+}
+
+// sequentialIDSchemaFromModel converts a SequentialIDSchemaResourceModel to a SequentialIDSchema API payload
+func sequentialIDSchemaFromModel(data *SequentialIDSchemaResourceModel) *SequentialIDSchema {
+	return &SequentialIDSchema{
+		Name:         data.Name.ValueString(),
+		SchemaType:   data.SchemaType.ValueString(),
+		PreText:      data.Prefix.ValueString(),
+		PostText:     data.Suffix.ValueString(),
+		CounterStart: data.CounterStart.ValueInt64(),
+		MaxValue:     data.MaxValue.ValueInt64(),
+	}
+}
+
+// sequentialIDCounterRangeValidator validates that counter_start is below max_value
+type sequentialIDCounterRangeValidator struct{}
+
+func (v sequentialIDCounterRangeValidator) Description(ctx context.Context) string {
+	return "Ensures the counter starts below max_value"
+}
+
+func (v sequentialIDCounterRangeValidator) MarkdownDescription(ctx context.Context) string {
+	return "Ensures the counter starts below `max_value`, so the schema can hand out at least one number."
+}
+
+func (v sequentialIDCounterRangeValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var maxValue types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("max_value"), &maxValue)...)
+	if resp.Diagnostics.HasError() || maxValue.IsNull() || maxValue.IsUnknown() {
+		return
+	}
+
+	if req.ConfigValue.ValueInt64() >= maxValue.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Counter Range",
+			fmt.Sprintf("counter_start (%d) must be below max_value (%d).", req.ConfigValue.ValueInt64(), maxValue.ValueInt64()),
+		)
+	}
+}
+
+func (r *SequentialIDSchemaResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sequential_id_schema"
+}
+
+func (r *SequentialIDSchemaResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a schema of the Emporix sequential ID service: the number format of orders, quotes, invoices and other documents. " +
+			"Each schema type has one active schema, which hands out the numbers of that type.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the schema, generated by the API.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the schema (e.g., 'Order numbers 2025').",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"schema_type": schema.StringAttribute{
+				MarkdownDescription: "Type of number the schema generates (e.g., `order`, `quote` or `invoice`). " +
+					"Changing this forces a new schema to be created.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"prefix": schema.StringAttribute{
+				MarkdownDescription: "Text put before the counter (e.g., 'ORD-').",
+				Optional:            true,
+			},
+			"suffix": schema.StringAttribute{
+				MarkdownDescription: "Text put after the counter (e.g., '-DE').",
+				Optional:            true,
+			},
+			"counter_start": schema.Int64Attribute{
+				MarkdownDescription: "First value of the counter. Must be below `max_value`. " +
+					"Changing this forces a new schema to be created, so numbers already handed out are never reused.",
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+					sequentialIDCounterRangeValidator{},
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"max_value": schema.Int64Attribute{
+				MarkdownDescription: "Highest value of the counter (e.g., 99999999).",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the schema is the active schema of its type. Setting it to `true` deactivates the schema that was active before. " +
+					"A schema is deactivated by activating another schema of the same type; setting `false` on the active schema is an error. " +
+					"When omitted, the provider does not change which schema is active.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SequentialIDSchemaResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "Sequential ID schema ID.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *SequentialIDSchemaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// activate makes the schema the active schema of its type when the plan asks for it and it isn't yet,
+// and returns the schema as it is afterwards. The caller holds getSequentialIDSchemaMutex.
+func (r *SequentialIDSchemaResource) activate(ctx context.Context, current *SequentialIDSchema, planned types.Bool, diags *diag.Diagnostics) *SequentialIDSchema {
+	if planned.IsNull() || planned.IsUnknown() || planned.ValueBool() == current.Active {
+		return current
+	}
+
+	if !planned.ValueBool() {
+		diags.AddAttributeError(
+			path.Root("active"),
+			"Cannot Deactivate Sequential ID Schema",
+			fmt.Sprintf("Schema %s is the active %q schema. Activate another schema of the same type instead of setting active = false.",
+				current.ID, current.SchemaType),
+		)
+		return current
+	}
+
+	tflog.Debug(ctx, "Activating sequential ID schema", map[string]interface{}{
+		"id":          current.ID,
+		"schema_type": current.SchemaType,
+	})
+
+	if err := r.client.SetActiveSequentialIDSchema(ctx, current.ID); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to activate sequential ID schema, got error: %s", err))
+		return current
+	}
+
+	activated, err := r.client.GetSequentialIDSchema(ctx, current.ID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read activated sequential ID schema, got error: %s", err))
+		return current
+	}
+	return activated
+}
+
+func (r *SequentialIDSchemaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SequentialIDSchemaResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating sequential ID schema", map[string]interface{}{
+		"name":        data.Name.ValueString(),
+		"schema_type": data.SchemaType.ValueString(),
+	})
+
+	// Lock per-tenant mutex so that schemas activated in the same apply are activated one
+	// after the other, and each reads back the active flag its own activation left behind.
+	mu := getSequentialIDSchemaMutex(r.client.Tenant)
+	mu.Lock()
+	defer mu.Unlock()
+
+	created, err := r.client.CreateSequentialIDSchema(ctx, sequentialIDSchemaFromModel(&data))
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to create sequential ID schema, got error: %s", err))
+		return
+	}
+
+	planned := data.Active
+	mapSequentialIDSchemaToModel(created, &data)
+
+	// Save the created schema first, so a failed activation doesn't leave it untracked
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	activated := r.activate(ctx, created, planned, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	mapSequentialIDSchemaToModel(activated, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SequentialIDSchemaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SequentialIDSchemaResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading sequential ID schema", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	schema, err := r.client.GetSequentialIDSchema(ctx, data.ID.ValueString())
+	if err != nil {
+		// If the schema was deleted outside Terraform, remove from state (drift detection)
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read sequential ID schema, got error: %s", err))
+		return
+	}
+
+	mapSequentialIDSchemaToModel(schema, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *SequentialIDSchemaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SequentialIDSchemaResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating sequential ID schema", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	mu := getSequentialIDSchemaMutex(r.client.Tenant)
+	mu.Lock()
+	defer mu.Unlock()
+
+	updated, err := r.client.UpdateSequentialIDSchema(ctx, data.ID.ValueString(), sequentialIDSchemaFromModel(&data))
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to update sequential ID schema, got error: %s", err))
+		return
+	}
+
+	updated = r.activate(ctx, updated, data.Active, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mapSequentialIDSchemaToModel(updated, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *SequentialIDSchemaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SequentialIDSchemaResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Deleting sequential ID schema", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteSequentialIDSchema(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete sequential ID schema, got error: %s", err),
+		)
+		return
+	}
+}
+
+func (r *SequentialIDSchemaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by schema ID, or by identity { id }
+	importState(ctx, req, resp, "id")
+}
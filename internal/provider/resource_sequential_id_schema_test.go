@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccSequentialIDSchemaResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSequentialIDSchemaDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSequentialIDSchemaResourceConfig("TF-", 99999),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("emporix_sequential_id_schema.test", "id"),
+					resource.TestCheckResourceAttr("emporix_sequential_id_schema.test", "schema_type", "tfTestNoSequence"),
+					resource.TestCheckResourceAttr("emporix_sequential_id_schema.test", "prefix", "TF-"),
+					resource.TestCheckResourceAttr("emporix_sequential_id_schema.test", "counter_start", "1000"),
+					resource.TestCheckResourceAttr("emporix_sequential_id_schema.test", "active", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "emporix_sequential_id_schema.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update testing: new prefix and a higher maximum keep the schema
+			{
+				Config: testAccSequentialIDSchemaResourceConfig("TF2-", 999999),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("emporix_sequential_id_schema.test", "prefix", "TF2-"),
+					resource.TestCheckResourceAttr("emporix_sequential_id_schema.test", "max_value", "999999"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSequentialIDSchemaResource_activation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSequentialIDSchemaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSequentialIDSchemaActivationConfig("current"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("emporix_sequential_id_schema.current", "active", "true"),
+					resource.TestCheckResourceAttr("emporix_sequential_id_schema.next", "active", "false"),
+				),
+			},
+			// Switching the active schema deactivates the previous one
+			{
+				Config: testAccSequentialIDSchemaActivationConfig("next"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("emporix_sequential_id_schema.next", "active", "true"),
+				),
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("emporix_sequential_id_schema.current", "active", "false"),
+					resource.TestCheckResourceAttr("emporix_sequential_id_schema.next", "active", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSequentialIDSchemaResource_invalidCounterRange(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSequentialIDSchemaResourceConfig("TF-", 1000),
+				ExpectError: regexp.MustCompile(`Invalid Counter Range`),
+			},
+		},
+	})
+}
+
+// testAccSequentialIDSchemaResourceConfig generates an inactive schema counting from 1000 up to maxValue
+func testAccSequentialIDSchemaResourceConfig(prefix string, maxValue int) string {
+	return fmt.Sprintf(`
+resource "emporix_sequential_id_schema" "test" {
+  name          = "Terraform Test Numbers"
+  schema_type   = "tfTestNoSequence"
+  prefix        = %[1]q
+  counter_start = 1000
+  max_value     = %[2]d
+}
+`, prefix, maxValue)
+}
+
+// testAccSequentialIDSchemaActivationConfig generates two schemas of one type, with the named one active
+func testAccSequentialIDSchemaActivationConfig(active string) string {
+	config := ""
+	for _, name := range []string{"current", "next"} {
+		activeAttr := ""
+		if name == active {
+			activeAttr = "active = true"
+		}
+		config += fmt.Sprintf(`
+resource "emporix_sequential_id_schema" %[1]q {
+  name          = "Terraform %[1]s numbers"
+  schema_type   = "tfTestActivationSequence"
+  prefix        = "%[1]s-"
+  counter_start = 1
+  max_value     = 99999
+  %[2]s
+}
+`, name, activeAttr)
+	}
+	return config
+}
+
+func testAccCheckSequentialIDSchemaDestroy(s *terraform.State) error {
+	ctx := context.Background()
+
+	client, err := getTestClient()
+	if err != nil {
+		return fmt.Errorf("failed to get test client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "emporix_sequential_id_schema" {
+			continue
+		}
+
+		_, err := client.GetSequentialIDSchema(ctx, rs.Primary.ID)
+		if IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("unexpected error checking sequential ID schema: %w", err)
+		}
+
+		return fmt.Errorf("sequential ID schema %s still exists after destroy", rs.Primary.ID)
+	}
+
+	return nil
+}
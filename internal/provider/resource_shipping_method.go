@@ -4,12 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -23,6 +22,7 @@ var (
 	_ resource.Resource                = &ShippingMethodResource{}
 	_ resource.ResourceWithConfigure   = &ShippingMethodResource{}
 	_ resource.ResourceWithImportState = &ShippingMethodResource{}
+	_ resource.ResourceWithIdentity    = &ShippingMethodResource{}
 )
 
 func NewShippingMethodResource() resource.Resource {
@@ -172,16 +172,7 @@ func (r *ShippingMethodResource) Schema(ctx context.Context, req resource.Schema
 			"max_order_value": schema.SingleNestedAttribute{
 				MarkdownDescription: "Maximum order value for this shipping method. Orders above this value cannot use this method.",
 				Optional:            true,
-				Attributes: map[string]schema.Attribute{
-					"amount": schema.Float64Attribute{
-						MarkdownDescription: "Amount value.",
-						Required:            true,
-					},
-					"currency": schema.StringAttribute{
-						MarkdownDescription: "Currency code (e.g., 'USD', 'EUR').",
-						Required:            true,
-					},
-				},
+				Attributes:          monetaryAmountAttributes(),
 			},
 			"fees": schema.ListNestedAttribute{
 				MarkdownDescription: "Shipping fee tiers based on order value. Multiple tiers can be defined for different order value ranges.",
@@ -191,30 +182,12 @@ func (r *ShippingMethodResource) Schema(ctx context.Context, req resource.Schema
 						"min_order_value": schema.SingleNestedAttribute{
 							MarkdownDescription: "Minimum order value for this fee tier.",
 							Required:            true,
-							Attributes: map[string]schema.Attribute{
-								"amount": schema.Float64Attribute{
-									MarkdownDescription: "Amount value.",
-									Required:            true,
-								},
-								"currency": schema.StringAttribute{
-									MarkdownDescription: "Currency code (e.g., 'USD', 'EUR').",
-									Required:            true,
-								},
-							},
+							Attributes:          monetaryAmountAttributes(),
 						},
 						"cost": schema.SingleNestedAttribute{
 							MarkdownDescription: "Shipping cost for this tier.",
 							Required:            true,
-							Attributes: map[string]schema.Attribute{
-								"amount": schema.Float64Attribute{
-									MarkdownDescription: "Amount value.",
-									Required:            true,
-								},
-								"currency": schema.StringAttribute{
-									MarkdownDescription: "Currency code (e.g., 'USD', 'EUR').",
-									Required:            true,
-								},
-							},
+							Attributes:          monetaryAmountAttributes(),
 						},
 						"shipping_group_id": schema.StringAttribute{
 							MarkdownDescription: "Optional shipping group ID for this fee tier.",
@@ -235,6 +208,25 @@ func (r *ShippingMethodResource) Schema(ctx context.Context, req resource.Schema
 	}
 }
 
+func (r *ShippingMethodResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"site": identityschema.StringAttribute{
+				Description:       "Site code the method belongs to.",
+				RequiredForImport: true,
+			},
+			"zone_id": identityschema.StringAttribute{
+				Description:       "Shipping zone ID.",
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "Shipping method ID.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *ShippingMethodResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -270,7 +262,7 @@ func (r *ShippingMethodResource) Create(ctx context.Context, req resource.Create
 	// Create shipping method via API
 	createdMethod, err := r.client.CreateShippingMethod(ctx, data.Site.ValueString(), data.ZoneID.ValueString(), apiMethod)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating shipping method", err.Error())
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Error creating shipping method", err.Error())
 		return
 	}
 
@@ -298,12 +290,13 @@ func (r *ShippingMethodResource) Create(ctx context.Context, req resource.Create
 	stateModel.Site = data.Site
 	stateModel.ZoneID = data.ZoneID
 	stateModel.ID = types.StringValue(createdID) // Use the actual ID from API
-	r.syncModelFromAPI(ctx, &stateModel, actualMethod, &resp.Diagnostics)
+	mapShippingMethodToModel(ctx, actualMethod, &stateModel, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *ShippingMethodResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -327,12 +320,13 @@ func (r *ShippingMethodResource) Read(ctx context.Context, req resource.ReadRequ
 	var stateModel ShippingMethodResourceModel
 	stateModel.Site = data.Site
 	stateModel.ZoneID = data.ZoneID
-	r.syncModelFromAPI(ctx, &stateModel, method, &resp.Diagnostics)
+	mapShippingMethodToModel(ctx, method, &stateModel, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *ShippingMethodResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -351,7 +345,7 @@ func (r *ShippingMethodResource) Update(ctx context.Context, req resource.Update
 
 	_, err := r.client.UpdateShippingMethod(ctx, data.Site.ValueString(), data.ZoneID.ValueString(), data.ID.ValueString(), apiMethod)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating shipping method", err.Error())
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Error updating shipping method", err.Error())
 		return
 	}
 
@@ -365,12 +359,13 @@ func (r *ShippingMethodResource) Update(ctx context.Context, req resource.Update
 	var stateModel ShippingMethodResourceModel
 	stateModel.Site = data.Site
 	stateModel.ZoneID = data.ZoneID
-	r.syncModelFromAPI(ctx, &stateModel, actualMethod, &resp.Diagnostics)
+	mapShippingMethodToModel(ctx, actualMethod, &stateModel, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateModel)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *ShippingMethodResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -389,24 +384,8 @@ func (r *ShippingMethodResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *ShippingMethodResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: "site:zone_id:method_id"
-	// Example: "main:zone-us:standard-shipping"
-	parts := strings.Split(req.ID, ":")
-	if len(parts) != 3 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID in format 'site:zone_id:method_id', got: %s", req.ID),
-		)
-		return
-	}
-
-	site := parts[0]
-	zoneID := parts[1]
-	methodID := parts[2]
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), site)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_id"), zoneID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), methodID)...)
+	// Import ID format: "site:zone_id:id" (e.g. "main:zone-us:standard-shipping"), or identity { site, zone_id, id }
+	importState(ctx, req, resp, "site", "zone_id", "id")
 }
 
 // Helper functions
@@ -495,7 +474,9 @@ func (r *ShippingMethodResource) toAPIModel(ctx context.Context, model *Shipping
 	return apiMethod, diags
 }
 
-func (r *ShippingMethodResource) syncModelFromAPI(ctx context.Context, model *ShippingMethodResourceModel, api *ShippingMethod, diags *diag.Diagnostics) {
+// mapShippingMethodToModel syncs the Terraform model from a ShippingMethod API response.
+// site and zone_id are path parameters and left untouched.
+func mapShippingMethodToModel(ctx context.Context, api *ShippingMethod, model *ShippingMethodResourceModel, diags *diag.Diagnostics) {
 	model.ID = types.StringValue(api.ID)
 	model.Active = types.BoolValue(api.Active)
 
@@ -581,6 +562,20 @@ func (r *ShippingMethodResource) syncModelFromAPI(ctx context.Context, model *Sh
 	}
 }
 
+// monetaryAmountAttributes returns the required amount/currency attributes of a MonetaryAmountModel
+func monetaryAmountAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"amount": schema.Float64Attribute{
+			MarkdownDescription: "Amount value.",
+			Required:            true,
+		},
+		"currency": schema.StringAttribute{
+			MarkdownDescription: "Currency code (e.g., 'USD', 'EUR').",
+			Required:            true,
+		},
+	}
+}
+
 // AttributeTypes helper methods
 func (m MonetaryAmountModel) AttributeTypes() map[string]attr.Type {
 	return map[string]attr.Type{
@@ -3,12 +3,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -20,6 +19,7 @@ import (
 var (
 	_ resource.Resource                = &ShippingZoneResource{}
 	_ resource.ResourceWithImportState = &ShippingZoneResource{}
+	_ resource.ResourceWithIdentity    = &ShippingZoneResource{}
 )
 
 type ShippingZoneResource struct {
@@ -114,6 +114,21 @@ func (r *ShippingZoneResource) Schema(ctx context.Context, req resource.SchemaRe
 	}
 }
 
+func (r *ShippingZoneResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"site": identityschema.StringAttribute{
+				Description:       "Site code the zone belongs to.",
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "Shipping zone ID.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *ShippingZoneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// ProviderData will be nil when the resource is initially created
 	// This is expected behavior during the configure phase
@@ -178,7 +193,7 @@ func (r *ShippingZoneResource) Create(ctx context.Context, req resource.CreateRe
 	// Create zone via API
 	_, err := r.client.CreateShippingZone(ctx, data.Site.ValueString(), zone)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create shipping zone, got error: %s", err))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to create shipping zone, got error: %s", err))
 		return
 	}
 
@@ -194,30 +209,13 @@ func (r *ShippingZoneResource) Create(ctx context.Context, req resource.CreateRe
 	// Sync ALL fields to state
 	// Note: site and id are preserved from plan (site is path parameter, not in API response)
 	// data.Site already set from plan
-	data.ID = types.StringValue(actualZone.ID)
-	data.Default = types.BoolValue(actualZone.Default)
-
-	// Convert API response name - use actual response, not original nameMap
-	if actualZone.Name != nil {
-		nameMapResult, diagsName := convertNameToMap(ctx, actualZone.Name, nameMap)
-		resp.Diagnostics.Append(diagsName...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.Name = nameMapResult
-	}
-
-	// Convert ship_to from actual response
-	if len(actualZone.ShipTo) > 0 {
-		var diagsShipTo diag.Diagnostics
-		data.ShipTo, diagsShipTo = convertShipToToSet(ctx, actualZone.ShipTo)
-		resp.Diagnostics.Append(diagsShipTo...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+	mapShippingZoneToModel(ctx, actualZone, nameMap, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *ShippingZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -254,30 +252,13 @@ func (r *ShippingZoneResource) Read(ctx context.Context, req resource.ReadReques
 	// Sync ALL fields to state from the API response
 	// Note: site is preserved from existing state (it's a path parameter, not in API response)
 	// data.Site already set from state
-	data.ID = types.StringValue(actualZone.ID)
-	data.Default = types.BoolValue(actualZone.Default)
-
-	// Convert API response name
-	if actualZone.Name != nil {
-		nameMapResult, diagsName := convertNameToMap(ctx, actualZone.Name, originalNameMap)
-		resp.Diagnostics.Append(diagsName...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.Name = nameMapResult
-	}
-
-	// Convert ship_to from API response
-	if len(actualZone.ShipTo) > 0 {
-		var diagsShipTo diag.Diagnostics
-		data.ShipTo, diagsShipTo = convertShipToToSet(ctx, actualZone.ShipTo)
-		resp.Diagnostics.Append(diagsShipTo...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+	mapShippingZoneToModel(ctx, actualZone, originalNameMap, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *ShippingZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -325,7 +306,7 @@ func (r *ShippingZoneResource) Update(ctx context.Context, req resource.UpdateRe
 	// Update zone via API
 	_, err := r.client.UpdateShippingZone(ctx, data.Site.ValueString(), data.ID.ValueString(), zone)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update shipping zone, got error: %s", err))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to update shipping zone, got error: %s", err))
 		return
 	}
 
@@ -341,30 +322,13 @@ func (r *ShippingZoneResource) Update(ctx context.Context, req resource.UpdateRe
 	// Sync ALL fields to state
 	// Note: site and id are preserved from plan (site is path parameter, not in API response)
 	// data.Site already set from plan
-	data.ID = types.StringValue(actualZone.ID)
-	data.Default = types.BoolValue(actualZone.Default)
-
-	// Convert API response name - use actual response, not original nameMap
-	if actualZone.Name != nil {
-		nameMapResult, diagsName := convertNameToMap(ctx, actualZone.Name, nameMap)
-		resp.Diagnostics.Append(diagsName...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.Name = nameMapResult
-	}
-
-	// Convert ship_to from actual response
-	if len(actualZone.ShipTo) > 0 {
-		var diagsShipTo diag.Diagnostics
-		data.ShipTo, diagsShipTo = convertShipToToSet(ctx, actualZone.ShipTo)
-		resp.Diagnostics.Append(diagsShipTo...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+	mapShippingZoneToModel(ctx, actualZone, nameMap, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *ShippingZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -387,7 +351,7 @@ func (r *ShippingZoneResource) Delete(ctx context.Context, req resource.DeleteRe
 		tflog.Debug(ctx, "Zone is default, checking if we need to reassign default to another zone")
 
 		// List all zones to see if there are others
-		allZones, err := r.client.ListShippingZones(ctx, data.Site.ValueString())
+		allZones, err := r.client.ListShippingZones(ctx, data.Site.ValueString(), nil)
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list shipping zones, got error: %s", err))
 			return
@@ -434,22 +398,8 @@ func (r *ShippingZoneResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *ShippingZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: "site:zone-id"
-	// Example: "main:zone-express"
-	parts := strings.Split(req.ID, ":")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID in format 'site:zone-id', got: %s", req.ID),
-		)
-		return
-	}
-
-	site := parts[0]
-	zoneID := parts[1]
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), site)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), zoneID)...)
+	// Import ID format: "site:id" (e.g. "main:zone-express"), or identity { site, id }
+	importState(ctx, req, resp, "site", "id")
 }
 
 // uniqueCountryValidatorSet validates that each country appears only once in ship_to set
@@ -505,6 +455,26 @@ func (v uniqueCountryValidatorSet) ValidateSet(ctx context.Context, req validato
 	}
 }
 
+// mapShippingZoneToModel syncs a ShippingZone API response into the model.
+// site is a path parameter and not part of the response, so it is left untouched.
+// originalNameMap preserves the configured language key when the API returns the name as a plain string.
+func mapShippingZoneToModel(ctx context.Context, zone *ShippingZone, originalNameMap map[string]string, data *ShippingZoneResourceModel, diags *diag.Diagnostics) {
+	data.ID = types.StringValue(zone.ID)
+	data.Default = types.BoolValue(zone.Default)
+
+	if zone.Name != nil {
+		nameMapResult, d := convertNameToMap(ctx, zone.Name, originalNameMap)
+		diags.Append(d...)
+		data.Name = nameMapResult
+	}
+
+	if len(zone.ShipTo) > 0 {
+		shipTo, d := convertShipToToSet(ctx, zone.ShipTo)
+		diags.Append(d...)
+		data.ShipTo = shipTo
+	}
+}
+
 // uniqueCountryValidator validates that each country appears only once in ship_to list (deprecated, kept for compatibility)
 // Helper function to convert ShippingDestination slice to types.Set
 func convertShipToToSet(ctx context.Context, shipTo []ShippingDestination) (types.Set, diag.Diagnostics) {
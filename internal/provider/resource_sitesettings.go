@@ -4,9 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
@@ -14,10 +17,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &SiteSettingsResource{}
 var _ resource.ResourceWithImportState = &SiteSettingsResource{}
+var _ resource.ResourceWithIdentity = &SiteSettingsResource{}
+var _ resource.ResourceWithModifyPlan = &SiteSettingsResource{}
 
 func NewSiteSettingsResource() resource.Resource {
 	return &SiteSettingsResource{}
@@ -203,6 +209,17 @@ func (r *SiteSettingsResource) Schema(ctx context.Context, req resource.SchemaRe
 	}
 }
 
+func (r *SiteSettingsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"code": identityschema.StringAttribute{
+				Description:       "Site code.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *SiteSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -252,7 +269,7 @@ func (r *SiteSettingsResource) Create(ctx context.Context, req resource.CreateRe
 
 	err := r.client.CreateSite(ctx, site)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, siteFieldPath(ctx, &plan), err,
 			"Error creating site",
 			fmt.Sprintf("Could not create site: %s", err.Error()),
 		)
@@ -272,7 +289,9 @@ func (r *SiteSettingsResource) Create(ctx context.Context, req resource.CreateRe
 			}
 			err := r.client.PostSiteMixin(ctx, plan.Code.ValueString(), mixinName, fields, schemaURL)
 			if err != nil {
-				resp.Diagnostics.AddError(
+				addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, func(string) (path.Path, bool) {
+					return mixinFieldsPath(ctx, plan.Mixins, mixinName)
+				}, err,
 					"Error adding mixin",
 					fmt.Sprintf("Could not add mixin '%s' to site: %s", mixinName, err.Error()),
 				)
@@ -292,7 +311,7 @@ func (r *SiteSettingsResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	// Update state with computed values (preserve plan values to prevent consistency errors)
-	r.apiToTerraform(ctx, createdSite, &plan, &plan, &resp.Diagnostics, true)
+	mapSiteToModel(ctx, createdSite, &plan, &plan, &resp.Diagnostics, true)
 
 	// Restore the original order for ship_to_countries if they contain the same elements
 	if len(originalShipToCountries) > 0 && createdSite.ShipToCountries != nil {
@@ -304,6 +323,7 @@ func (r *SiteSettingsResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *SiteSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -337,7 +357,7 @@ func (r *SiteSettingsResource) Read(ctx context.Context, req resource.ReadReques
 	}
 	originalTaxCalculationType = state.TaxCalculationAddressType
 
-	r.apiToTerraform(ctx, site, &state, &state, &resp.Diagnostics, false)
+	mapSiteToModel(ctx, site, &state, &state, &resp.Diagnostics, false)
 
 	// Restore the original order for ship_to_countries if they contain the same elements
 	if len(originalShipToCountries) > 0 && site.ShipToCountries != nil {
@@ -354,6 +374,7 @@ func (r *SiteSettingsResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 // Helper function to check if two slices contain the same elements (order-independent)
@@ -383,6 +404,85 @@ func sameElements(a, b []string) bool {
 	return true
 }
 
+// ModifyPlan warns during plan about languages of the site that the tenant doesn't have,
+// when the site is created or its languages change.
+func (r *SiteSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan SiteSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state SiteSettingsResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.Languages.Equal(state.Languages) && plan.DefaultLanguage.Equal(state.DefaultLanguage) {
+			return
+		}
+	}
+
+	r.warnUnknownLanguages(ctx, &plan, &resp.Diagnostics)
+}
+
+// warnUnknownLanguages warns about languages of the site that the tenant doesn't have.
+// The site is saved anyway, as the languages may be added outside of Terraform later.
+// Languages that are not known yet, e.g. the code of an emporix_language created in the same apply, are not checked.
+func (r *SiteSettingsResource) warnUnknownLanguages(ctx context.Context, plan *SiteSettingsResourceModel, diags *diag.Diagnostics) {
+	var codes []string
+	if !plan.Languages.IsNull() && !plan.Languages.IsUnknown() {
+		for _, element := range plan.Languages.Elements() {
+			if code, ok := element.(types.String); ok && !code.IsNull() && !code.IsUnknown() {
+				codes = append(codes, code.ValueString())
+			}
+		}
+	}
+	if !plan.DefaultLanguage.IsNull() && !plan.DefaultLanguage.IsUnknown() {
+		codes = append(codes, plan.DefaultLanguage.ValueString())
+	}
+	if len(codes) == 0 {
+		return
+	}
+
+	languages, err := r.client.ListLanguages(ctx)
+	if err != nil {
+		// Reading tenant configurations may need scopes the site doesn't, so this is not an error
+		tflog.Debug(ctx, "Unable to check the languages of the site", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+	if len(languages) == 0 {
+		return
+	}
+
+	known := make(map[string]bool)
+	for _, language := range languages {
+		known[language.Code] = true
+	}
+
+	var unknown []string
+	for _, code := range codes {
+		if !known[code] {
+			unknown = append(unknown, code)
+			known[code] = true
+		}
+	}
+	if len(unknown) > 0 {
+		diags.AddAttributeWarning(path.Root("languages"), "Unknown Site Languages",
+			fmt.Sprintf("The site uses languages the tenant doesn't have: %s. "+
+				"Add them with emporix_language resources, or to the project_lang tenant configuration. "+
+				"If emporix_language resources in this configuration add them, reference their code so they are created first.", strings.Join(unknown, ", ")))
+	}
+}
+
 func (r *SiteSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan SiteSettingsResourceModel
 	var state SiteSettingsResourceModel
@@ -429,7 +529,7 @@ func (r *SiteSettingsResource) Update(ctx context.Context, req resource.UpdateRe
 		if len(patchData) > 0 {
 			err := r.client.UpdateSite(ctx, plan.Code.ValueString(), patchData)
 			if err != nil {
-				resp.Diagnostics.AddError(
+				addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, siteFieldPath(ctx, &plan), err,
 					"Error updating site",
 					fmt.Sprintf("Could not update site: %s", err.Error()),
 				)
@@ -498,7 +598,9 @@ func (r *SiteSettingsResource) Update(ctx context.Context, req resource.UpdateRe
 				// Mixin already exists — update via PUT
 				err := r.client.PutSiteMixin(ctx, plan.Code.ValueString(), mixinName, fields, schemaURL)
 				if err != nil {
-					resp.Diagnostics.AddError(
+					addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, func(string) (path.Path, bool) {
+						return mixinFieldsPath(ctx, plan.Mixins, mixinName)
+					}, err,
 						"Error updating mixin",
 						fmt.Sprintf("Could not update mixin '%s': %s", mixinName, err.Error()),
 					)
@@ -508,7 +610,9 @@ func (r *SiteSettingsResource) Update(ctx context.Context, req resource.UpdateRe
 				// New mixin — create via POST
 				err := r.client.PostSiteMixin(ctx, plan.Code.ValueString(), mixinName, fields, schemaURL)
 				if err != nil {
-					resp.Diagnostics.AddError(
+					addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, func(string) (path.Path, bool) {
+						return mixinFieldsPath(ctx, plan.Mixins, mixinName)
+					}, err,
 						"Error creating mixin",
 						fmt.Sprintf("Could not create mixin '%s': %s", mixinName, err.Error()),
 					)
@@ -528,7 +632,7 @@ func (r *SiteSettingsResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
-	r.apiToTerraform(ctx, updatedSite, &plan, &plan, &resp.Diagnostics, true)
+	mapSiteToModel(ctx, updatedSite, &plan, &plan, &resp.Diagnostics, true)
 
 	// Restore the original order for ship_to_countries if they contain the same elements
 	if len(originalShipToCountries) > 0 && updatedSite.ShipToCountries != nil {
@@ -540,6 +644,7 @@ func (r *SiteSettingsResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *SiteSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -562,5 +667,6 @@ func (r *SiteSettingsResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *SiteSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("code"), req, resp)
+	// Import by site code, or by identity { code }
+	importState(ctx, req, resp, "code")
 }
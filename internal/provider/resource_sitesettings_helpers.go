@@ -5,26 +5,66 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// mixinObjectAttrTypes are the attribute types of a mixins list element (see MixinModel)
+var mixinObjectAttrTypes = map[string]attr.Type{
+	"name":       types.StringType,
+	"schema_url": types.StringType,
+	"fields":     types.StringType,
+}
+
+// mixinFieldsPath returns the path of the fields attribute of the named mixin in the planned mixins,
+// so API validation errors for mixin data point at the mixin block that caused them
+func mixinFieldsPath(ctx context.Context, planMixins types.List, mixinName string) (path.Path, bool) {
+	if planMixins.IsNull() || planMixins.IsUnknown() {
+		return path.Empty(), false
+	}
+
+	var mixins []MixinModel
+	if d := planMixins.ElementsAs(ctx, &mixins, false); d.HasError() {
+		return path.Empty(), false
+	}
+	for i, m := range mixins {
+		if m.Name.ValueString() == mixinName {
+			return path.Root("mixins").AtListIndex(i).AtName("fields"), true
+		}
+	}
+	return path.Empty(), false
+}
+
+// siteFieldPath maps API field errors for a site onto the plan. Site fields follow from the
+// schema; mixin data ("mixins.<name>.<field>") lives in the JSON-encoded fields of a mixin block.
+func siteFieldPath(ctx context.Context, plan *SiteSettingsResourceModel) apiFieldPathFunc {
+	return func(field string) (path.Path, bool) {
+		parts := strings.SplitN(field, ".", 3)
+		if len(parts) < 2 || parts[0] != "mixins" {
+			return path.Empty(), false
+		}
+		return mixinFieldsPath(ctx, plan.Mixins, parts[1])
+	}
+}
+
 // orderedMixinNames returns mixin names in a deterministic order. Mixin names
-// already present in previousModel's mixins list keep their existing relative
+// already present in the previous mixins list keep their existing relative
 // order (so a stable plan doesn't get reshuffled), and any remaining mixins
 // are appended alphabetically. This avoids depending on Go's randomized
 // map iteration order, which otherwise produces a different mixins order on
 // every read/apply and trips Terraform's "produced inconsistent result after
 // apply" check whenever more than one mixin is configured.
-func orderedMixinNames(ctx context.Context, metadataMixins map[string]string, previousModel *SiteSettingsResourceModel) []string {
+func orderedMixinNames(ctx context.Context, metadataMixins map[string]string, previous types.List) []string {
 	seen := make(map[string]bool, len(metadataMixins))
 	ordered := make([]string, 0, len(metadataMixins))
 
-	if previousModel != nil && !previousModel.Mixins.IsNull() && !previousModel.Mixins.IsUnknown() {
+	if !previous.IsNull() && !previous.IsUnknown() {
 		var previousMixins []MixinModel
-		if d := previousModel.Mixins.ElementsAs(ctx, &previousMixins, false); !d.HasError() {
+		if d := previous.ElementsAs(ctx, &previousMixins, false); !d.HasError() {
 			for _, m := range previousMixins {
 				name := m.Name.ValueString()
 				if _, ok := metadataMixins[name]; ok && !seen[name] {
@@ -397,7 +437,9 @@ func (r *SiteSettingsResource) buildPatchData(ctx context.Context, plan *SiteSet
 	return patchData, diags
 }
 
-func (r *SiteSettingsResource) apiToTerraform(ctx context.Context, site *SiteSettings, model *SiteSettingsResourceModel, previousModel *SiteSettingsResourceModel, diags *diag.Diagnostics, preservePlanValues bool) {
+// mapSiteToModel syncs the Terraform model from a site API response. previousModel (plan or state)
+// decides the order of mixins and, with preservePlanValues, which optional fields stay unset.
+func mapSiteToModel(ctx context.Context, site *SiteSettings, model *SiteSettingsResourceModel, previousModel *SiteSettingsResourceModel, diags *diag.Diagnostics, preservePlanValues bool) {
 	model.Code = types.StringValue(site.Code)
 	model.Name = types.StringValue(site.Name)
 	model.Active = types.BoolValue(site.Active)
@@ -592,7 +634,7 @@ func (r *SiteSettingsResource) apiToTerraform(ctx context.Context, site *SiteSet
 
 			// Iterate through metadata.mixins (in a deterministic order) to get schema URLs
 			// Only include mixins that have both metadata AND data
-			for _, mixinName := range orderedMixinNames(ctx, site.Metadata.Mixins, previousModel) {
+			for _, mixinName := range orderedMixinNames(ctx, site.Metadata.Mixins, previousModel.Mixins) {
 				schemaURL := site.Metadata.Mixins[mixinName]
 				if mixinData, ok := site.Mixins[mixinName]; ok {
 					// Convert mixin data to JSON
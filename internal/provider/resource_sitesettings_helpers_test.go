@@ -5,17 +5,10 @@ import (
 	"reflect"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-var mixinObjectAttrTypes = map[string]attr.Type{
-	"name":       types.StringType,
-	"schema_url": types.StringType,
-	"fields":     types.StringType,
-}
-
 func mixinsListValue(t *testing.T, names []string) types.List {
 	t.Helper()
 
@@ -77,7 +70,7 @@ func TestOrderedMixinNames_PreservesPreviousOrder(t *testing.T) {
 	}
 
 	for i := 0; i < 50; i++ {
-		got := orderedMixinNames(ctx, metadataMixins, previousModel)
+		got := orderedMixinNames(ctx, metadataMixins, previousModel.Mixins)
 		if !reflect.DeepEqual(got, previousOrder) {
 			t.Fatalf("iteration %d: orderedMixinNames = %v, want %v", i, got, previousOrder)
 		}
@@ -97,7 +90,7 @@ func TestOrderedMixinNames_NewMixinsAppendedAlphabetically(t *testing.T) {
 	}
 
 	want := []string{"beta", "alpha", "zeta"}
-	got := orderedMixinNames(ctx, metadataMixins, previousModel)
+	got := orderedMixinNames(ctx, metadataMixins, previousModel.Mixins)
 	if !reflect.DeepEqual(got, want) {
 		t.Fatalf("orderedMixinNames = %v, want %v", got, want)
 	}
@@ -116,7 +109,7 @@ func TestOrderedMixinNames_NoPreviousMixins_SortsAlphabetically(t *testing.T) {
 	}
 
 	want := []string{"a", "b", "c"}
-	got := orderedMixinNames(ctx, metadataMixins, previousModel)
+	got := orderedMixinNames(ctx, metadataMixins, previousModel.Mixins)
 	if !reflect.DeepEqual(got, want) {
 		t.Fatalf("orderedMixinNames = %v, want %v", got, want)
 	}
@@ -149,8 +142,6 @@ func TestApiToTerraform_MixinsOrderStableAcrossRepeatedCalls(t *testing.T) {
 		site.Mixins[n] = map[string]interface{}{"field": n}
 	}
 
-	r := &SiteSettingsResource{}
-
 	for i := 0; i < 50; i++ {
 		// Mirrors Create/Update: plan is passed as both model and previousModel.
 		plan := &SiteSettingsResourceModel{
@@ -158,9 +149,9 @@ func TestApiToTerraform_MixinsOrderStableAcrossRepeatedCalls(t *testing.T) {
 		}
 		var diags diag.Diagnostics
 
-		r.apiToTerraform(ctx, site, plan, plan, &diags, true)
+		mapSiteToModel(ctx, site, plan, plan, &diags, true)
 		if diags.HasError() {
-			t.Fatalf("iteration %d: apiToTerraform returned errors: %v", i, diags)
+			t.Fatalf("iteration %d: mapSiteToModel returned errors: %v", i, diags)
 		}
 
 		got := mixinNamesFromList(t, plan.Mixins)
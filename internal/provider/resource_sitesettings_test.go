@@ -3,15 +3,22 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccSiteSettingsResource_basic(t *testing.T) {
-	code := fmt.Sprintf("test-site-%d", time.Now().Unix())
+	code := fmt.Sprintf("test-site-%d", testAccTimestamp(t))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -53,7 +60,7 @@ func TestAccSiteSettingsResource_basic(t *testing.T) {
 }
 
 func TestAccSiteSettingsResource_multipleLanguages(t *testing.T) {
-	code := fmt.Sprintf("test-lang-%d", time.Now().Unix())
+	code := fmt.Sprintf("test-lang-%d", testAccTimestamp(t))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -73,7 +80,7 @@ func TestAccSiteSettingsResource_multipleLanguages(t *testing.T) {
 }
 
 func TestAccSiteSettingsResource_multipleCurrencies(t *testing.T) {
-	code := fmt.Sprintf("test-curr-%d", time.Now().Unix())
+	code := fmt.Sprintf("test-curr-%d", testAccTimestamp(t))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -93,7 +100,7 @@ func TestAccSiteSettingsResource_multipleCurrencies(t *testing.T) {
 }
 
 func TestAccSiteSettingsResource_shipToCountries(t *testing.T) {
-	code := fmt.Sprintf("test-ship-%d", time.Now().Unix())
+	code := fmt.Sprintf("test-ship-%d", testAccTimestamp(t))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -120,7 +127,7 @@ func TestAccSiteSettingsResource_shipToCountries(t *testing.T) {
 }
 
 func TestAccSiteSettingsResource_includesTax(t *testing.T) {
-	code := fmt.Sprintf("test-tax-%d", time.Now().Unix())
+	code := fmt.Sprintf("test-tax-%d", testAccTimestamp(t))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -147,8 +154,8 @@ func TestAccSiteSettingsResource_includesTax(t *testing.T) {
 }
 
 func TestAccSiteSettingsResource_requiresReplace(t *testing.T) {
-	code1 := fmt.Sprintf("test-replace1-%d", time.Now().Unix())
-	code2 := fmt.Sprintf("test-replace2-%d", time.Now().Unix())
+	code1 := fmt.Sprintf("test-replace1-%d", testAccTimestamp(t))
+	code2 := fmt.Sprintf("test-replace2-%d", testAccTimestamp(t))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -172,6 +179,106 @@ func TestAccSiteSettingsResource_requiresReplace(t *testing.T) {
 	})
 }
 
+func TestSiteSettingsResource_WarnsAboutUnknownLanguages(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newFakeAPIClient(t)
+	r := &SiteSettingsResource{client: client}
+
+	languages, _ := types.ListValueFrom(ctx, types.StringType, []string{"en", "fr"})
+	plan := &SiteSettingsResourceModel{DefaultLanguage: types.StringValue("en"), Languages: languages}
+
+	// Without project_lang there is nothing to check against
+	var diags diag.Diagnostics
+	r.warnUnknownLanguages(ctx, plan, &diags)
+	if diags.WarningsCount() != 0 {
+		t.Errorf("expected no warnings without project_lang, got %v", diags)
+	}
+
+	if _, err := client.CreateLanguage(ctx, &Language{Code: "en", Label: "English", Default: true}); err != nil {
+		t.Fatalf("unexpected error creating language: %s", err)
+	}
+
+	diags = nil
+	r.warnUnknownLanguages(ctx, plan, &diags)
+	if diags.WarningsCount() != 1 || diags.HasError() {
+		t.Fatalf("expected a single warning, got %v", diags)
+	}
+	if detail := diags.Warnings()[0].Detail(); !strings.Contains(detail, "fr") || strings.Contains(detail, "en,") {
+		t.Errorf("expected only fr to be reported, got %q", detail)
+	}
+}
+
+// The language check runs during plan, before the site is written
+func TestSiteSettingsResource_ModifyPlanWarnsAboutUnknownLanguages(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newFakeAPIClient(t)
+	r := &SiteSettingsResource{client: client}
+
+	if _, err := client.CreateLanguage(ctx, &Language{Code: "en", Label: "English", Default: true}); err != nil {
+		t.Fatalf("unexpected error creating language: %s", err)
+	}
+
+	_, siteSchema, diags := resourceSchema(ctx, r)
+	if diags.HasError() {
+		t.Fatalf("resource schema returned errors: %v", diags)
+	}
+	siteType := siteSchema.Type().TerraformType(ctx)
+
+	// site returns the raw value of a site with the given languages, nil for one not known during plan
+	site := func(languages ...interface{}) tftypes.Value {
+		values := make([]attr.Value, len(languages))
+		for i, language := range languages {
+			values[i] = types.StringUnknown()
+			if language != nil {
+				values[i] = types.StringValue(language.(string))
+			}
+		}
+
+		plan := tfsdk.Plan{Schema: siteSchema, Raw: tftypes.NewValue(siteType, nil)}
+		for name, value := range map[string]attr.Value{
+			"code":             types.StringValue("main"),
+			"default_language": types.StringValue("en"),
+			"languages":        types.ListValueMust(types.StringType, values),
+		} {
+			if diags := plan.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+				t.Fatalf("unable to set %s: %v", name, diags)
+			}
+		}
+		return plan.Raw
+	}
+	null := tftypes.NewValue(siteType, nil)
+
+	testCases := map[string]struct {
+		plan, state tftypes.Value
+		warnings    int
+	}{
+		"create with unknown language":   {plan: site("en", "fr"), state: null, warnings: 1},
+		"create with known languages":    {plan: site("en"), state: null, warnings: 0},
+		"languages unchanged":            {plan: site("en", "fr"), state: site("en", "fr"), warnings: 0},
+		"languages changed":              {plan: site("en", "fr"), state: site("en"), warnings: 1},
+		"language not known during plan": {plan: site("en", nil), state: null, warnings: 0},
+		"destroy":                        {plan: null, state: site("en", "fr"), warnings: 0},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := fwresource.ModifyPlanRequest{
+				Plan:  tfsdk.Plan{Schema: siteSchema, Raw: tc.plan},
+				State: tfsdk.State{Schema: siteSchema, Raw: tc.state},
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+			}
+			if resp.Diagnostics.WarningsCount() != tc.warnings {
+				t.Errorf("expected %d warnings, got %v", tc.warnings, resp.Diagnostics)
+			}
+		})
+	}
+}
+
 // testAccSiteSettingsResourceConfigBasic generates a basic site settings configuration
 func testAccSiteSettingsResourceConfigBasic(code string) string {
 	return fmt.Sprintf(`
@@ -336,8 +443,8 @@ resource "emporix_sitesettings" "test" {
 }
 
 func TestAccSiteSettingsResource_mixins(t *testing.T) {
-	code := fmt.Sprintf("test-mixin-%d", time.Now().Unix())
-	schemaId := fmt.Sprintf("ts-mxn-sch-%d", time.Now().Unix())
+	code := fmt.Sprintf("test-mixin-%d", testAccTimestamp(t))
+	schemaId := fmt.Sprintf("ts-mxn-sch-%d", testAccTimestamp(t))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
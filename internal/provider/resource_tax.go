@@ -3,11 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -19,6 +21,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &TaxResource{}
 var _ resource.ResourceWithImportState = &TaxResource{}
+var _ resource.ResourceWithIdentity = &TaxResource{}
 
 func NewTaxResource() resource.Resource {
 	return &TaxResource{}
@@ -108,6 +111,17 @@ func (r *TaxResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 	}
 }
 
+func (r *TaxResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"country_code": identityschema.StringAttribute{
+				Description:       "Country code of the tax configuration.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *TaxResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -161,7 +175,7 @@ func (r *TaxResource) Create(ctx context.Context, req resource.CreateRequest, re
 	// Create via API
 	tax, err := r.client.CreateTax(ctx, taxCreate)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create tax configuration, got error: %s", err))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, taxFieldPath, err, "Client Error", fmt.Sprintf("Unable to create tax configuration, got error: %s", err))
 		return
 	}
 
@@ -169,6 +183,7 @@ func (r *TaxResource) Create(ctx context.Context, req resource.CreateRequest, re
 	mapTaxToModel(ctx, tax, &data, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *TaxResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -199,6 +214,7 @@ func (r *TaxResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	mapTaxToModel(ctx, tax, &data, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *TaxResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -237,7 +253,7 @@ func (r *TaxResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	// Update via API
 	tax, err := r.client.UpdateTax(ctx, data.CountryCode.ValueString(), updateData)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update tax configuration, got error: %s", err))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, taxFieldPath, err, "Client Error", fmt.Sprintf("Unable to update tax configuration, got error: %s", err))
 		return
 	}
 
@@ -245,6 +261,7 @@ func (r *TaxResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	mapTaxToModel(ctx, tax, &data, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *TaxResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -273,8 +290,8 @@ func (r *TaxResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 }
 
 func (r *TaxResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import by country code (e.g., "US", "DE", "GB")
-	resource.ImportStatePassthroughID(ctx, path.Root("country_code"), req, resp)
+	// Import by country code (e.g., "US", "DE", "GB"), or by identity { country_code }
+	importState(ctx, req, resp, "country_code")
 }
 
 // taxClassModelsToAPI converts a list of TaxClassModel (Terraform state) to API TaxClass structs.
@@ -316,6 +333,15 @@ func taxClassModelsToAPI(ctx context.Context, taxClassModels []TaxClassModel, di
 	return taxClasses
 }
 
+// taxFieldPath maps the nested location of the tax API onto the flat country_code attribute;
+// tax class fields follow from the schema
+func taxFieldPath(field string) (path.Path, bool) {
+	if strings.HasPrefix(field, "location") {
+		return path.Root("country_code"), true
+	}
+	return path.Empty(), false
+}
+
 // mapTaxToModel converts a Tax API response to a TaxResourceModel
 func mapTaxToModel(ctx context.Context, tax *Tax, data *TaxResourceModel, diags *diag.Diagnostics) {
 	// Set country code (guard against nil Location from API)
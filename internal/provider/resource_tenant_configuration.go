@@ -5,18 +5,24 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &TenantConfigurationResource{}
 var _ resource.ResourceWithImportState = &TenantConfigurationResource{}
+var _ resource.ResourceWithIdentity = &TenantConfigurationResource{}
 
 func NewTenantConfigurationResource() resource.Resource {
 	return &TenantConfigurationResource{}
@@ -28,10 +34,43 @@ type TenantConfigurationResource struct {
 
 // TenantConfigurationResourceModel describes the resource data model.
 type TenantConfigurationResourceModel struct {
-	Key     types.String `tfsdk:"key"`
-	Value   types.String `tfsdk:"value"`
-	Version types.Int64  `tfsdk:"version"`
-	Secured types.Bool   `tfsdk:"secured"`
+	Key            types.String `tfsdk:"key"`
+	Value          types.String `tfsdk:"value"`
+	ValueWO        types.String `tfsdk:"value_wo"`
+	ValueWOVersion types.Int64  `tfsdk:"value_wo_version"`
+	Version        types.Int64  `tfsdk:"version"`
+	Secured        types.Bool   `tfsdk:"secured"`
+}
+
+// mapTenantConfigurationToModel converts a TenantConfiguration API response to a TenantConfigurationResourceModel.
+// The value is stored as a JSON string, unless it is set through the write-only value_wo and must stay out of state.
+func mapTenantConfigurationToModel(config *TenantConfiguration, data *TenantConfigurationResourceModel, diags *diag.Diagnostics) {
+	if !data.usesWriteOnlyValue() {
+		valueJSON, err := json.Marshal(config.Value)
+		if err != nil {
+			diags.AddError("JSON Error", fmt.Sprintf("Unable to marshal value to JSON: %s", err))
+			return
+		}
+		data.Value = types.StringValue(string(valueJSON))
+	}
+
+	data.Key = types.StringValue(config.Key)
+	data.Version = types.Int64Value(int64(config.Version))
+	data.Secured = types.BoolValue(config.Secured)
+}
+
+// usesWriteOnlyValue reports whether the value is managed through value_wo. The version is unknown
+// in a plan and known in state; only a configuration being imported has neither value nor version.
+func (m *TenantConfigurationResourceModel) usesWriteOnlyValue() bool {
+	return m.Value.IsNull() && !m.Version.IsNull()
+}
+
+// valueJSON returns the JSON value to send: value, or value_wo read from the configuration
+func (m *TenantConfigurationResourceModel) valueJSON() string {
+	if m.Value.IsNull() {
+		return m.ValueWO.ValueString()
+	}
+	return m.Value.ValueString()
 }
 
 func (r *TenantConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -53,8 +92,27 @@ func (r *TenantConfigurationResource) Schema(ctx context.Context, req resource.S
 				},
 			},
 			"value": schema.StringAttribute{
-				MarkdownDescription: "Configuration value as JSON string. Can be any valid JSON: object, string, array, or boolean.",
-				Required:            true,
+				MarkdownDescription: "Configuration value as JSON string. Can be any valid JSON: object, string, array, or boolean. Exactly one of `value` and `value_wo` must be set.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("value_wo")),
+				},
+			},
+			"value_wo": schema.StringAttribute{
+				MarkdownDescription: "Write-only alternative to `value` for secured configurations, e.g. for a secret from an ephemeral resource. Never stored in state; requires Terraform 1.11 or later. " +
+					"Terraform cannot detect changes of a write-only value: change `value_wo_version` to send a new value.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"value_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `value_wo`. Change it, e.g. increment it, to send the value again after rotating it.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AlsoRequires(path.MatchRoot("value_wo")),
+				},
 			},
 			"version": schema.Int64Attribute{
 				MarkdownDescription: "Configuration version (managed by API).",
@@ -70,6 +128,17 @@ func (r *TenantConfigurationResource) Schema(ctx context.Context, req resource.S
 	}
 }
 
+func (r *TenantConfigurationResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"key": identityschema.StringAttribute{
+				Description:       "Configuration key.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *TenantConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -95,13 +164,19 @@ func (r *TenantConfigurationResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	// Terraform leaves the write-only value_wo null in the plan, so read it from the configuration
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("value_wo"), &data.ValueWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, "Creating tenant configuration", map[string]interface{}{
 		"key": data.Key.ValueString(),
 	})
 
 	// Parse value JSON string to interface{}
 	var valueInterface interface{}
-	if err := json.Unmarshal([]byte(data.Value.ValueString()), &valueInterface); err != nil {
+	if err := json.Unmarshal([]byte(data.valueJSON()), &valueInterface); err != nil {
 		resp.Diagnostics.AddError("Invalid JSON", fmt.Sprintf("Unable to parse value as JSON: %s", err))
 		return
 	}
@@ -115,23 +190,17 @@ func (r *TenantConfigurationResource) Create(ctx context.Context, req resource.C
 
 	config, err := r.client.CreateTenantConfiguration(ctx, configCreate)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create tenant configuration, got error: %s", err))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to create tenant configuration, got error: %s", err))
 		return
 	}
 
-	// Convert value back to JSON string for storage
-	valueJSON, err := json.Marshal(config.Value)
-	if err != nil {
-		resp.Diagnostics.AddError("JSON Error", fmt.Sprintf("Unable to marshal value to JSON: %s", err))
+	mapTenantConfigurationToModel(config, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	data.Key = types.StringValue(config.Key)
-	data.Value = types.StringValue(string(valueJSON))
-	data.Version = types.Int64Value(int64(config.Version))
-	data.Secured = types.BoolValue(config.Secured)
-
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *TenantConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -158,19 +227,13 @@ func (r *TenantConfigurationResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	// Convert value to JSON string
-	valueJSON, err := json.Marshal(config.Value)
-	if err != nil {
-		resp.Diagnostics.AddError("JSON Error", fmt.Sprintf("Unable to marshal value to JSON: %s", err))
+	mapTenantConfigurationToModel(config, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	data.Key = types.StringValue(config.Key)
-	data.Value = types.StringValue(string(valueJSON))
-	data.Version = types.Int64Value(int64(config.Version))
-	data.Secured = types.BoolValue(config.Secured)
-
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *TenantConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -181,13 +244,19 @@ func (r *TenantConfigurationResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	// Terraform leaves the write-only value_wo null in the plan, so read it from the configuration
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("value_wo"), &data.ValueWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, "Updating tenant configuration", map[string]interface{}{
 		"key": data.Key.ValueString(),
 	})
 
 	// Parse value JSON string to interface{}
 	var valueInterface interface{}
-	if err := json.Unmarshal([]byte(data.Value.ValueString()), &valueInterface); err != nil {
+	if err := json.Unmarshal([]byte(data.valueJSON()), &valueInterface); err != nil {
 		resp.Diagnostics.AddError("Invalid JSON", fmt.Sprintf("Unable to parse value as JSON: %s", err))
 		return
 	}
@@ -210,23 +279,17 @@ func (r *TenantConfigurationResource) Update(ctx context.Context, req resource.U
 	// Update configuration via API
 	config, err := r.client.UpdateTenantConfiguration(ctx, data.Key.ValueString(), updateData)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update tenant configuration, got error: %s", err))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to update tenant configuration, got error: %s", err))
 		return
 	}
 
-	// Convert value back to JSON string
-	valueJSON, err := json.Marshal(config.Value)
-	if err != nil {
-		resp.Diagnostics.AddError("JSON Error", fmt.Sprintf("Unable to marshal value to JSON: %s", err))
+	mapTenantConfigurationToModel(config, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	data.Key = types.StringValue(config.Key)
-	data.Value = types.StringValue(string(valueJSON))
-	data.Version = types.Int64Value(int64(config.Version))
-	data.Secured = types.BoolValue(config.Secured)
-
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *TenantConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -252,6 +315,6 @@ func (r *TenantConfigurationResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *TenantConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import by configuration key (e.g., "project_country", "taxConfiguration")
-	resource.ImportStatePassthroughID(ctx, path.Root("key"), req, resp)
+	// Import by configuration key (e.g., "project_country"), or by identity { key }
+	importState(ctx, req, resp, "key")
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccTenantConfigurationResource_basic(t *testing.T) {
@@ -81,6 +82,42 @@ func TestAccTenantConfigurationResource_secured(t *testing.T) {
 	})
 }
 
+func TestAccTenantConfigurationResource_writeOnlyValue(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckTenantConfigurationDestroy,
+		Steps: []resource.TestStep{
+			// Create with a write-only value, which never reaches state
+			{
+				Config: testAccTenantConfigurationResourceConfigWriteOnly("test_config_wo", "first-secret", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("emporix_tenant_configuration.test", "secured", "true"),
+					resource.TestCheckNoResourceAttr("emporix_tenant_configuration.test", "value"),
+					resource.TestCheckNoResourceAttr("emporix_tenant_configuration.test", "value_wo"),
+					testAccCheckTenantConfigurationValue("test_config_wo", "first-secret"),
+				),
+			},
+			// A new value is only sent when the version changes
+			{
+				Config: testAccTenantConfigurationResourceConfigWriteOnly("test_config_wo", "second-secret", 1),
+				Check:  testAccCheckTenantConfigurationValue("test_config_wo", "first-secret"),
+			},
+			{
+				Config: testAccTenantConfigurationResourceConfigWriteOnly("test_config_wo", "second-secret", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("emporix_tenant_configuration.test", "value_wo_version", "2"),
+					resource.TestCheckNoResourceAttr("emporix_tenant_configuration.test", "value"),
+					testAccCheckTenantConfigurationValue("test_config_wo", "second-secret"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTenantConfigurationResource_requiresReplace(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -126,6 +163,38 @@ resource "emporix_tenant_configuration" "test" {
 `, key, value, secured)
 }
 
+// testAccTenantConfigurationResourceConfigWriteOnly generates a secured tenant configuration with a write-only value
+func testAccTenantConfigurationResourceConfigWriteOnly(key, value string, version int) string {
+	return fmt.Sprintf(`
+resource "emporix_tenant_configuration" "test" {
+  key              = %[1]q
+  value_wo         = jsonencode(%[2]q)
+  value_wo_version = %[3]d
+  secured          = true
+}
+`, key, value, version)
+}
+
+// testAccCheckTenantConfigurationValue verifies the value of a configuration directly against the API,
+// as a write-only value is not in state
+func testAccCheckTenantConfigurationValue(key, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := getTestClient()
+		if err != nil {
+			return fmt.Errorf("failed to get test client: %w", err)
+		}
+
+		config, err := client.GetTenantConfiguration(context.Background(), key)
+		if err != nil {
+			return fmt.Errorf("unable to read tenant configuration %q: %w", key, err)
+		}
+		if config.Value != want {
+			return fmt.Errorf("tenant configuration %q has value %v, want %q", key, config.Value, want)
+		}
+		return nil
+	}
+}
+
 // testAccCheckTenantConfigurationDestroy verifies that tenant configurations have been deleted
 func testAccCheckTenantConfigurationDestroy(s *terraform.State) error {
 	ctx := context.Background()
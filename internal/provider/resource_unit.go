@@ -0,0 +1,292 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UnitResource{}
+var _ resource.ResourceWithImportState = &UnitResource{}
+var _ resource.ResourceWithIdentity = &UnitResource{}
+var _ resource.ResourceWithValidateConfig = &UnitResource{}
+
+func NewUnitResource() resource.Resource {
+	return &UnitResource{}
+}
+
+// UnitResource defines the resource implementation.
+type UnitResource struct {
+	client *EmporixClient
+}
+
+// UnitResourceModel describes the resource data model.
+type UnitResourceModel struct {
+	Code     types.String  `tfsdk:"code"`
+	Name     types.Map     `tfsdk:"name"`
+	Type     types.String  `tfsdk:"type"`
+	BaseUnit types.Bool    `tfsdk:"base_unit"`
+	Factor   types.Float64 `tfsdk:"factor"`
+}
+
+// mapUnitToModel converts a Unit API response to a UnitResourceModel
+func mapUnitToModel(ctx context.Context, unit *Unit, data *UnitResourceModel, diags *diag.Diagnostics) {
+	data.Code = types.StringValue(unit.Code)
+	data.Type = types.StringValue(unit.Type)
+	data.BaseUnit = types.BoolValue(unit.BaseUnit)
+	data.Factor = types.Float64Value(unit.Factor)
+
+	// Convert name map to Terraform map
+	if unit.Name != nil {
+		nameMapValue, d := types.MapValueFrom(ctx, types.StringType, unit.Name)
+		diags.Append(d...)
+		data.Name = nameMapValue
+	}
+}
+
+// unitFromModel converts a UnitResourceModel to a Unit API payload
+func unitFromModel(ctx context.Context, data *UnitResourceModel, diags *diag.Diagnostics) *Unit {
+	nameMap := make(map[string]string)
+	diags.Append(data.Name.ElementsAs(ctx, &nameMap, false)...)
+
+	return &Unit{
+		Code:     data.Code.ValueString(),
+		Name:     nameMap,
+		Type:     data.Type.ValueString(),
+		BaseUnit: data.BaseUnit.ValueBool(),
+		Factor:   data.Factor.ValueFloat64(),
+	}
+}
+
+func (r *UnitResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unit"
+}
+
+func (r *UnitResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a measurement unit of the Emporix unit handling service. " +
+			"Units of the same type (e.g., mass) are converted into each other through their type's base unit.",
+
+		Attributes: map[string]schema.Attribute{
+			"code": schema.StringAttribute{
+				MarkdownDescription: "Unit code (e.g., kg, g, l). Cannot be changed after creation.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.MapAttribute{
+				MarkdownDescription: "Unit name as a map of language code to name (e.g., {\"en\": \"Kilogram\", \"de\": \"Kilogramm\"}). " +
+					"Provide at least one language translation.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Unit type, the quantity the unit measures (e.g., mass, length, volume).",
+				Required:            true,
+			},
+			"base_unit": schema.BoolAttribute{
+				MarkdownDescription: "Whether the unit is the base unit of its type. A base unit has a `factor` of 1. Defaults to false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"factor": schema.Float64Attribute{
+				MarkdownDescription: "Factor converting a quantity of this unit into the base unit of its type (e.g., 0.001 for g when kg is the base unit). " +
+					"Must be greater than 0. Defaults to 1.",
+				Optional: true,
+				Computed: true,
+				Default:  float64default.StaticFloat64(1),
+			},
+		},
+	}
+}
+
+func (r *UnitResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"code": identityschema.StringAttribute{
+				Description:       "Unit code (e.g., kg, g, l).",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *UnitResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EmporixClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *EmporixClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UnitResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data UnitResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Factor.IsNull() || data.Factor.IsUnknown() {
+		return
+	}
+
+	if data.Factor.ValueFloat64() <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("factor"),
+			"Invalid Unit Factor",
+			fmt.Sprintf("factor must be greater than 0, got: %g", data.Factor.ValueFloat64()),
+		)
+		return
+	}
+
+	if data.BaseUnit.ValueBool() && data.Factor.ValueFloat64() != 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("factor"),
+			"Invalid Unit Factor",
+			fmt.Sprintf("A base unit converts into itself, so its factor must be 1, got: %g", data.Factor.ValueFloat64()),
+		)
+	}
+}
+
+func (r *UnitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UnitResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating unit", map[string]interface{}{
+		"code": data.Code.ValueString(),
+		"type": data.Type.ValueString(),
+	})
+
+	unit := unitFromModel(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateUnit(ctx, unit)
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to create unit, got error: %s", err))
+		return
+	}
+
+	mapUnitToModel(ctx, created, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *UnitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UnitResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading unit", map[string]interface{}{
+		"code": data.Code.ValueString(),
+	})
+
+	unit, err := r.client.GetUnit(ctx, data.Code.ValueString())
+	if err != nil {
+		// If resource not found, remove from state (drift detection)
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read unit, got error: %s", err))
+		return
+	}
+
+	mapUnitToModel(ctx, unit, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *UnitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data UnitResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating unit", map[string]interface{}{
+		"code": data.Code.ValueString(),
+	})
+
+	unit := unitFromModel(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateUnit(ctx, data.Code.ValueString(), unit)
+	if err != nil {
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to update unit, got error: %s", err))
+		return
+	}
+
+	mapUnitToModel(ctx, updated, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *UnitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UnitResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Deleting unit", map[string]interface{}{
+		"code": data.Code.ValueString(),
+	})
+
+	err := r.client.DeleteUnit(ctx, data.Code.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete unit, got error: %s", err),
+		)
+		return
+	}
+}
+
+func (r *UnitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by unit code (e.g., "kg"), or by identity { code }
+	importState(ctx, req, resp, "code")
+}
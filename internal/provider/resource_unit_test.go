@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccUnitResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckUnitDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUnitResourceConfig("Gram", 0.001),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("emporix_unit.kg", "code", "tf-kg"),
+					resource.TestCheckResourceAttr("emporix_unit.kg", "base_unit", "true"),
+					resource.TestCheckResourceAttr("emporix_unit.kg", "factor", "1"),
+					resource.TestCheckResourceAttr("emporix_unit.g", "name.en", "Gram"),
+					resource.TestCheckResourceAttr("emporix_unit.g", "type", "mass"),
+					resource.TestCheckResourceAttr("emporix_unit.g", "base_unit", "false"),
+					resource.TestCheckResourceAttr("emporix_unit.g", "factor", "0.001"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "emporix_unit.g",
+				ImportState:                          true,
+				ImportStateId:                        "tf-g",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "code",
+			},
+			// Update testing
+			{
+				Config: testAccUnitResourceConfig("Grams", 0.001),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("emporix_unit.g", "name.en", "Grams"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUnitResource_invalidFactor(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccUnitResourceConfig("Gram", 0),
+				ExpectError: regexp.MustCompile(`factor must be greater than 0`),
+			},
+		},
+	})
+}
+
+// testAccUnitResourceConfig generates a base unit and a unit converted into it
+func testAccUnitResourceConfig(name string, factor float64) string {
+	return fmt.Sprintf(`
+resource "emporix_unit" "kg" {
+  code      = "tf-kg"
+  type      = "mass"
+  base_unit = true
+  name = {
+    en = "Kilogram"
+  }
+}
+
+resource "emporix_unit" "g" {
+  code   = "tf-g"
+  type   = emporix_unit.kg.type
+  factor = %[2]g
+  name = {
+    en = %[1]q
+  }
+}
+`, name, factor)
+}
+
+// testAccCheckUnitDestroy verifies that units have been deleted
+func testAccCheckUnitDestroy(s *terraform.State) error {
+	ctx := context.Background()
+
+	client, err := getTestClient()
+	if err != nil {
+		return fmt.Errorf("failed to get test client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "emporix_unit" {
+			continue
+		}
+
+		code := rs.Primary.Attributes["code"]
+
+		_, err := client.GetUnit(ctx, code)
+		if IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("unexpected error checking unit: %w", err)
+		}
+
+		return fmt.Errorf("unit %s still exists after destroy", code)
+	}
+
+	return nil
+}
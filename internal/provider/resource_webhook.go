@@ -6,10 +6,12 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -17,11 +19,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = &WebhookResource{}
 var _ resource.ResourceWithImportState = &WebhookResource{}
+var _ resource.ResourceWithIdentity = &WebhookResource{}
 var _ resource.ResourceWithValidateConfig = &WebhookResource{}
 
 func NewWebhookResource() resource.Resource {
@@ -36,10 +40,19 @@ type EventConfigModel struct {
 	EventType      types.String            `tfsdk:"event_type"`
 	DestinationUrl types.String            `tfsdk:"destination_url"`
 	SecretKey      types.String            `tfsdk:"secret_key"`
+	SecretKeyWO    types.String            `tfsdk:"secret_key_wo"`
 	Headers        map[string]types.String `tfsdk:"headers"`
 	Subscribed     types.Bool              `tfsdk:"subscribed"`
 }
 
+// secretKey returns the secret key to send: secret_key, or secret_key_wo read from the configuration
+func (m EventConfigModel) secretKey() types.String {
+	if m.SecretKey.IsNull() {
+		return m.SecretKeyWO
+	}
+	return m.SecretKey
+}
+
 type WebhookResourceModel struct {
 	Code                types.String            `tfsdk:"code"`
 	Active              types.Bool              `tfsdk:"active"`
@@ -47,11 +60,21 @@ type WebhookResourceModel struct {
 	DestinationUrl      types.String            `tfsdk:"destination_url"`
 	SecretKey           types.Bool              `tfsdk:"secret_key_exists"`
 	SecretKeyString     types.String            `tfsdk:"secret_key"`
+	SecretKeyWO         types.String            `tfsdk:"secret_key_wo"`
+	SecretKeyWOVersion  types.Int64             `tfsdk:"secret_key_wo_version"`
 	Headers             map[string]types.String `tfsdk:"headers"`
 	EventsConfiguration []EventConfigModel      `tfsdk:"events_configuration"`
 	Version             types.Int64             `tfsdk:"version"`
 }
 
+// secretKey returns the secret key to send: secret_key, or secret_key_wo read from the configuration
+func (m WebhookResourceModel) secretKey() types.String {
+	if m.SecretKeyString.IsNull() {
+		return m.SecretKeyWO
+	}
+	return m.SecretKeyString
+}
+
 type eventDestinationUrlDefaultModifier struct{}
 
 func (m eventDestinationUrlDefaultModifier) Description(ctx context.Context) string {
@@ -123,6 +146,23 @@ func (r *WebhookResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"secret_key_wo": schema.StringAttribute{
+				MarkdownDescription: "Write-only alternative to `secret_key`, e.g. for a secret from an ephemeral resource. Never stored in state; requires Terraform 1.11 or later. " +
+					"Terraform cannot detect changes of a write-only value: change `secret_key_wo_version` to send a new secret.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("secret_key")),
+				},
+			},
+			"secret_key_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the write-only secrets (`secret_key_wo` and the `secret_key_wo` of `events_configuration`). Change it, e.g. increment it, to send the secrets again after rotating them.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 			"secret_key_exists": schema.BoolAttribute{
 				MarkdownDescription: "Whether a secret key exists for this webhook (read-only, computed by API). Useful for Svix provider to know if signing is configured.",
 				Computed:            true,
@@ -164,6 +204,16 @@ func (r *WebhookResource) Schema(ctx context.Context, req resource.SchemaRequest
 							Optional:            true,
 							Sensitive:           true,
 						},
+						"secret_key_wo": schema.StringAttribute{
+							MarkdownDescription: "Write-only alternative to `secret_key` for this event type. Never stored in state; requires Terraform 1.11 or later. " +
+								"Change the resource-level `secret_key_wo_version` to send a new secret.",
+							Optional:  true,
+							Sensitive: true,
+							WriteOnly: true,
+							Validators: []validator.String{
+								stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("secret_key")),
+							},
+						},
 						"headers": schema.MapAttribute{
 							MarkdownDescription: "HTTP headers to include for this specific event type.",
 							Optional:            true,
@@ -182,6 +232,17 @@ func (r *WebhookResource) Schema(ctx context.Context, req resource.SchemaRequest
 	}
 }
 
+func (r *WebhookResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"code": identityschema.StringAttribute{
+				Description:       "Webhook code.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *WebhookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -206,6 +267,10 @@ func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	readWriteOnlySecrets(ctx, req.Config, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	userProviderValue := plan.Provider.ValueString()
 	apiProviderValue := normalizeProvider(plan.Provider.ValueString())
@@ -227,7 +292,7 @@ func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest
 
 	webhook, err := r.client.CreateWebhook(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create webhook configuration, got error: %s", err))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to create webhook configuration, got error: %s", err))
 		return
 	}
 
@@ -256,6 +321,7 @@ func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest
 	refreshEventSubscriptions(ctx, r.client, &result, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -295,6 +361,7 @@ func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 		result.Provider = state.Provider
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -308,6 +375,10 @@ func (r *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	readWriteOnlySecrets(ctx, req.Config, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	userProviderValue := plan.Provider.ValueString()
 
@@ -346,12 +417,13 @@ func (r *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest
 
 		result.Provider = types.StringValue(userProviderValue)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+		resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 		return
 	}
 
 	_, err = r.client.UpdateWebhook(ctx, plan.Code.ValueString(), patches)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update webhook configuration, got error: %s", err))
+		addAPIErrorDiagnostics(ctx, &resp.Diagnostics, req.Plan.Schema, nil, err, "Client Error", fmt.Sprintf("Unable to update webhook configuration, got error: %s", err))
 		return
 	}
 
@@ -385,6 +457,7 @@ func (r *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	result.Provider = types.StringValue(userProviderValue)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *WebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -407,7 +480,8 @@ func (r *WebhookResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *WebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("code"), req, resp)
+	// Import by webhook code, or by identity { code }
+	importState(ctx, req, resp, "code")
 }
 
 func (r *WebhookResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -484,24 +558,24 @@ func buildNestedConfigFromModel(model WebhookResourceModel, providerType string)
 
 	switch normalizedProvider {
 	case "SVIX_SHARED":
-		if !model.SecretKeyString.IsNull() {
-			config.ApiKey = model.SecretKeyString.ValueString()
+		if secretKey := model.secretKey(); !secretKey.IsNull() {
+			config.ApiKey = secretKey.ValueString()
 		}
 	case "SVIX":
 		if !model.DestinationUrl.IsNull() {
 			config.DestinationUrl = model.DestinationUrl.ValueString()
 		}
 
-		if !model.SecretKeyString.IsNull() {
-			config.ApiKey = model.SecretKeyString.ValueString()
+		if secretKey := model.secretKey(); !secretKey.IsNull() {
+			config.ApiKey = secretKey.ValueString()
 		}
 	default:
 		if !model.DestinationUrl.IsNull() {
 			config.DestinationUrl = model.DestinationUrl.ValueString()
 		}
 
-		if !model.SecretKeyString.IsNull() {
-			config.SecretKey = model.SecretKeyString.ValueString()
+		if secretKey := model.secretKey(); !secretKey.IsNull() {
+			config.SecretKey = secretKey.ValueString()
 		}
 
 		if len(model.Headers) > 0 {
@@ -543,8 +617,8 @@ func buildEventConfigNestedFromModel(models []EventConfigModel) []EventConfig {
 		if !m.DestinationUrl.IsNull() {
 			event.DestinationUrl = m.DestinationUrl.ValueString()
 		}
-		if !m.SecretKey.IsNull() {
-			event.SecretKey = m.SecretKey.ValueString()
+		if secretKey := m.secretKey(); !secretKey.IsNull() {
+			event.SecretKey = secretKey.ValueString()
 		}
 		if len(m.Headers) > 0 {
 			event.Headers = buildHeaderFieldValueMapFromModel(m.Headers)
@@ -630,7 +704,10 @@ func buildPatchOperations(current *WebhookConfigGet, plan, state WebhookResource
 		})
 	}
 
-	if !plan.SecretKeyString.Equal(state.SecretKeyString) {
+	// Write-only secrets are not in state, so they are only sent again when their version changes
+	secretsRotated := !plan.SecretKeyWOVersion.Equal(state.SecretKeyWOVersion)
+
+	if !plan.SecretKeyString.Equal(state.SecretKeyString) || (secretsRotated && !plan.SecretKeyWO.IsNull()) {
 		secretKeyPath := configPrefix + "/secretKey"
 		if provider == "SVIX" || provider == "SVIX_SHARED" {
 			secretKeyPath = configPrefix + "/apiKey"
@@ -638,7 +715,7 @@ func buildPatchOperations(current *WebhookConfigGet, plan, state WebhookResource
 		patches = append(patches, WebhookConfigPartialUpdates{
 			Op:    "UPSERT",
 			Path:  secretKeyPath,
-			Value: plan.SecretKeyString.ValueString(),
+			Value: plan.secretKey().ValueString(),
 		})
 	}
 
@@ -658,9 +735,12 @@ func buildPatchOperations(current *WebhookConfigGet, plan, state WebhookResource
 		}
 	}
 
+	// The events are compared without their write-only secrets, but always sent with them,
+	// as the UPSERT replaces the secrets of all events
 	planEvents := buildEventConfigNestedFromModel(plan.EventsConfiguration)
+	comparedEvents := buildEventConfigNestedFromModel(withoutWriteOnlySecrets(plan.EventsConfiguration))
 	stateEvents := buildEventConfigNestedFromModel(state.EventsConfiguration)
-	if !reflect.DeepEqual(planEvents, stateEvents) {
+	if !reflect.DeepEqual(comparedEvents, stateEvents) || (secretsRotated && !reflect.DeepEqual(planEvents, comparedEvents)) {
 		eventsPath := configPrefix + "/eventsConfiguration"
 		if len(plan.EventsConfiguration) == 0 {
 			patches = append(patches, WebhookConfigPartialUpdates{
@@ -686,6 +766,7 @@ func preserveTopLevelFields(result, state *WebhookResourceModel) {
 	if result.SecretKeyString.IsNull() && !state.SecretKeyString.IsNull() {
 		result.SecretKeyString = state.SecretKeyString
 	}
+	result.SecretKeyWOVersion = state.SecretKeyWOVersion
 	if len(result.Headers) == 0 && len(state.Headers) > 0 {
 		result.Headers = state.Headers
 	}
@@ -698,11 +779,43 @@ func mergeSensitiveValuesIntoResult(result, plan *WebhookResourceModel) {
 	if result.SecretKeyString.IsNull() && !plan.SecretKeyString.IsNull() {
 		result.SecretKeyString = plan.SecretKeyString
 	}
+	result.SecretKeyWOVersion = plan.SecretKeyWOVersion
 	if len(result.Headers) == 0 && len(plan.Headers) > 0 {
 		result.Headers = plan.Headers
 	}
 }
 
+// readWriteOnlySecrets copies the write-only secrets from the configuration into the plan,
+// as Terraform leaves write-only attributes null in the plan
+func readWriteOnlySecrets(ctx context.Context, config tfsdk.Config, plan *WebhookResourceModel, diags *diag.Diagnostics) {
+	var data WebhookResourceModel
+	diags.Append(config.Get(ctx, &data)...)
+	if diags.HasError() {
+		return
+	}
+
+	plan.SecretKeyWO = data.SecretKeyWO
+	// The events list comes from the configuration, so its order matches the plan
+	for i := range plan.EventsConfiguration {
+		if i < len(data.EventsConfiguration) {
+			plan.EventsConfiguration[i].SecretKeyWO = data.EventsConfiguration[i].SecretKeyWO
+		}
+	}
+}
+
+// withoutWriteOnlySecrets returns a copy of the events without their write-only secrets
+func withoutWriteOnlySecrets(events []EventConfigModel) []EventConfigModel {
+	if events == nil {
+		return nil
+	}
+	result := make([]EventConfigModel, len(events))
+	for i, event := range events {
+		event.SecretKeyWO = types.StringNull()
+		result[i] = event
+	}
+	return result
+}
+
 func mergeEventsFromState(result *WebhookResourceModel, state *WebhookResourceModel) {
 	mergeEventsFromSource(&result.EventsConfiguration, state.EventsConfiguration)
 	reorderEventsToMatch(&result.EventsConfiguration, state.EventsConfiguration)
@@ -6,9 +6,11 @@ import (
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccWebhookResource_basic(t *testing.T) {
@@ -18,6 +20,8 @@ func TestAccWebhookResource_basic(t *testing.T) {
 		os.Unsetenv("EMPORIX_WEBHOOK_FORCE_DELETE")
 	})
 
+	code := fmt.Sprintf("test_webhook_1_%d", testAccTimestamp(t))
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -25,9 +29,9 @@ func TestAccWebhookResource_basic(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccWebhookResourceConfig("test_webhook_1", `"HTTP"`, `"<URL>"`, true, nil, nil),
+				Config: testAccWebhookResourceConfig(code, `"HTTP"`, `"<URL>"`, true, nil, nil),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("emporix_webhook.test", "code", "test_webhook_1"),
+					resource.TestCheckResourceAttr("emporix_webhook.test", "code", code),
 					resource.TestCheckResourceAttr("emporix_webhook.test", "provider_type", "HTTP"),
 					resource.TestCheckResourceAttr("emporix_webhook.test", "destination_url", "<URL>"),
 					resource.TestCheckResourceAttr("emporix_webhook.test", "active", "true"),
@@ -38,15 +42,15 @@ func TestAccWebhookResource_basic(t *testing.T) {
 			{
 				ResourceName:                         "emporix_webhook.test",
 				ImportState:                          true,
-				ImportStateId:                        "test_webhook_1",
+				ImportStateId:                        code,
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "code",
 			},
 			// Update testing - change active to true
 			{
-				Config: testAccWebhookResourceConfig("test_webhook_1", `"HTTP"`, `"<URL>"`, true, nil, nil),
+				Config: testAccWebhookResourceConfig(code, `"HTTP"`, `"<URL>"`, true, nil, nil),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("emporix_webhook.test", "code", "test_webhook_1"),
+					resource.TestCheckResourceAttr("emporix_webhook.test", "code", code),
 					resource.TestCheckResourceAttr("emporix_webhook.test", "active", "true"),
 				),
 			},
@@ -79,6 +83,8 @@ func TestAccWebhookResource_withSecretKey(t *testing.T) {
 		os.Unsetenv("EMPORIX_WEBHOOK_FORCE_DELETE")
 	})
 
+	code := fmt.Sprintf("test_webhook_secret_%d", testAccTimestamp(t))
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -86,9 +92,9 @@ func TestAccWebhookResource_withSecretKey(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create with secret key (HTTP provider)
 			{
-				Config: testAccWebhookResourceConfigWithSecretKey("test_webhook_secret", `"HTTP"`, `"<URL>"`, true, `"my-secret-key"`),
+				Config: testAccWebhookResourceConfigWithSecretKey(code, `"HTTP"`, `"<URL>"`, true, `"my-secret-key"`),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("emporix_webhook.test", "code", "test_webhook_secret"),
+					resource.TestCheckResourceAttr("emporix_webhook.test", "code", code),
 					resource.TestCheckResourceAttr("emporix_webhook.test", "provider_type", "HTTP"),
 					resource.TestCheckResourceAttr("emporix_webhook.test", "secret_key_exists", "true"),
 				),
@@ -97,12 +103,104 @@ func TestAccWebhookResource_withSecretKey(t *testing.T) {
 	})
 }
 
+func TestAccWebhookResource_writeOnlySecretKey(t *testing.T) {
+	os.Setenv("EMPORIX_WEBHOOK_FORCE_DELETE", "true")
+	t.Cleanup(func() {
+		os.Unsetenv("EMPORIX_WEBHOOK_FORCE_DELETE")
+	})
+
+	code := fmt.Sprintf("test_webhook_wo_%d", testAccTimestamp(t))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckWebhookDestroy,
+		Steps: []resource.TestStep{
+			// Create with write-only secrets, which never reach state
+			{
+				Config: testAccWebhookResourceConfigWriteOnly(code, "first-secret", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("emporix_webhook.test", "secret_key_exists", "true"),
+					resource.TestCheckResourceAttr("emporix_webhook.test", "secret_key_wo_version", "1"),
+					resource.TestCheckNoResourceAttr("emporix_webhook.test", "secret_key"),
+					resource.TestCheckNoResourceAttr("emporix_webhook.test", "secret_key_wo"),
+					resource.TestCheckNoResourceAttr("emporix_webhook.test", "events_configuration.0.secret_key_wo"),
+				),
+			},
+			// Rotate the secrets by bumping the version
+			{
+				Config: testAccWebhookResourceConfigWriteOnly(code, "second-secret", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("emporix_webhook.test", "secret_key_exists", "true"),
+					resource.TestCheckResourceAttr("emporix_webhook.test", "secret_key_wo_version", "2"),
+					resource.TestCheckNoResourceAttr("emporix_webhook.test", "secret_key_wo"),
+				),
+			},
+		},
+	})
+}
+
+// TestWebhookPatchOperations_writeOnlySecrets checks that write-only secrets are only sent when
+// their version changes, and that an events update always carries them along.
+func TestWebhookPatchOperations_writeOnlySecrets(t *testing.T) {
+	state := WebhookResourceModel{
+		Provider:           types.StringValue("HTTP"),
+		Active:             types.BoolValue(true),
+		SecretKeyWOVersion: types.Int64Value(1),
+		EventsConfiguration: []EventConfigModel{
+			{EventType: types.StringValue("order.created")},
+		},
+	}
+	plan := state
+	plan.SecretKeyWO = types.StringValue("s3cr3t")
+	plan.EventsConfiguration = []EventConfigModel{
+		{EventType: types.StringValue("order.created"), SecretKeyWO: types.StringValue("event-s3cr3t")},
+	}
+
+	if patches := buildPatchOperations(nil, plan, state); len(patches) != 0 {
+		t.Errorf("expected no patches while the version is unchanged, got %+v", patches)
+	}
+
+	plan.SecretKeyWOVersion = types.Int64Value(2)
+	paths := map[string]interface{}{}
+	for _, patch := range buildPatchOperations(nil, plan, state) {
+		paths[patch.Path] = patch.Value
+	}
+	if paths["/configuration/http/secretKey"] != "s3cr3t" {
+		t.Errorf("expected the rotated secret key to be sent, got %+v", paths)
+	}
+	events, _ := paths["/configuration/http/eventsConfiguration"].([]EventConfig)
+	if len(events) != 1 || events[0].SecretKey != "event-s3cr3t" {
+		t.Errorf("expected the rotated event secret to be sent, got %+v", paths)
+	}
+
+	// Changing an event resends the unchanged write-only secrets, which the UPSERT would clear otherwise
+	plan.SecretKeyWOVersion = state.SecretKeyWOVersion
+	plan.EventsConfiguration[0].DestinationUrl = types.StringValue("https://example.com/orders")
+	paths = map[string]interface{}{}
+	for _, patch := range buildPatchOperations(nil, plan, state) {
+		paths[patch.Path] = patch.Value
+	}
+	if _, ok := paths["/configuration/http/secretKey"]; ok {
+		t.Error("expected the secret key not to be sent without a version change")
+	}
+	events, _ = paths["/configuration/http/eventsConfiguration"].([]EventConfig)
+	if len(events) != 1 || events[0].SecretKey != "event-s3cr3t" {
+		t.Errorf("expected the event update to keep its write-only secret, got %+v", paths)
+	}
+}
+
 func TestAccWebhookResource_withHeaders(t *testing.T) {
 	os.Setenv("EMPORIX_WEBHOOK_FORCE_DELETE", "true")
 	t.Cleanup(func() {
 		os.Unsetenv("EMPORIX_WEBHOOK_FORCE_DELETE")
 	})
 
+	code := fmt.Sprintf("test_webhook_headers_%d", testAccTimestamp(t))
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -110,9 +208,9 @@ func TestAccWebhookResource_withHeaders(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create with headers
 			{
-				Config: testAccWebhookResourceConfigWithHeaders("test_webhook_headers", `"HTTP"`, `"<URL>"`, true, map[string]string{"X-Custom-Header": "custom-value", "X-Api-Key": "api-key-123"}),
+				Config: testAccWebhookResourceConfigWithHeaders(code, `"HTTP"`, `"<URL>"`, true, map[string]string{"X-Custom-Header": "custom-value", "X-Api-Key": "api-key-123"}),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("emporix_webhook.test", "code", "test_webhook_headers"),
+					resource.TestCheckResourceAttr("emporix_webhook.test", "code", code),
 					resource.TestCheckResourceAttr("emporix_webhook.test", "provider_type", "HTTP"),
 					resource.TestCheckResourceAttr("emporix_webhook.test", "headers.%", "2"),
 					resource.TestCheckResourceAttr("emporix_webhook.test", "headers.X-Custom-Header", "custom-value"),
@@ -129,6 +227,8 @@ func TestAccWebhookResource_withEventsConfiguration(t *testing.T) {
 		os.Unsetenv("EMPORIX_WEBHOOK_FORCE_DELETE")
 	})
 
+	code := fmt.Sprintf("test_webhook_events_%d", testAccTimestamp(t))
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -136,13 +236,13 @@ func TestAccWebhookResource_withEventsConfiguration(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create with events configuration
 			{
-				Config: testAccWebhookResourceConfigWithEvents("test_webhook_events", `"HTTP"`, `"<URL>"`, true,
+				Config: testAccWebhookResourceConfigWithEvents(code, `"HTTP"`, `"<URL>"`, true,
 					[]testEventConfig{
 						{EventType: "order.created", DestinationUrl: "<URL>"},
 						{EventType: "customer.created", DestinationUrl: "<URL>"},
 					}),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("emporix_webhook.test", "code", "test_webhook_events"),
+					resource.TestCheckResourceAttr("emporix_webhook.test", "code", code),
 					resource.TestCheckResourceAttr("emporix_webhook.test", "provider_type", "HTTP"),
 					resource.TestCheckResourceAttr("emporix_webhook.test", "events_configuration.0.event_type", "order.created"),
 					resource.TestCheckResourceAttr("emporix_webhook.test", "events_configuration.1.event_type", "customer.created"),
@@ -206,7 +306,7 @@ func TestAccWebhookResource_eventsConfigurationLifecycle(t *testing.T) {
 		os.Unsetenv("EMPORIX_WEBHOOK_FORCE_DELETE")
 	})
 
-	code := "test_webhook_events_lifecycle"
+	code := fmt.Sprintf("test_webhook_events_lifecycle_%d", testAccTimestamp(t))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -279,7 +379,7 @@ func TestAccWebhookResource_eventDestinationUrlFallback(t *testing.T) {
 		os.Unsetenv("EMPORIX_WEBHOOK_FORCE_DELETE")
 	})
 
-	code := "test_webhook_dest_url_fallback"
+	code := fmt.Sprintf("test_webhook_dest_url_fallback_%d", testAccTimestamp(t))
 	parentUrl := "<URL>"
 	overrideUrl := "<URL_2>"
 
@@ -338,7 +438,7 @@ func TestAccWebhookResource_subscribedToggle(t *testing.T) {
 		os.Unsetenv("EMPORIX_WEBHOOK_FORCE_DELETE")
 	})
 
-	code := "test_webhook_subscribed_toggle"
+	code := fmt.Sprintf("test_webhook_subscribed_toggle_%d", testAccTimestamp(t))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -413,6 +513,9 @@ func TestAccWebhookResource_requiresReplace(t *testing.T) {
 		os.Unsetenv("EMPORIX_WEBHOOK_FORCE_DELETE")
 	})
 
+	code1 := fmt.Sprintf("test_webhook_code1_%d", testAccTimestamp(t))
+	code2 := fmt.Sprintf("test_webhook_code2_%d", testAccTimestamp(t))
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -420,16 +523,16 @@ func TestAccWebhookResource_requiresReplace(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create with code1
 			{
-				Config: testAccWebhookResourceConfig("test_webhook_code1", `"HTTP"`, `"<URL>"`, true, nil, nil),
+				Config: testAccWebhookResourceConfig(code1, `"HTTP"`, `"<URL>"`, true, nil, nil),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("emporix_webhook.test", "code", "test_webhook_code1"),
+					resource.TestCheckResourceAttr("emporix_webhook.test", "code", code1),
 				),
 			},
 			// Change code (should require replace)
 			{
-				Config: testAccWebhookResourceConfig("test_webhook_code2", `"HTTP"`, `"<URL>"`, true, nil, nil),
+				Config: testAccWebhookResourceConfig(code2, `"HTTP"`, `"<URL>"`, true, nil, nil),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("emporix_webhook.test", "code", "test_webhook_code2"),
+					resource.TestCheckResourceAttr("emporix_webhook.test", "code", code2),
 				),
 			},
 		},
@@ -480,6 +583,27 @@ func testAccWebhookResourceConfigWithSecretKey(code, provider, destinationUrl st
 	return testAccWebhookResourceConfig(code, provider, destinationUrl, active, &secretKey, nil)
 }
 
+// testAccWebhookResourceConfigWriteOnly generates an HTTP webhook whose secrets are write-only
+func testAccWebhookResourceConfigWriteOnly(code, secretKey string, secretVersion int) string {
+	return fmt.Sprintf(`
+resource "emporix_webhook" "test" {
+  code                  = %[1]q
+  provider_type         = "HTTP"
+  destination_url       = "<URL>"
+  active                = true
+  secret_key_wo         = %[2]q
+  secret_key_wo_version = %[3]d
+
+  events_configuration = [
+    {
+      event_type    = "order.created"
+      secret_key_wo = "%[2]s-orders"
+    },
+  ]
+}
+`, code, secretKey, secretVersion)
+}
+
 // testAccWebhookResourceConfigWithHeaders generates a webhook resource config with headers
 func testAccWebhookResourceConfigWithHeaders(code, provider, destinationUrl string, active bool, headers map[string]string) string {
 	return testAccWebhookResourceConfig(code, provider, destinationUrl, active, nil, headers)
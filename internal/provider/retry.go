@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// defaultMaxRetries is the number of times a request is retried after the first attempt
+	defaultMaxRetries = 3
+	// defaultRetryWaitMin is the base delay of the exponential backoff
+	defaultRetryWaitMin = 1 * time.Second
+	// defaultRetryWaitMax caps both the backoff delay and any Retry-After value sent by the API
+	defaultRetryWaitMax = 30 * time.Second
+)
+
+// isIdempotentMethod reports whether a request can be sent again without risking
+// a duplicate side effect on the API (e.g. a second POST creating a second object).
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// shouldRetryResponse decides whether a response status is worth retrying.
+// 429 means the request was throttled before being processed, so it is safe to
+// retry for every method. Gateway errors may hide a request that was actually
+// applied, so they are only retried for idempotent methods.
+func shouldRetryResponse(method string, statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotentMethod(method)
+	}
+	return false
+}
+
+// shouldRetryError decides whether a transport error is worth retrying.
+// Cancellation and deadline errors come from the caller and are never retried.
+func shouldRetryError(ctx context.Context, method string, err error) bool {
+	if ctx.Err() != nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	return isIdempotentMethod(method)
+}
+
+// parseRetryAfter parses a Retry-After header given either as delay-seconds or as an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := date.Sub(now)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// retryBackoff returns how long to wait before the given retry attempt (0-based).
+// A valid Retry-After header wins over the computed backoff; otherwise the delay
+// grows exponentially from minWait with full jitter. The result never exceeds maxWait.
+func retryBackoff(attempt int, minWait, maxWait time.Duration, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			if wait > maxWait {
+				return maxWait
+			}
+			return wait
+		}
+	}
+
+	backoff := minWait
+	for i := 0; i < attempt && backoff < maxWait; i++ {
+		backoff *= 2
+	}
+	if backoff > maxWait {
+		backoff = maxWait
+	}
+	if backoff <= 0 {
+		return 0
+	}
+
+	// Full jitter keeps parallel resource operations from retrying in lockstep
+	return time.Duration(rand.Int63n(int64(backoff) + 1))
+}
+
+// sleepWithContext waits for the given duration or until the context is done.
+func sleepWithContext(ctx context.Context, wait time.Duration) error {
+	if wait <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
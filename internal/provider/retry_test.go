@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newRetryTestClient returns a client pointed at the given server with retry delays short enough for unit tests
+func newRetryTestClient(serverURL string) *EmporixClient {
+	client := NewEmporixClient("test", "token", serverURL)
+	client.RetryWaitMin = time.Millisecond
+	client.RetryWaitMax = 5 * time.Millisecond
+	return client
+}
+
+func TestDoRequest_RetriesThrottledRequests(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"code":"main"}` {
+			t.Errorf("request body was not replayed on retry, got %q", string(body))
+		}
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(server.URL)
+	resp, err := client.doRequest(context.Background(), "POST", "/sites", map[string]string{"code": "main"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected status 201, got %d", resp.StatusCode)
+	}
+	if got := atomic.LoadInt32(&calls); got != 3 {
+		t.Fatalf("expected 3 calls, got %d", got)
+	}
+}
+
+func TestDoRequest_RetriesGatewayErrorsForIdempotentMethodsOnly(t *testing.T) {
+	testCases := map[string]struct {
+		method        string
+		expectedCalls int32
+	}{
+		"GET is retried":       {method: "GET", expectedCalls: 4},
+		"PUT is retried":       {method: "PUT", expectedCalls: 4},
+		"DELETE is retried":    {method: "DELETE", expectedCalls: 4},
+		"POST is not retried":  {method: "POST", expectedCalls: 1},
+		"PATCH is not retried": {method: "PATCH", expectedCalls: 1},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var calls int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&calls, 1)
+				w.WriteHeader(http.StatusBadGateway)
+			}))
+			defer server.Close()
+
+			client := newRetryTestClient(server.URL)
+			resp, err := client.doRequest(context.Background(), tc.method, "/resource", nil, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if resp.StatusCode != http.StatusBadGateway {
+				t.Fatalf("expected the last 502 response to be returned, got %d", resp.StatusCode)
+			}
+			if got := atomic.LoadInt32(&calls); got != tc.expectedCalls {
+				t.Fatalf("expected %d calls, got %d", tc.expectedCalls, got)
+			}
+		})
+	}
+}
+
+func TestDoRequest_DoesNotRetryClientErrors(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(server.URL)
+	if _, err := client.doRequest(context.Background(), "GET", "/resource", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Fatalf("expected 1 call, got %d", got)
+	}
+}
+
+func TestDoRequest_RetriesDisabled(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(server.URL)
+	client.MaxRetries = 0
+	if _, err := client.doRequest(context.Background(), "GET", "/resource", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Fatalf("expected 1 call, got %d", got)
+	}
+}
+
+func TestRetryBackoff_HonoursRetryAfterUpToMaxWait(t *testing.T) {
+	resp := &http.Response{Header: http.Header{}}
+
+	resp.Header.Set("Retry-After", "2")
+	if got := retryBackoff(0, time.Second, 10*time.Second, resp); got != 2*time.Second {
+		t.Fatalf("expected Retry-After of 2s to be used, got %s", got)
+	}
+
+	resp.Header.Set("Retry-After", "120")
+	if got := retryBackoff(0, time.Second, 10*time.Second, resp); got != 10*time.Second {
+		t.Fatalf("expected Retry-After to be capped at 10s, got %s", got)
+	}
+
+	resp.Header.Set("Retry-After", time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	if got := retryBackoff(0, time.Second, 10*time.Second, resp); got != 0 {
+		t.Fatalf("expected a Retry-After date in the past to mean no wait, got %s", got)
+	}
+}
+
+func TestRetryBackoff_ExponentialWithJitter(t *testing.T) {
+	for attempt, ceiling := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 8 * time.Second} {
+		for i := 0; i < 20; i++ {
+			got := retryBackoff(attempt, time.Second, 8*time.Second, nil)
+			if got < 0 || got > ceiling {
+				t.Fatalf("attempt %d: expected backoff within [0, %s], got %s", attempt, ceiling, got)
+			}
+		}
+	}
+}
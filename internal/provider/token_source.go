@@ -0,0 +1,295 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// tokenRefreshMargin is how long before expiry a token is proactively refreshed,
+// so requests started just before the deadline don't reach the API with a dead token.
+const tokenRefreshMargin = 60 * time.Second
+
+// tokenSource supplies the bearer token EmporixClient sends with every request.
+type tokenSource interface {
+	// Token returns a token that is valid for at least tokenRefreshMargin, refreshing it if needed
+	Token(ctx context.Context) (string, error)
+	// Invalidate marks the given token as rejected by the API and reports whether a
+	// fresh token can be obtained, i.e. whether replaying the request makes sense
+	Invalidate(token string) bool
+}
+
+// staticTokenSource serves a token supplied by the user (access_token / EMPORIX_ACCESS_TOKEN).
+// It can't be refreshed, so a 401 is returned to the caller unchanged.
+type staticTokenSource struct {
+	token string
+}
+
+func (s *staticTokenSource) Token(ctx context.Context) (string, error) {
+	return s.token, nil
+}
+
+func (s *staticTokenSource) Invalidate(token string) bool {
+	return false
+}
+
+// clientCredentialsTokenSource obtains tokens via the OAuth client_credentials grant and
+// refreshes them shortly before they expire. It is safe for concurrent use by all
+// resources sharing the provider's client: only one refresh runs at a time.
+type clientCredentialsTokenSource struct {
+	apiUrl       string
+	clientId     string
+	clientSecret string
+	scope        string
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+	now       func() time.Time
+}
+
+func newClientCredentialsTokenSource(apiUrl, clientId, clientSecret, scope string) *clientCredentialsTokenSource {
+	return &clientCredentialsTokenSource{
+		apiUrl:       apiUrl,
+		clientId:     clientId,
+		clientSecret: clientSecret,
+		scope:        scope,
+		now:          time.Now,
+	}
+}
+
+func (s *clientCredentialsTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && (s.expiresAt.IsZero() || s.now().Add(tokenRefreshMargin).Before(s.expiresAt)) {
+		return s.token, nil
+	}
+
+	if s.token != "" {
+		tflog.Debug(ctx, "OAuth access token is about to expire, refreshing", map[string]interface{}{
+			"subsystem":  "oauth",
+			"expires_at": s.expiresAt.Format(time.RFC3339),
+		})
+	}
+
+	tokenResponse, err := requestAccessToken(ctx, s.apiUrl, s.clientId, s.clientSecret, s.scope)
+	if err != nil {
+		return "", err
+	}
+
+	s.token = tokenResponse.AccessToken
+	// A zero expiry means the token endpoint didn't say; keep the token until the API rejects it
+	s.expiresAt = time.Time{}
+	if tokenResponse.ExpiresIn > 0 {
+		s.expiresAt = s.now().Add(time.Duration(tokenResponse.ExpiresIn) * time.Second)
+	}
+
+	return s.token, nil
+}
+
+func (s *clientCredentialsTokenSource) Invalidate(token string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Only drop the cached token if it is the one that was rejected; another
+	// goroutine may already have refreshed it after a concurrent 401.
+	if s.token == token {
+		s.token = ""
+		s.expiresAt = time.Time{}
+	}
+	return true
+}
+
+// credentialProcessTimeout bounds a single run of the credential process.
+const credentialProcessTimeout = 60 * time.Second
+
+// credentialProcessMinInterval is how long a token that is about to expire is still reused,
+// so a helper handing out tokens close to expiry isn't run for every request.
+const credentialProcessMinInterval = 15 * time.Second
+
+// credentialProcessOutput is the JSON document a credential process writes to stdout.
+type credentialProcessOutput struct {
+	AccessToken string `json:"access_token"`
+	// ExpiresAt is an RFC 3339 timestamp; without it the token is used until the API rejects it
+	ExpiresAt string `json:"expires_at,omitempty"`
+}
+
+// processTokenSource obtains tokens by running an external command (credential_process),
+// e.g. a helper that fetches them from Vault, and runs it again shortly before they expire.
+// Like clientCredentialsTokenSource it is safe for concurrent use.
+type processTokenSource struct {
+	command []string
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+	lastRun   time.Time
+	now       func() time.Time
+}
+
+func newProcessTokenSource(command []string) *processTokenSource {
+	return &processTokenSource{
+		command: command,
+		now:     time.Now,
+	}
+}
+
+func (s *processTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" {
+		now := s.now()
+		if s.expiresAt.IsZero() || now.Add(tokenRefreshMargin).Before(s.expiresAt) {
+			return s.token, nil
+		}
+		// Within the refresh margin, but not expired yet and the helper only just ran
+		if now.Before(s.expiresAt) && now.Sub(s.lastRun) < credentialProcessMinInterval {
+			return s.token, nil
+		}
+	}
+
+	tflog.Debug(ctx, "Running credential process", map[string]interface{}{
+		"subsystem": "oauth",
+		"command":   s.command[0],
+	})
+
+	output, err := runCredentialProcess(ctx, s.command)
+	if err != nil {
+		return "", err
+	}
+	s.lastRun = s.now()
+
+	s.token = output.AccessToken
+	s.expiresAt = time.Time{}
+	if output.ExpiresAt != "" {
+		// Already validated by runCredentialProcess
+		s.expiresAt, _ = time.Parse(time.RFC3339, output.ExpiresAt)
+	}
+
+	return s.token, nil
+}
+
+func (s *processTokenSource) Invalidate(token string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token == token {
+		s.token = ""
+		s.expiresAt = time.Time{}
+	}
+	return true
+}
+
+// runCredentialProcess runs the command and parses the token document it writes to stdout.
+// Stderr is passed through in errors so helper failures are visible in diagnostics.
+func runCredentialProcess(ctx context.Context, command []string) (*credentialProcessOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, credentialProcessTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, fmt.Errorf("credential process %s did not finish within %s", command[0], credentialProcessTimeout)
+		}
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return nil, fmt.Errorf("credential process %s failed: %w: %s", command[0], err, message)
+		}
+		return nil, fmt.Errorf("credential process %s failed: %w", command[0], err)
+	}
+
+	var output credentialProcessOutput
+	if err := json.Unmarshal(stdout.Bytes(), &output); err != nil {
+		return nil, fmt.Errorf("credential process %s returned invalid JSON: %w", command[0], err)
+	}
+	if output.AccessToken == "" {
+		return nil, fmt.Errorf("credential process %s returned no access_token", command[0])
+	}
+	if output.ExpiresAt != "" {
+		if _, err := time.Parse(time.RFC3339, output.ExpiresAt); err != nil {
+			return nil, fmt.Errorf("credential process %s returned an invalid expires_at, expected RFC 3339: %w", command[0], err)
+		}
+	}
+
+	return &output, nil
+}
+
+// splitCommandLine splits a credential process command line into the command and its arguments.
+// Arguments are separated by whitespace; single quotes keep everything up to the closing quote,
+// double quotes and backslashes work as in a POSIX shell, e.g.
+//
+//	"/opt/vault helper/emporix-token" --role 'ci runner'
+//
+// Variables, globs and other shell features are not supported.
+func splitCommandLine(commandLine string) ([]string, error) {
+	var args []string
+	var current strings.Builder
+	inArg := false
+	var quote rune
+
+	runes := []rune(commandLine)
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		switch {
+		case quote == '\'':
+			if c == '\'' {
+				quote = 0
+			} else {
+				current.WriteRune(c)
+			}
+		case quote == '"':
+			switch {
+			case c == '"':
+				quote = 0
+			case c == '\\' && i+1 < len(runes) && strings.ContainsRune(`"\\$`+"`", runes[i+1]):
+				i++
+				current.WriteRune(runes[i])
+			default:
+				current.WriteRune(c)
+			}
+		case c == '\'' || c == '"':
+			quote = c
+			inArg = true
+		case c == '\\':
+			if i+1 == len(runes) {
+				return nil, fmt.Errorf("trailing backslash")
+			}
+			i++
+			current.WriteRune(runes[i])
+			inArg = true
+		case c == ' ' || c == '\t' || c == '\n':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(c)
+			inArg = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote", quote)
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	if len(args) == 0 {
+		return nil, fmt.Errorf("no command given")
+	}
+	return args, nil
+}
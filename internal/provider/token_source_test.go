@@ -0,0 +1,343 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"terraform-provider-emporix/internal/emporixfake"
+)
+
+// newTokenTestServer serves /oauth/token with numbered tokens ("token-1", "token-2", ...)
+// and accepts API calls only when they carry the most recently issued token.
+func newTokenTestServer(t *testing.T, expiresIn int) (*httptest.Server, *int32) {
+	t.Helper()
+
+	var issued int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			n := atomic.AddInt32(&issued, 1)
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(OAuthTokenResponse{
+				AccessToken: fmt.Sprintf("token-%d", n),
+				TokenType:   "Bearer",
+				ExpiresIn:   expiresIn,
+			})
+			return
+		}
+
+		current := fmt.Sprintf("Bearer token-%d", atomic.LoadInt32(&issued))
+		if r.Header.Get("Authorization") != current {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &issued
+}
+
+func TestClientCredentialsTokenSource_RefreshesBeforeExpiry(t *testing.T) {
+	server, issued := newTokenTestServer(t, 3600)
+
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	source := newClientCredentialsTokenSource(server.URL, "id", "secret", "")
+	source.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	first, err := source.Token(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	now = now.Add(30 * time.Minute)
+	if second, _ := source.Token(ctx); second != first {
+		t.Fatalf("expected cached token %q while still valid, got %q", first, second)
+	}
+
+	// Inside the refresh margin the token must be replaced
+	now = now.Add(30*time.Minute - tokenRefreshMargin/2)
+	third, err := source.Token(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if third == first {
+		t.Fatalf("expected token to be refreshed shortly before expiry")
+	}
+	if got := atomic.LoadInt32(issued); got != 2 {
+		t.Fatalf("expected 2 token requests, got %d", got)
+	}
+}
+
+func TestClientCredentialsTokenSource_ConcurrentCallersShareOneRefresh(t *testing.T) {
+	server, issued := newTokenTestServer(t, 3600)
+	source := newClientCredentialsTokenSource(server.URL, "id", "secret", "")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := source.Token(context.Background()); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(issued); got != 1 {
+		t.Fatalf("expected a single token request, got %d", got)
+	}
+}
+
+func TestDoRequest_RefreshesTokenOnUnauthorized(t *testing.T) {
+	server, issued := newTokenTestServer(t, 3600)
+	source := newClientCredentialsTokenSource(server.URL, "id", "secret", "")
+	client := newEmporixClientWithTokenSource("test", source, server.URL)
+
+	ctx := context.Background()
+	if _, err := source.Token(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Simulate the API revoking the token early: the server now only accepts token-2
+	atomic.AddInt32(issued, 1)
+	source.mu.Lock()
+	source.token = "token-1"
+	source.mu.Unlock()
+
+	resp, err := client.doRequest(ctx, "POST", "/resource", map[string]string{"a": "b"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected request to succeed after token refresh, got %d", resp.StatusCode)
+	}
+}
+
+func TestDoRequest_StaticTokenUnauthorizedIsNotReplayed(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if strings.HasPrefix(r.URL.Path, "/oauth") {
+			t.Errorf("static token client must not request new tokens")
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client := NewEmporixClient("test", "static-token", server.URL)
+	resp, err := client.doRequest(context.Background(), "GET", "/resource", nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected 401 to be returned to the caller, got %d", resp.StatusCode)
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Fatalf("expected 1 call, got %d", got)
+	}
+}
+
+// TestCredentialProcessHelper is not a real test: the credential process tests run the
+// test binary as the helper command, and it prints EMPORIX_TEST_PROCESS_OUTPUT.
+func TestCredentialProcessHelper(t *testing.T) {
+	output, ok := os.LookupEnv("EMPORIX_TEST_PROCESS_OUTPUT")
+	if !ok {
+		t.Skip("only runs as a credential process")
+	}
+	if strings.HasPrefix(output, "fail:") {
+		fmt.Fprint(os.Stderr, strings.TrimPrefix(output, "fail:"))
+		os.Exit(1)
+	}
+	fmt.Print(output)
+	os.Exit(0)
+}
+
+// credentialProcessCommand returns a command running TestCredentialProcessHelper, printing output
+func credentialProcessCommand(t *testing.T, output string) []string {
+	t.Helper()
+	t.Setenv("EMPORIX_TEST_PROCESS_OUTPUT", output)
+	return []string{os.Args[0], "-test.run=^TestCredentialProcessHelper$"}
+}
+
+func TestProcessTokenSource_RerunsBeforeExpiry(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	command := credentialProcessCommand(t, `{"access_token":"token-1","expires_at":"2026-01-01T11:00:00Z"}`)
+	source := newProcessTokenSource(command)
+	source.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	if token, err := source.Token(ctx); err != nil || token != "token-1" {
+		t.Fatalf("expected token-1, got %q (%v)", token, err)
+	}
+
+	// Cached while the token is valid, even if the helper would print something else
+	t.Setenv("EMPORIX_TEST_PROCESS_OUTPUT", `{"access_token":"token-2","expires_at":"2026-01-01T12:00:00Z"}`)
+	now = now.Add(30 * time.Minute)
+	if token, _ := source.Token(ctx); token != "token-1" {
+		t.Errorf("expected the cached token-1, got %q", token)
+	}
+
+	now = now.Add(29*time.Minute + 30*time.Second)
+	if token, _ := source.Token(ctx); token != "token-2" {
+		t.Errorf("expected token-2 shortly before expiry, got %q", token)
+	}
+}
+
+func TestProcessTokenSource_Errors(t *testing.T) {
+	for name, tc := range map[string]struct {
+		output   string
+		expected string
+	}{
+		"exit code":      {`fail:vault sealed`, "vault sealed"},
+		"invalid JSON":   {`token-1`, "invalid JSON"},
+		"no token":       {`{"expires_at":"2026-01-01T11:00:00Z"}`, "no access_token"},
+		"invalid expiry": {`{"access_token":"token-1","expires_at":"in an hour"}`, "invalid expires_at"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			source := newProcessTokenSource(credentialProcessCommand(t, tc.output))
+			_, err := source.Token(context.Background())
+			if err == nil || !strings.Contains(err.Error(), tc.expected) {
+				t.Errorf("expected an error containing %q, got %v", tc.expected, err)
+			}
+		})
+	}
+}
+
+func TestProviderConfigure_CredentialProcess(t *testing.T) {
+	clearProviderEnv(t)
+	command := credentialProcessCommand(t, `{"access_token":"process-token"}`)
+
+	resp := configureProvider(t, map[string]tftypes.Value{
+		"tenant": tftypes.NewValue(tftypes.String, "mytenant"),
+		"credential_process": tftypes.NewValue(
+			tftypes.Object{AttributeTypes: map[string]tftypes.Type{"command": tftypes.String, "args": tftypes.List{ElementType: tftypes.String}}},
+			map[string]tftypes.Value{
+				"command": tftypes.NewValue(tftypes.String, command[0]),
+				"args":    tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, command[1])}),
+			},
+		),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("configure returned errors: %v", resp.Diagnostics)
+	}
+	if token, _ := resp.ResourceData.(*EmporixClient).tokens.Token(context.Background()); token != "process-token" {
+		t.Errorf("expected the token of the credential process, got %q", token)
+	}
+}
+
+func TestProcessTokenSource_ReusesTokenCloseToExpiry(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	// The helper hands out tokens that are already inside the refresh margin
+	command := credentialProcessCommand(t, `{"access_token":"token-1","expires_at":"2026-01-01T10:00:45Z"}`)
+	source := newProcessTokenSource(command)
+	source.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	if token, err := source.Token(ctx); err != nil || token != "token-1" {
+		t.Fatalf("expected token-1, got %q (%v)", token, err)
+	}
+
+	t.Setenv("EMPORIX_TEST_PROCESS_OUTPUT", `{"access_token":"token-2","expires_at":"2026-01-01T10:01:15Z"}`)
+	now = now.Add(10 * time.Second)
+	if token, _ := source.Token(ctx); token != "token-1" {
+		t.Errorf("expected token-1 to be reused right after the helper ran, got %q", token)
+	}
+
+	now = now.Add(10 * time.Second)
+	if token, _ := source.Token(ctx); token != "token-2" {
+		t.Errorf("expected the helper to run again after the minimum interval, got %q", token)
+	}
+}
+
+func TestSplitCommandLine(t *testing.T) {
+	tests := []struct {
+		commandLine string
+		want        []string
+	}{
+		{`vault-emporix-token --role ci`, []string{"vault-emporix-token", "--role", "ci"}},
+		{`  "/opt/vault helper/token"   --role 'ci runner' `, []string{"/opt/vault helper/token", "--role", "ci runner"}},
+		{`helper --label "say \"hi\"" --path C:\\tools`, []string{"helper", "--label", `say "hi"`, "--path", `C:\tools`}},
+		{`helper 'it'\''s' ""`, []string{"helper", "it's", ""}},
+		{`helper my\ arg`, []string{"helper", "my arg"}},
+	}
+	for _, tt := range tests {
+		got, err := splitCommandLine(tt.commandLine)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tt.commandLine, err)
+			continue
+		}
+		if strings.Join(got, "|") != strings.Join(tt.want, "|") || len(got) != len(tt.want) {
+			t.Errorf("%s: expected %q, got %q", tt.commandLine, tt.want, got)
+		}
+	}
+
+	for _, invalid := range []string{``, `   `, `helper "unterminated`, `helper 'unterminated`, `helper \`} {
+		if _, err := splitCommandLine(invalid); err == nil {
+			t.Errorf("%q: expected an error", invalid)
+		}
+	}
+}
+
+func TestProviderConfigure_ConfigClientCredentialsOverrideEnvCredentialProcess(t *testing.T) {
+	clearProviderEnv(t)
+	server := emporixfake.New()
+	t.Cleanup(server.Close)
+
+	// Running this would fail the configuration
+	t.Setenv("EMPORIX_CREDENTIAL_PROCESS", "/nonexistent/emporix-token-helper")
+
+	resp := configureProvider(t, map[string]tftypes.Value{
+		"tenant":        tftypes.NewValue(tftypes.String, server.Tenant),
+		"api_url":       tftypes.NewValue(tftypes.String, server.URL),
+		"client_id":     tftypes.NewValue(tftypes.String, server.ClientID),
+		"client_secret": tftypes.NewValue(tftypes.String, server.ClientSecret),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("configure returned errors: %v", resp.Diagnostics)
+	}
+	if _, ok := resp.ResourceData.(*EmporixClient).tokens.(*clientCredentialsTokenSource); !ok {
+		t.Errorf("expected the configured client credentials to be used, got %T", resp.ResourceData.(*EmporixClient).tokens)
+	}
+}
+
+func TestProviderConfigure_EnvCredentialProcessOverridesProfileClientCredentials(t *testing.T) {
+	clearProviderEnv(t)
+	writeCredentialsFile(t, "[dev]\ntenant = mytenant\nclient_id = profile-id\nclient_secret = profile-secret\n")
+	command := credentialProcessCommand(t, `{"access_token":"process-token"}`)
+	t.Setenv("EMPORIX_CREDENTIAL_PROCESS", `"`+command[0]+`" `+command[1])
+	t.Setenv("EMPORIX_PROFILE", "dev")
+
+	resp := configureProvider(t, nil)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("configure returned errors: %v", resp.Diagnostics)
+	}
+	if token, _ := resp.ResourceData.(*EmporixClient).tokens.Token(context.Background()); token != "process-token" {
+		t.Errorf("expected the token of the credential process, got %q", token)
+	}
+}
+
+func TestProviderConfigure_CredentialProcessConflict(t *testing.T) {
+	clearProviderEnv(t)
+	t.Setenv("EMPORIX_TENANT", "mytenant")
+	t.Setenv("EMPORIX_CLIENT_ID", "env-id")
+	t.Setenv("EMPORIX_CLIENT_SECRET", "env-secret")
+	t.Setenv("EMPORIX_CREDENTIAL_PROCESS", "/nonexistent/emporix-token-helper")
+
+	resp := configureProvider(t, nil)
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Conflicting Authentication Configuration" {
+		t.Errorf("expected a conflicting configuration error, got %v", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Validity periods are shared by price lists, customer segments and coupons.
+
+// ValidityModel describes a validity period, see validityAttribute
+type ValidityModel struct {
+	From types.String `tfsdk:"from"`
+	To   types.String `tfsdk:"to"`
+}
+
+func (m ValidityModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"from": types.StringType,
+		"to":   types.StringType,
+	}
+}
+
+// validityAttribute is the schema of an optional validity period with ISO 8601 bounds, either of which may be open
+func validityAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"from": schema.StringAttribute{
+				MarkdownDescription: "Start of the validity period in ISO 8601 format. Example: '2024-01-01T00:00:00.000Z'.",
+				Optional:            true,
+				Validators: []validator.String{
+					iso8601DateTimeValidator(),
+				},
+			},
+			"to": schema.StringAttribute{
+				MarkdownDescription: "End of the validity period in ISO 8601 format. Example: '2024-12-31T23:59:59.000Z'.",
+				Optional:            true,
+				Validators: []validator.String{
+					iso8601DateTimeValidator(),
+				},
+			},
+		},
+		Validators: []validator.Object{
+			objectvalidator.AtLeastOneOf(
+				path.MatchRelative().AtName("from"),
+				path.MatchRelative().AtName("to"),
+			),
+		},
+	}
+}
+
+// validityToObject converts an API validity period to a validity object, or null when it has no bounds
+func validityToObject(ctx context.Context, validity *Validity, diags *diag.Diagnostics) types.Object {
+	if validity == nil || (validity.From == "" && validity.To == "") {
+		return types.ObjectNull(ValidityModel{}.AttributeTypes())
+	}
+	model := ValidityModel{
+		From: stringOrNull(validity.From),
+		To:   stringOrNull(validity.To),
+	}
+	obj, d := types.ObjectValueFrom(ctx, model.AttributeTypes(), model)
+	diags.Append(d...)
+	return obj
+}
+
+// validityFromObject converts a validity object to an API validity period, or nil when not set
+func validityFromObject(ctx context.Context, obj types.Object, diags *diag.Diagnostics) *Validity {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+	var model ValidityModel
+	diags.Append(obj.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	return &Validity{
+		From: model.From.ValueString(),
+		To:   model.To.ValueString(),
+	}
+}
+
+// validateValidity reports an error on the validity attribute at attrPath when its period ends before it starts
+func validateValidity(ctx context.Context, obj types.Object, attrPath path.Path, diags *diag.Diagnostics) {
+	if obj.IsNull() || obj.IsUnknown() {
+		return
+	}
+
+	var validity ValidityModel
+	diags.Append(obj.As(ctx, &validity, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return
+	}
+
+	if validity.From.IsNull() || validity.From.IsUnknown() || validity.To.IsNull() || validity.To.IsUnknown() {
+		return
+	}
+
+	// Timestamps of the same format compare chronologically as strings
+	if validity.From.ValueString() > validity.To.ValueString() {
+		diags.AddAttributeError(
+			attrPath.AtName("to"),
+			"Invalid Validity Period",
+			fmt.Sprintf("validity.from (%s) must be before or equal to validity.to (%s)", validity.From.ValueString(), validity.To.ValueString()),
+		)
+	}
+}
@@ -0,0 +1,556 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Acceptance tests can record their API interactions once and replay them afterwards:
+//
+//	EMPORIX_VCR_MODE=record  runs against the configured tenant and writes every request and
+//	                         response to testdata/cassettes/<TestName>.json
+//	EMPORIX_VCR_MODE=replay  answers every request from the cassette, without credentials or network
+//
+// Cassettes never contain the bearer token, the OAuth client credentials, webhook secrets,
+// API client secrets or the tenant name; requests are matched after applying the same scrubbing.
+const (
+	vcrModeRecord = "record"
+	vcrModeReplay = "replay"
+
+	vcrCassetteDir = "testdata/cassettes"
+	// vcrTenant replaces the tenant name in cassettes and is the tenant used during replay
+	vcrTenant = "vcrtenant"
+	// vcrRedacted replaces secrets in cassettes and is the client ID and secret used during replay
+	vcrRedacted = "REDACTED"
+)
+
+// vcrSecretFields are JSON and form fields whose values are redacted in cassettes
+var vcrSecretFields = map[string]bool{
+	"access_token":  true,
+	"refresh_token": true,
+	"client_id":     true,
+	"client_secret": true,
+	// clientSecret is returned when an API client is created (POST /iam/{tenant}/api-keys)
+	"clientSecret": true,
+	"secretKey":    true,
+	"apiKey":       true,
+}
+
+// vcrResponseHeaders are the response headers kept in cassettes; the client ignores the rest
+var vcrResponseHeaders = []string{"Content-Type", "Retry-After", "X-Total-Count"}
+
+type vcrCassette struct {
+	// Timestamp is the time the cassette was recorded, used by tests to build unique codes
+	Timestamp int64 `json:"timestamp"`
+	// Fixtures are the environment variables a test read while recording, see testAccFixture
+	Fixtures     map[string]string `json:"fixtures,omitempty"`
+	Interactions []*vcrInteraction `json:"interactions"`
+}
+
+type vcrInteraction struct {
+	Request  vcrRequest  `json:"request"`
+	Response vcrResponse `json:"response"`
+
+	replayed bool
+}
+
+type vcrRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+type vcrResponse struct {
+	Status  int               `json:"status"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    string            `json:"body,omitempty"`
+}
+
+// vcrRecorder is an http.RoundTripper that records interactions to, or replays them from, a cassette
+type vcrRecorder struct {
+	mode string
+	path string
+	next http.RoundTripper
+
+	mu       sync.Mutex
+	cassette vcrCassette
+}
+
+func newVCRRecorder(mode, path string) (*vcrRecorder, error) {
+	r := &vcrRecorder{mode: mode, path: path, next: http.DefaultTransport}
+
+	switch mode {
+	case vcrModeRecord:
+		r.cassette.Timestamp = time.Now().Unix()
+	case vcrModeReplay:
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &r.cassette); err != nil {
+			return nil, fmt.Errorf("error decoding cassette %s: %w", path, err)
+		}
+	default:
+		return nil, fmt.Errorf("EMPORIX_VCR_MODE must be %q or %q, got %q", vcrModeRecord, vcrModeReplay, mode)
+	}
+
+	return r, nil
+}
+
+func (r *vcrRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		reqBody, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	request := vcrRequest{
+		Method: req.Method,
+		URL:    scrubTenant(req.URL.RequestURI()),
+		Body:   scrubBody(reqBody),
+	}
+
+	if r.mode == vcrModeReplay {
+		return r.replay(req, request)
+	}
+	return r.record(req, request)
+}
+
+func (r *vcrRecorder) record(req *http.Request, request vcrRequest) (*http.Response, error) {
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	response := vcrResponse{Status: resp.StatusCode, Body: scrubBody(respBody)}
+	for _, header := range vcrResponseHeaders {
+		if value := resp.Header.Get(header); value != "" {
+			if response.Headers == nil {
+				response.Headers = map[string]string{}
+			}
+			response.Headers[header] = value
+		}
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, &vcrInteraction{Request: request, Response: response})
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+// replay answers with the first interaction not replayed yet that matches method, URL and body.
+// Resources are applied concurrently, so interactions are not required to replay in recorded order.
+func (r *vcrRecorder) replay(req *http.Request, request vcrRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, interaction := range r.cassette.Interactions {
+		if interaction.replayed || interaction.Request != request {
+			continue
+		}
+		interaction.replayed = true
+
+		header := http.Header{}
+		for k, v := range interaction.Response.Headers {
+			header.Set(k, v)
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.Status, http.StatusText(interaction.Response.Status)),
+			StatusCode:    interaction.Response.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no interaction recorded in %s for %s %s with body %s", r.path, request.Method, request.URL, request.Body)
+}
+
+func (r *vcrRecorder) save() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, append(data, '\n'), 0o644)
+}
+
+// vcrRecorders holds the recorder of each running test; acceptance tests don't run in parallel
+var vcrRecorders = map[*testing.T]*vcrRecorder{}
+
+// testAccVCR installs the recorder for the test when EMPORIX_VCR_MODE is set and returns it,
+// or returns nil. Tests without a cassette are skipped in replay mode.
+func testAccVCR(t *testing.T) *vcrRecorder {
+	t.Helper()
+
+	mode := os.Getenv("EMPORIX_VCR_MODE")
+	if mode == "" {
+		return nil
+	}
+	if r, ok := vcrRecorders[t]; ok {
+		return r
+	}
+
+	path := filepath.Join(vcrCassetteDir, strings.ReplaceAll(t.Name(), "/", "_")+".json")
+	r, err := newVCRRecorder(mode, path)
+	if os.IsNotExist(err) {
+		t.Skipf("no cassette recorded at %s", path)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if mode == vcrModeReplay {
+		t.Setenv("EMPORIX_TENANT", vcrTenant)
+		t.Setenv("EMPORIX_CLIENT_ID", vcrRedacted)
+		t.Setenv("EMPORIX_CLIENT_SECRET", vcrRedacted)
+	}
+
+	vcrRecorders[t] = r
+	httpTransport = r
+	t.Cleanup(func() {
+		httpTransport = nil
+		delete(vcrRecorders, t)
+
+		// A failed run may stop halfway; keep the previous cassette instead
+		if mode == vcrModeRecord && !t.Failed() {
+			if err := r.save(); err != nil {
+				t.Errorf("error saving cassette %s: %s", path, err)
+			}
+		}
+	})
+
+	return r
+}
+
+// testAccTimestamp returns a timestamp for building unique object codes. When replaying
+// it is the time the cassette was recorded, so the codes match the recorded requests.
+func testAccTimestamp(t *testing.T) int64 {
+	t.Helper()
+
+	if r := testAccVCR(t); r != nil {
+		return r.cassette.Timestamp
+	}
+	return time.Now().Unix()
+}
+
+// testAccFixture returns the ID of an object the provider doesn't manage, e.g. a product, from the
+// environment variable name. The fake API accepts any ID, so fakeValue is used there. While recording
+// the value is stored in the cassette and replayed from it. Without a value the test is skipped.
+func testAccFixture(t *testing.T, name, fakeValue string) string {
+	t.Helper()
+
+	r := testAccVCR(t)
+	if r != nil && r.mode == vcrModeReplay {
+		value, ok := r.cassette.Fixtures[name]
+		if !ok {
+			t.Fatalf("%s was not recorded in %s", name, r.path)
+		}
+		return value
+	}
+
+	value := os.Getenv(name)
+	if value == "" {
+		if os.Getenv("EMPORIX_FAKE_API") != "true" {
+			t.Skipf("%s must be set to run this test against a tenant", name)
+		}
+		value = fakeValue
+	}
+
+	if r != nil {
+		r.mu.Lock()
+		if r.cassette.Fixtures == nil {
+			r.cassette.Fixtures = map[string]string{}
+		}
+		r.cassette.Fixtures[name] = value
+		r.mu.Unlock()
+	}
+	return value
+}
+
+// scrubBody redacts secrets and the tenant name from a JSON or form encoded body
+func scrubBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err == nil {
+		if scrubbed, err := json.Marshal(redactJSON(value)); err == nil {
+			return scrubTenant(string(scrubbed))
+		}
+	}
+
+	// OAuth token requests are form encoded
+	if form, err := url.ParseQuery(string(body)); err == nil && form.Get("grant_type") != "" {
+		for field := range form {
+			if vcrSecretFields[field] {
+				form.Set(field, vcrRedacted)
+			}
+		}
+		return scrubTenant(form.Encode())
+	}
+
+	return scrubTenant(string(body))
+}
+
+func redactJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if _, isString := field.(string); isString && vcrSecretFields[key] {
+				v[key] = vcrRedacted
+				continue
+			}
+			v[key] = redactJSON(field)
+		}
+	case []interface{}:
+		for i, element := range v {
+			v[i] = redactJSON(element)
+		}
+	}
+	return value
+}
+
+// scrubTenant replaces the tenant name where it appears as a whole word, such as a path
+// segment, so codes that merely contain the tenant name are left alone
+func scrubTenant(s string) string {
+	tenant := strings.ToLower(os.Getenv("EMPORIX_TENANT"))
+	if tenant == "" || tenant == vcrTenant {
+		return s
+	}
+	pattern := regexp.MustCompile(`(^|[^A-Za-z0-9_-])` + regexp.QuoteMeta(tenant) + `($|[^A-Za-z0-9_-])`)
+	return pattern.ReplaceAllString(s, "${1}"+vcrTenant+"${2}")
+}
+
+func TestVCR_RecordThenReplay(t *testing.T) {
+	ctx := context.Background()
+	cassette := filepath.Join(t.TempDir(), "cassette.json")
+	t.Cleanup(func() { httpTransport = nil })
+
+	webhook := &webhookCreateRequest{
+		Code:     "http",
+		Active:   true,
+		Provider: "HTTP",
+		Configuration: &NestedConfigCreate{
+			DestinationUrl: "https://example.com/hook",
+			SecretKey:      "s3cr3t-signing-key",
+		},
+	}
+
+	// Record against the fake API
+	recorder, err := newVCRRecorder(vcrModeRecord, cassette)
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpTransport = recorder
+	client, server := newFakeAPIClient(t)
+	t.Setenv("EMPORIX_TENANT", server.Tenant)
+
+	if _, err := client.CreateWebhook(ctx, webhook); err != nil {
+		t.Fatalf("unexpected error creating webhook: %s", err)
+	}
+	recorded, err := client.GetCountry(ctx, "DE")
+	if err != nil {
+		t.Fatalf("unexpected error reading country: %s", err)
+	}
+	if err := recorder.save(); err != nil {
+		t.Fatal(err)
+	}
+	server.Close()
+
+	data, err := os.ReadFile(cassette)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{server.ClientID, server.ClientSecret, "s3cr3t-signing-key", "fake-token-", server.Tenant} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("cassette contains %q:\n%s", secret, data)
+		}
+	}
+
+	// Replay with the server gone, using the placeholder tenant and credentials
+	replayer, err := newVCRRecorder(vcrModeReplay, cassette)
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpTransport = replayer
+	t.Setenv("EMPORIX_TENANT", vcrTenant)
+	tokens := newClientCredentialsTokenSource(server.URL, vcrRedacted, vcrRedacted, "")
+	client = newEmporixClientWithTokenSource(vcrTenant, tokens, server.URL)
+	client.MaxRetries = 0
+
+	replayed, err := client.GetCountry(ctx, "DE")
+	if err != nil {
+		t.Fatalf("unexpected error replaying country: %s", err)
+	}
+	if replayed.Code != recorded.Code || replayed.Metadata.Version != recorded.Metadata.Version {
+		t.Errorf("expected replayed country %+v, got %+v", recorded, replayed)
+	}
+
+	got, err := client.CreateWebhook(ctx, webhook)
+	if err != nil {
+		t.Fatalf("unexpected error replaying webhook: %s", err)
+	}
+	if got.Code != "http" || got.Configuration.DestinationUrl != "https://example.com/hook" {
+		t.Errorf("unexpected replayed webhook: %+v", got)
+	}
+
+	// Every interaction is replayed once
+	if _, err := client.GetCountry(ctx, "DE"); err == nil {
+		t.Fatal("expected an error for a request beyond the recorded interactions")
+	}
+}
+
+func TestScrubBody(t *testing.T) {
+	t.Setenv("EMPORIX_TENANT", "acme")
+
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "json secrets",
+			body: `{"configuration":{"secretKey":"abc","destinationUrl":"https://hooks.example.com/acme"},"code":"acme-hook"}`,
+			want: `{"code":"acme-hook","configuration":{"destinationUrl":"https://hooks.example.com/vcrtenant","secretKey":"REDACTED"}}`,
+		},
+		{
+			name: "token response",
+			body: `{"access_token":"eyJhbGci","expires_in":3599,"token_type":"Bearer"}`,
+			want: `{"access_token":"REDACTED","expires_in":3599,"token_type":"Bearer"}`,
+		},
+		{
+			name: "token request",
+			body: `client_id=abc&client_secret=def&grant_type=client_credentials`,
+			want: `client_id=REDACTED&client_secret=REDACTED&grant_type=client_credentials`,
+		},
+		{
+			name: "api client secret",
+			body: `{"clientId":"client-apikey-1","clientSecret":"k9Xq2vLr7","id":"apikey-1"}`,
+			want: `{"clientId":"client-apikey-1","clientSecret":"REDACTED","id":"apikey-1"}`,
+		},
+		{
+			name: "secretKeyExists is kept",
+			body: `{"secretKeyExists":true}`,
+			want: `{"secretKeyExists":true}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := scrubBody([]byte(tt.body)); got != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestVCR_RecordScrubsAPIClientSecret(t *testing.T) {
+	ctx := context.Background()
+	cassette := filepath.Join(t.TempDir(), "cassette.json")
+	t.Cleanup(func() { httpTransport = nil })
+
+	recorder, err := newVCRRecorder(vcrModeRecord, cassette)
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpTransport = recorder
+	client, server := newFakeAPIClient(t)
+	t.Setenv("EMPORIX_TENANT", server.Tenant)
+
+	created, err := client.CreateAPIClient(ctx, &APIClient{Name: "CI", Scopes: []string{"site.site_read"}})
+	if err != nil {
+		t.Fatalf("unexpected error creating API client: %s", err)
+	}
+	if created.ClientSecret == "" {
+		t.Fatal("expected the fake API to return a client secret")
+	}
+	if err := recorder.save(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(cassette)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), created.ClientSecret) {
+		t.Errorf("cassette contains the API client secret %q:\n%s", created.ClientSecret, data)
+	}
+	if !strings.Contains(string(data), created.ClientID) {
+		t.Errorf("expected the cassette to keep the client ID %q", created.ClientID)
+	}
+}
+
+func TestVCR_FixtureRecordThenReplay(t *testing.T) {
+	cassette := filepath.Join(t.TempDir(), "cassette.json")
+
+	// Install the recorders directly; testAccVCR would look for the cassette of the test
+	withRecorder := func(t *testing.T, mode string) *vcrRecorder {
+		recorder, err := newVCRRecorder(mode, cassette)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Setenv("EMPORIX_VCR_MODE", mode)
+		vcrRecorders[t] = recorder
+		t.Cleanup(func() { delete(vcrRecorders, t) })
+		return recorder
+	}
+
+	t.Run("record", func(t *testing.T) {
+		t.Setenv("EMPORIX_TEST_PRODUCT_ID", "recorded-product")
+		recorder := withRecorder(t, vcrModeRecord)
+
+		if id := testAccFixture(t, "EMPORIX_TEST_PRODUCT_ID", "tf-test-product"); id != "recorded-product" {
+			t.Errorf("expected the ID from the environment, got %q", id)
+		}
+		if err := recorder.save(); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("replay", func(t *testing.T) {
+		t.Setenv("EMPORIX_TEST_PRODUCT_ID", "")
+		withRecorder(t, vcrModeReplay)
+
+		if id := testAccFixture(t, "EMPORIX_TEST_PRODUCT_ID", "tf-test-product"); id != "recorded-product" {
+			t.Errorf("expected the recorded ID, got %q", id)
+		}
+	})
+}
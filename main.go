@@ -4,6 +4,7 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"terraform-provider-emporix/internal/provider"
@@ -14,6 +15,11 @@ var (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		export(os.Args[2:])
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
@@ -30,3 +36,28 @@ func main() {
 		log.Fatal(err.Error())
 	}
 }
+
+// export generates configuration and import blocks for an existing tenant:
+//
+//	terraform-provider-emporix export -tenant mytenant -out ./exported
+func export(args []string) {
+	opts := provider.ExportOptions{Log: os.Stderr}
+
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	flags.StringVar(&opts.Tenant, "tenant", os.Getenv("EMPORIX_TENANT"), "Emporix tenant to export, defaults to EMPORIX_TENANT")
+	flags.StringVar(&opts.ClientId, "client-id", os.Getenv("EMPORIX_CLIENT_ID"), "OAuth2 client ID, defaults to EMPORIX_CLIENT_ID")
+	flags.StringVar(&opts.Scope, "scope", os.Getenv("EMPORIX_SCOPE"), "OAuth2 scopes, defaults to EMPORIX_SCOPE")
+	flags.StringVar(&opts.ApiUrl, "api-url", os.Getenv("EMPORIX_API_URL"), "Emporix API base URL, defaults to EMPORIX_API_URL or https://api.emporix.io")
+	flags.StringVar(&opts.Profile, "profile", os.Getenv("EMPORIX_PROFILE"), "credentials file profile for settings not given otherwise, defaults to EMPORIX_PROFILE")
+	flags.StringVar(&opts.OutputDir, "out", ".", "directory the generated .tf files are written to")
+	flags.Parse(args)
+
+	// Secrets are only read from the environment so they don't end up in shell history
+	opts.ClientSecret = os.Getenv("EMPORIX_CLIENT_SECRET")
+	opts.AccessToken = os.Getenv("EMPORIX_ACCESS_TOKEN")
+	opts.CredentialProcess = os.Getenv("EMPORIX_CREDENTIAL_PROCESS")
+
+	if err := provider.Export(context.Background(), opts); err != nil {
+		log.Fatal(err.Error())
+	}
+}